@@ -148,8 +148,8 @@ var (
 
 // Client queries for DNS records
 type Client interface {
-	LookupTXT(context.Context, string) (txts []string, err error)
-	LookupHost(context.Context, string) ([]net.IP, error)
+	LookupTXT(context.Context, string) (txts []string, security Security, err error)
+	LookupHost(context.Context, string) ([]net.IP, Security, error)
 	LookupCAA(context.Context, string) ([]*dns.CAA, string, error)
 }
 
@@ -161,11 +161,14 @@ type impl struct {
 	maxTries                 int
 	clk                      clock.Clock
 	log                      blog.Logger
+	// validator is nil unless the client validates DNSSEC itself.
+	validator *validator
 
 	queryTime         *prometheus.HistogramVec
 	totalLookupTime   *prometheus.HistogramVec
 	timeoutCounter    *prometheus.CounterVec
 	idMismatchCounter *prometheus.CounterVec
+	dnssecCounter     *prometheus.CounterVec
 }
 
 var _ Client = &impl{}
@@ -184,10 +187,13 @@ type server struct {
 }
 
 // New constructs a new DNS resolver object that utilizes the
-// provided list of DNS servers for resolution.
+// provided list of DNS servers for resolution. If trustAnchors is non-empty
+// the resolver validates DNSSEC itself, starting from those DS records,
+// rather than trusting the servers to.
 func New(
 	readTimeout time.Duration,
 	servers []string,
+	trustAnchors []*dns.DS,
 	stats prometheus.Registerer,
 	clk clock.Clock,
 	maxTries int,
@@ -229,7 +235,14 @@ func New(
 		},
 		[]string{"qtype", "resolver"},
 	)
-	stats.MustRegister(queryTime, totalLookupTime, timeoutCounter, idMismatchCounter)
+	dnssecCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dns_dnssec_validations",
+			Help: "Counter of DNSSEC validation results sliced by query type and result",
+		},
+		[]string{"qtype", "result"},
+	)
+	stats.MustRegister(queryTime, totalLookupTime, timeoutCounter, idMismatchCounter, dnssecCounter)
 
 	var serverStructs []server
 	for _, s := range servers {
@@ -244,7 +257,7 @@ func New(
 		})
 	}
 
-	return &impl{
+	client := &impl{
 		dnsClient:                dnsClient,
 		servers:                  serverStructs,
 		allowRestrictedAddresses: false,
@@ -254,8 +267,13 @@ func New(
 		totalLookupTime:          totalLookupTime,
 		timeoutCounter:           timeoutCounter,
 		idMismatchCounter:        idMismatchCounter,
+		dnssecCounter:            dnssecCounter,
 		log:                      log,
 	}
+	if len(trustAnchors) > 0 {
+		client.validator = newValidator(trustAnchors, client.exchangeOne, clk)
+	}
+	return client
 }
 
 // NewTest constructs a new DNS resolver object that utilizes the
@@ -264,19 +282,20 @@ func New(
 func NewTest(
 	readTimeout time.Duration,
 	servers []string,
+	trustAnchors []*dns.DS,
 	stats prometheus.Registerer,
 	clk clock.Clock,
 	maxTries int,
 	log blog.Logger) Client {
-	resolver := New(readTimeout, servers, stats, clk, maxTries, log)
+	resolver := New(readTimeout, servers, trustAnchors, stats, clk, maxTries, log)
 	resolver.(*impl).allowRestrictedAddresses = true
 	return resolver
 }
 
 // exchangeOne performs a single DNS exchange with a randomly chosen server
 // out of the server list, returning the response, time, and error (if any).
-// We assume that the upstream resolver requests and validates DNSSEC records
-// itself.
+// Unless the client validates DNSSEC itself, we assume that the upstream
+// resolver requests and validates DNSSEC records.
 func (dnsClient *impl) exchangeOne(ctx context.Context, hostname string, qtype uint16) (resp *dns.Msg, err error) {
 	m := new(dns.Msg)
 	// Set question type
@@ -291,7 +310,13 @@ func (dnsClient *impl) exchangeOne(ctx context.Context, hostname string, qtype u
 	// Tell the resolver that we're willing to receive responses up to 4096 bytes.
 	// This happens sometimes when there are a very large number of CAA records
 	// present.
-	m.SetEdns0(4096, false)
+	m.SetEdns0(4096, dnsClient.validator != nil)
+	if dnsClient.validator != nil {
+		// We want the signatures (the DO bit set above) even for answers the
+		// resolver considers bogus, so that we make our own decision about
+		// them rather than getting SERVFAIL.
+		m.CheckingDisabled = true
+	}
 
 	if len(dnsClient.servers) < 1 {
 		return nil, fmt.Errorf("Not configured with at least one DNS Server")
@@ -404,18 +429,45 @@ type dnsResp struct {
 	err error
 }
 
+// validate checks the DNSSEC status of the response to a query for hostname
+// and qtype, if the client validates DNSSEC itself. Errors are wrapped in the
+// Error type.
+func (dnsClient *impl) validate(ctx context.Context, hostname string, qtype uint16, resp *dns.Msg) (Security, error) {
+	if dnsClient.validator == nil {
+		return Unvalidated, nil
+	}
+	security, err := dnsClient.validator.validate(ctx, hostname, qtype, resp)
+	result := string(security)
+	if _, ok := err.(bogusError); ok {
+		result = "bogus"
+	} else if err != nil {
+		result = "failed"
+	}
+	dnsClient.dnssecCounter.With(prometheus.Labels{
+		"qtype":  dns.TypeToString[qtype],
+		"result": result,
+	}).Inc()
+	if err != nil {
+		return "", &Error{qtype, hostname, err, -1}
+	}
+	return security, nil
+}
+
 // LookupTXT sends a DNS query to find all TXT records associated with
-// the provided hostname which it returns along with the returned
-// DNS authority section.
-func (dnsClient *impl) LookupTXT(ctx context.Context, hostname string) ([]string, error) {
+// the provided hostname, which it returns along with their DNSSEC status.
+func (dnsClient *impl) LookupTXT(ctx context.Context, hostname string) ([]string, Security, error) {
 	var txt []string
 	dnsType := dns.TypeTXT
 	r, err := dnsClient.exchangeOne(ctx, hostname, dnsType)
 	if err != nil {
-		return nil, &Error{dnsType, hostname, err, -1}
+		return nil, "", &Error{dnsType, hostname, err, -1}
 	}
 	if r.Rcode != dns.RcodeSuccess {
-		return nil, &Error{dnsType, hostname, nil, r.Rcode}
+		return nil, "", &Error{dnsType, hostname, nil, r.Rcode}
+	}
+	security, err := dnsClient.validate(ctx, hostname, dnsType, r)
+	if err != nil {
+		return nil, "", err
 	}
 
 	for _, answer := range r.Answer {
@@ -426,7 +478,17 @@ func (dnsClient *impl) LookupTXT(ctx context.Context, hostname string) ([]string
 		}
 	}
 
-	return txt, err
+	return txt, security, nil
+}
+
+// IsReservedIP returns true if the given IP address is in one of the private,
+// reserved, or otherwise non-publicly-routable ranges that the resolver refuses
+// to return.
+func IsReservedIP(ip net.IP) bool {
+	if ip.To4() != nil {
+		return isPrivateV4(ip)
+	}
+	return isPrivateV6(ip)
 }
 
 func isPrivateV4(ip net.IP) bool {
@@ -447,15 +509,19 @@ func isPrivateV6(ip net.IP) bool {
 	return false
 }
 
-func (dnsClient *impl) lookupIP(ctx context.Context, hostname string, ipType uint16) ([]dns.RR, error) {
+func (dnsClient *impl) lookupIP(ctx context.Context, hostname string, ipType uint16) ([]dns.RR, Security, error) {
 	resp, err := dnsClient.exchangeOne(ctx, hostname, ipType)
 	if err != nil {
-		return nil, &Error{ipType, hostname, err, -1}
+		return nil, "", &Error{ipType, hostname, err, -1}
 	}
 	if resp.Rcode != dns.RcodeSuccess {
-		return nil, &Error{ipType, hostname, nil, resp.Rcode}
+		return nil, "", &Error{ipType, hostname, nil, resp.Rcode}
+	}
+	security, err := dnsClient.validate(ctx, hostname, ipType, resp)
+	if err != nil {
+		return nil, "", err
 	}
-	return resp.Answer, nil
+	return resp.Answer, security, nil
 }
 
 // LookupHost sends a DNS query to find all A and AAAA records associated with
@@ -463,26 +529,42 @@ func (dnsClient *impl) lookupIP(ctx context.Context, hostname string, ipType uin
 // chase CNAME/DNAME aliases and return relevant records.  It will retry
 // requests in the case of temporary network errors. It can return net package,
 // context.Canceled, and context.DeadlineExceeded errors, all wrapped in the
-// DNSError type.
-func (dnsClient *impl) LookupHost(ctx context.Context, hostname string) ([]net.IP, error) {
+// DNSError type. The DNSSEC status returned is that of the least secure of the
+// A and AAAA answers. If either answer fails DNSSEC validation the lookup
+// fails, even if the other succeeded.
+func (dnsClient *impl) LookupHost(ctx context.Context, hostname string) ([]net.IP, Security, error) {
 	var recordsA, recordsAAAA []dns.RR
+	var securityA, securityAAAA Security
 	var errA, errAAAA error
 	var wg sync.WaitGroup
 
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		recordsA, errA = dnsClient.lookupIP(ctx, hostname, dns.TypeA)
+		recordsA, securityA, errA = dnsClient.lookupIP(ctx, hostname, dns.TypeA)
 	}()
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		recordsAAAA, errAAAA = dnsClient.lookupIP(ctx, hostname, dns.TypeAAAA)
+		recordsAAAA, securityAAAA, errAAAA = dnsClient.lookupIP(ctx, hostname, dns.TypeAAAA)
 	}()
 	wg.Wait()
 
 	if errA != nil && errAAAA != nil {
-		return nil, errA
+		return nil, "", errA
+	}
+	for _, err := range []error{errA, errAAAA} {
+		if dnsErr, ok := err.(*Error); ok && dnsErr.bogus() {
+			return nil, "", err
+		}
+	}
+	var security Security
+	if errA != nil {
+		security = securityAAAA
+	} else if errAAAA != nil {
+		security = securityA
+	} else {
+		security = combine(securityA, securityAAAA)
 	}
 
 	var addrs []net.IP
@@ -502,13 +584,14 @@ func (dnsClient *impl) LookupHost(ctx context.Context, hostname string) ([]net.I
 		}
 	}
 
-	return addrs, nil
+	return addrs, security, nil
 }
 
 // LookupCAA sends a DNS query to find all CAA records associated with
 // the provided hostname and the complete dig-style RR `response`. This
 // response is quite verbose, however it's only populated when the CAA
-// response is non-empty.
+// response is non-empty. If the client validates DNSSEC, an answer which
+// fails validation, including a forged absence of CAA records, is an error.
 func (dnsClient *impl) LookupCAA(ctx context.Context, hostname string) ([]*dns.CAA, string, error) {
 	dnsType := dns.TypeCAA
 	r, err := dnsClient.exchangeOne(ctx, hostname, dnsType)
@@ -519,6 +602,11 @@ func (dnsClient *impl) LookupCAA(ctx context.Context, hostname string) ([]*dns.C
 	if r.Rcode == dns.RcodeServerFailure {
 		return nil, "", &Error{dnsType, hostname, nil, r.Rcode}
 	}
+	if r.Rcode == dns.RcodeSuccess || r.Rcode == dns.RcodeNameError {
+		if _, err := dnsClient.validate(ctx, hostname, dnsType, r); err != nil {
+			return nil, "", err
+		}
+	}
 
 	var CAAs []*dns.CAA
 	for _, answer := range r.Answer {
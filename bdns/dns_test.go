@@ -243,36 +243,36 @@ func TestMain(m *testing.M) {
 }
 
 func TestDNSNoServers(t *testing.T) {
-	obj := NewTest(time.Hour, []string{}, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
+	obj := NewTest(time.Hour, []string{}, nil, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
 
-	_, err := obj.LookupHost(context.Background(), "letsencrypt.org")
+	_, _, err := obj.LookupHost(context.Background(), "letsencrypt.org")
 
 	test.AssertError(t, err, "No servers")
 }
 
 func TestDNSOneServer(t *testing.T) {
-	obj := NewTest(time.Second*10, []string{dnsLoopbackAddr}, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
+	obj := NewTest(time.Second*10, []string{dnsLoopbackAddr}, nil, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
 
-	_, err := obj.LookupHost(context.Background(), "letsencrypt.org")
+	_, _, err := obj.LookupHost(context.Background(), "letsencrypt.org")
 
 	test.AssertNotError(t, err, "No message")
 }
 
 func TestDNSDuplicateServers(t *testing.T) {
-	obj := NewTest(time.Second*10, []string{dnsLoopbackAddr, dnsLoopbackAddr}, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
+	obj := NewTest(time.Second*10, []string{dnsLoopbackAddr, dnsLoopbackAddr}, nil, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
 
-	_, err := obj.LookupHost(context.Background(), "letsencrypt.org")
+	_, _, err := obj.LookupHost(context.Background(), "letsencrypt.org")
 
 	test.AssertNotError(t, err, "No message")
 }
 
 func TestDNSLookupsNoServer(t *testing.T) {
-	obj := NewTest(time.Second*10, []string{}, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
+	obj := NewTest(time.Second*10, []string{}, nil, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
 
-	_, err := obj.LookupTXT(context.Background(), "letsencrypt.org")
+	_, _, err := obj.LookupTXT(context.Background(), "letsencrypt.org")
 	test.AssertError(t, err, "No servers")
 
-	_, err = obj.LookupHost(context.Background(), "letsencrypt.org")
+	_, _, err = obj.LookupHost(context.Background(), "letsencrypt.org")
 	test.AssertError(t, err, "No servers")
 
 	_, _, err = obj.LookupCAA(context.Background(), "letsencrypt.org")
@@ -280,13 +280,13 @@ func TestDNSLookupsNoServer(t *testing.T) {
 }
 
 func TestDNSServFail(t *testing.T) {
-	obj := NewTest(time.Second*10, []string{dnsLoopbackAddr}, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
+	obj := NewTest(time.Second*10, []string{dnsLoopbackAddr}, nil, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
 	bad := "servfail.com"
 
-	_, err := obj.LookupTXT(context.Background(), bad)
+	_, _, err := obj.LookupTXT(context.Background(), bad)
 	test.AssertError(t, err, "LookupTXT didn't return an error")
 
-	_, err = obj.LookupHost(context.Background(), bad)
+	_, _, err = obj.LookupHost(context.Background(), bad)
 	test.AssertError(t, err, "LookupHost didn't return an error")
 
 	emptyCaa, _, err := obj.LookupCAA(context.Background(), bad)
@@ -295,13 +295,13 @@ func TestDNSServFail(t *testing.T) {
 }
 
 func TestDNSLookupTXT(t *testing.T) {
-	obj := NewTest(time.Second*10, []string{dnsLoopbackAddr}, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
+	obj := NewTest(time.Second*10, []string{dnsLoopbackAddr}, nil, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
 
-	a, err := obj.LookupTXT(context.Background(), "letsencrypt.org")
+	a, _, err := obj.LookupTXT(context.Background(), "letsencrypt.org")
 	t.Logf("A: %v", a)
 	test.AssertNotError(t, err, "No message")
 
-	a, err = obj.LookupTXT(context.Background(), "split-txt.letsencrypt.org")
+	a, _, err = obj.LookupTXT(context.Background(), "split-txt.letsencrypt.org")
 	t.Logf("A: %v ", a)
 	test.AssertNotError(t, err, "No message")
 	test.AssertEquals(t, len(a), 1)
@@ -309,36 +309,36 @@ func TestDNSLookupTXT(t *testing.T) {
 }
 
 func TestDNSLookupHost(t *testing.T) {
-	obj := NewTest(time.Second*10, []string{dnsLoopbackAddr}, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
+	obj := NewTest(time.Second*10, []string{dnsLoopbackAddr}, nil, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
 
-	ip, err := obj.LookupHost(context.Background(), "servfail.com")
+	ip, _, err := obj.LookupHost(context.Background(), "servfail.com")
 	t.Logf("servfail.com - IP: %s, Err: %s", ip, err)
 	test.AssertError(t, err, "Server failure")
 	test.Assert(t, len(ip) == 0, "Should not have IPs")
 
-	ip, err = obj.LookupHost(context.Background(), "nonexistent.letsencrypt.org")
+	ip, _, err = obj.LookupHost(context.Background(), "nonexistent.letsencrypt.org")
 	t.Logf("nonexistent.letsencrypt.org - IP: %s, Err: %s", ip, err)
 	test.AssertNotError(t, err, "Not an error to not exist")
 	test.Assert(t, len(ip) == 0, "Should not have IPs")
 
 	// Single IPv4 address
-	ip, err = obj.LookupHost(context.Background(), "cps.letsencrypt.org")
+	ip, _, err = obj.LookupHost(context.Background(), "cps.letsencrypt.org")
 	t.Logf("cps.letsencrypt.org - IP: %s, Err: %s", ip, err)
 	test.AssertNotError(t, err, "Not an error to exist")
 	test.Assert(t, len(ip) == 1, "Should have IP")
-	ip, err = obj.LookupHost(context.Background(), "cps.letsencrypt.org")
+	ip, _, err = obj.LookupHost(context.Background(), "cps.letsencrypt.org")
 	t.Logf("cps.letsencrypt.org - IP: %s, Err: %s", ip, err)
 	test.AssertNotError(t, err, "Not an error to exist")
 	test.Assert(t, len(ip) == 1, "Should have IP")
 
 	// Single IPv6 address
-	ip, err = obj.LookupHost(context.Background(), "v6.letsencrypt.org")
+	ip, _, err = obj.LookupHost(context.Background(), "v6.letsencrypt.org")
 	t.Logf("v6.letsencrypt.org - IP: %s, Err: %s", ip, err)
 	test.AssertNotError(t, err, "Not an error to exist")
 	test.Assert(t, len(ip) == 1, "Should not have IPs")
 
 	// Both IPv6 and IPv4 address
-	ip, err = obj.LookupHost(context.Background(), "dualstack.letsencrypt.org")
+	ip, _, err = obj.LookupHost(context.Background(), "dualstack.letsencrypt.org")
 	t.Logf("dualstack.letsencrypt.org - IP: %s, Err: %s", ip, err)
 	test.AssertNotError(t, err, "Not an error to exist")
 	test.Assert(t, len(ip) == 2, "Should have 2 IPs")
@@ -348,7 +348,7 @@ func TestDNSLookupHost(t *testing.T) {
 	test.Assert(t, ip[1].To16().Equal(expected), "wrong ipv6 address")
 
 	// IPv6 error, IPv4 success
-	ip, err = obj.LookupHost(context.Background(), "v6error.letsencrypt.org")
+	ip, _, err = obj.LookupHost(context.Background(), "v6error.letsencrypt.org")
 	t.Logf("v6error.letsencrypt.org - IP: %s, Err: %s", ip, err)
 	test.AssertNotError(t, err, "Not an error to exist")
 	test.Assert(t, len(ip) == 1, "Should have 1 IP")
@@ -356,7 +356,7 @@ func TestDNSLookupHost(t *testing.T) {
 	test.Assert(t, ip[0].To4().Equal(expected), "wrong ipv4 address")
 
 	// IPv6 success, IPv4 error
-	ip, err = obj.LookupHost(context.Background(), "v4error.letsencrypt.org")
+	ip, _, err = obj.LookupHost(context.Background(), "v4error.letsencrypt.org")
 	t.Logf("v4error.letsencrypt.org - IP: %s, Err: %s", ip, err)
 	test.AssertNotError(t, err, "Not an error to exist")
 	test.Assert(t, len(ip) == 1, "Should have 1 IP")
@@ -366,7 +366,7 @@ func TestDNSLookupHost(t *testing.T) {
 	// IPv6 error, IPv4 error
 	// Should return the IPv4 error (Refused) and not IPv6 error (NotImplemented)
 	hostname := "dualstackerror.letsencrypt.org"
-	ip, err = obj.LookupHost(context.Background(), hostname)
+	ip, _, err = obj.LookupHost(context.Background(), hostname)
 	t.Logf("%s - IP: %s, Err: %s", hostname, ip, err)
 	test.AssertError(t, err, "Should be an error")
 	expectedErr := &Error{dns.TypeA, hostname, nil, dns.RcodeRefused}
@@ -374,20 +374,20 @@ func TestDNSLookupHost(t *testing.T) {
 }
 
 func TestDNSNXDOMAIN(t *testing.T) {
-	obj := NewTest(time.Second*10, []string{dnsLoopbackAddr}, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
+	obj := NewTest(time.Second*10, []string{dnsLoopbackAddr}, nil, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
 
 	hostname := "nxdomain.letsencrypt.org"
-	_, err := obj.LookupHost(context.Background(), hostname)
+	_, _, err := obj.LookupHost(context.Background(), hostname)
 	expected := &Error{dns.TypeA, hostname, nil, dns.RcodeNameError}
 	test.AssertDeepEquals(t, err, expected)
 
-	_, err = obj.LookupTXT(context.Background(), hostname)
+	_, _, err = obj.LookupTXT(context.Background(), hostname)
 	expected.recordType = dns.TypeTXT
 	test.AssertDeepEquals(t, err, expected)
 }
 
 func TestDNSLookupCAA(t *testing.T) {
-	obj := NewTest(time.Second*10, []string{dnsLoopbackAddr}, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
+	obj := NewTest(time.Second*10, []string{dnsLoopbackAddr}, nil, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
 	removeIDExp := regexp.MustCompile(" id: [[:digit:]]+")
 
 	caas, resp, err := obj.LookupCAA(context.Background(), "bracewel.net")
@@ -456,6 +456,15 @@ func TestIsPrivateIP(t *testing.T) {
 	test.Assert(t, !isPrivateV6(net.ParseIP("0100::0001:0000:0000:0000:0000")), "should be private")
 }
 
+func TestIsReservedIP(t *testing.T) {
+	test.Assert(t, IsReservedIP(net.ParseIP("10.0.0.1")), "should be reserved")
+	test.Assert(t, IsReservedIP(net.ParseIP("127.0.0.1")), "should be reserved")
+	test.Assert(t, !IsReservedIP(net.ParseIP("93.184.216.34")), "should not be reserved")
+	test.Assert(t, IsReservedIP(net.ParseIP("::1")), "should be reserved")
+	test.Assert(t, IsReservedIP(net.ParseIP("fe80::1")), "should be reserved")
+	test.Assert(t, !IsReservedIP(net.ParseIP("2606:4700::1")), "should not be reserved")
+}
+
 type testExchanger struct {
 	sync.Mutex
 	count int
@@ -597,10 +606,10 @@ func TestRetry(t *testing.T) {
 	}
 
 	for i, tc := range tests {
-		testClient := NewTest(time.Second*10, []string{dnsLoopbackAddr}, metrics.NoopRegisterer, clock.NewFake(), tc.maxTries, blog.UseMock())
+		testClient := NewTest(time.Second*10, []string{dnsLoopbackAddr}, nil, metrics.NoopRegisterer, clock.NewFake(), tc.maxTries, blog.UseMock())
 		dr := testClient.(*impl)
 		dr.dnsClient = tc.te
-		_, err := dr.LookupTXT(context.Background(), "example.com")
+		_, _, err := dr.LookupTXT(context.Background(), "example.com")
 		if err == errTooManyRequests {
 			t.Errorf("#%d, sent more requests than the test case handles", i)
 		}
@@ -625,12 +634,12 @@ func TestRetry(t *testing.T) {
 		}
 	}
 
-	testClient := NewTest(time.Second*10, []string{dnsLoopbackAddr}, metrics.NoopRegisterer, clock.NewFake(), 3, blog.UseMock())
+	testClient := NewTest(time.Second*10, []string{dnsLoopbackAddr}, nil, metrics.NoopRegisterer, clock.NewFake(), 3, blog.UseMock())
 	dr := testClient.(*impl)
 	dr.dnsClient = &testExchanger{errs: []error{isTempErr, isTempErr, nil}}
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
-	_, err := dr.LookupTXT(ctx, "example.com")
+	_, _, err := dr.LookupTXT(ctx, "example.com")
 	if err == nil ||
 		err.Error() != "DNS problem: query timed out looking up TXT for example.com" {
 		t.Errorf("expected %s, got %s", context.Canceled, err)
@@ -639,7 +648,7 @@ func TestRetry(t *testing.T) {
 	dr.dnsClient = &testExchanger{errs: []error{isTempErr, isTempErr, nil}}
 	ctx, cancel = context.WithTimeout(context.Background(), -10*time.Hour)
 	defer cancel()
-	_, err = dr.LookupTXT(ctx, "example.com")
+	_, _, err = dr.LookupTXT(ctx, "example.com")
 	if err == nil ||
 		err.Error() != "DNS problem: query timed out looking up TXT for example.com" {
 		t.Errorf("expected %s, got %s", context.DeadlineExceeded, err)
@@ -648,7 +657,7 @@ func TestRetry(t *testing.T) {
 	dr.dnsClient = &testExchanger{errs: []error{isTempErr, isTempErr, nil}}
 	ctx, deadlineCancel := context.WithTimeout(context.Background(), -10*time.Hour)
 	deadlineCancel()
-	_, err = dr.LookupTXT(ctx, "example.com")
+	_, _, err = dr.LookupTXT(ctx, "example.com")
 	if err == nil ||
 		err.Error() != "DNS problem: query timed out looking up TXT for example.com" {
 		t.Errorf("expected %s, got %s", context.DeadlineExceeded, err)
@@ -719,7 +728,7 @@ func TestRotateServerOnErr(t *testing.T) {
 	// number of dnsServers to ensure we always get around to trying the one
 	// working server
 	maxTries := 5
-	client := NewTest(time.Second*10, dnsServers, metrics.NoopRegisterer, clock.NewFake(), maxTries, blog.UseMock())
+	client := NewTest(time.Second*10, dnsServers, nil, metrics.NoopRegisterer, clock.NewFake(), maxTries, blog.UseMock())
 
 	// Configure a mock exchanger that will always return a retryable error for
 	// the A and B servers. This will force the C server to do all the work once
@@ -736,7 +745,7 @@ func TestRotateServerOnErr(t *testing.T) {
 	// servers *all* queries should eventually succeed by being retried against
 	// the C server.
 	for i := 0; i < maxTries*2; i++ {
-		_, err := client.LookupTXT(context.Background(), "example.com")
+		_, _, err := client.LookupTXT(context.Background(), "example.com")
 		// Any errors are unexpected - the C server should have responded without error.
 		test.AssertNotError(t, err, "Expected no error from eventual retry with functional server")
 	}
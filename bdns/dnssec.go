@@ -0,0 +1,738 @@
+package bdns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/miekg/dns"
+)
+
+// Security is the DNSSEC status of the answer to a lookup.
+type Security string
+
+const (
+	// Unvalidated means the client isn't validating DNSSEC, so nothing is
+	// known about the answer.
+	Unvalidated Security = ""
+	// Secure means the answer, or the proof that there is no answer, was
+	// signed by keys which chain to a trust anchor.
+	Secure Security = "secure"
+	// Insecure means the answer comes from a zone which is provably unsigned,
+	// either because it's beneath a delegation with no DS records or because
+	// it uses only algorithms we don't support.
+	Insecure Security = "insecure"
+)
+
+// combine returns the status of an answer made up of parts with statuses a
+// and b. An answer is only as secure as its least secure part.
+func combine(a, b Security) Security {
+	if a == Unvalidated || b == Unvalidated {
+		return Unvalidated
+	}
+	if a == Insecure || b == Insecure {
+		return Insecure
+	}
+	return Secure
+}
+
+// bogusError is returned when an answer fails DNSSEC validation: it, or the
+// chain of keys leading to it, is missing signatures or has signatures which
+// don't verify.
+type bogusError struct {
+	reason string
+}
+
+func (e bogusError) Error() string {
+	return "DNSSEC validation failure: " + e.reason
+}
+
+func bogusf(format string, args ...interface{}) error {
+	return bogusError{fmt.Sprintf(format, args...)}
+}
+
+const (
+	// maxCNAMEs bounds how long a chain of CNAMEs in an answer we'll follow.
+	maxCNAMEs = 8
+	// maxNSEC3Iterations is the most NSEC3 hash iterations we'll compute. Per
+	// RFC 9276 Section 3.2, proofs using more iterations are treated as
+	// insecure rather than bogus.
+	maxNSEC3Iterations = 150
+	// maxCacheTTL caps how long a validated delegation is cached, whatever
+	// the TTLs of its records.
+	maxCacheTTL = time.Hour
+	// maxCachedDelegations bounds how many delegations are cached. A DS query
+	// is sent for every name we look up beneath a signed zone, so without a
+	// bound the cache would grow with every name ever validated.
+	maxCachedDelegations = 10000
+)
+
+// supportedAlgorithms are the DNSKEY algorithms we can verify signatures
+// from. Zones signed only with other algorithms are treated as insecure (RFC
+// 4035 Section 5.2).
+var supportedAlgorithms = map[uint8]bool{
+	dns.RSASHA1:          true,
+	dns.RSASHA1NSEC3SHA1: true,
+	dns.RSASHA256:        true,
+	dns.RSASHA512:        true,
+	dns.ECDSAP256SHA256:  true,
+	dns.ECDSAP384SHA384:  true,
+	dns.ED25519:          true,
+}
+
+// supportedDigests are the DS digest types we can check DNSKEYs against.
+var supportedDigests = map[uint8]bool{
+	dns.SHA1:   true,
+	dns.SHA256: true,
+	dns.SHA384: true,
+}
+
+// ParseTrustAnchors parses DS records in zone file format, like the root
+// zone's ". IN DS 20326 8 2 E06D44B8...", for use as DNSSEC trust anchors.
+func ParseTrustAnchors(anchors []string) ([]*dns.DS, error) {
+	var parsed []*dns.DS
+	for _, a := range anchors {
+		rr, err := dns.NewRR(a)
+		if err != nil {
+			return nil, fmt.Errorf("parsing trust anchor %q: %s", a, err)
+		}
+		ds, ok := rr.(*dns.DS)
+		if !ok {
+			return nil, fmt.Errorf("trust anchor %q is not a DS record", a)
+		}
+		parsed = append(parsed, ds)
+	}
+	return parsed, nil
+}
+
+// cutKind is what a DS query tells us about a name during the walk down from
+// a trust anchor.
+type cutKind int
+
+const (
+	// notCut means the name is in the same zone as its parent.
+	notCut cutKind = iota
+	// signedCut means the name is the apex of a signed zone.
+	signedCut
+	// unsignedCut means the name is the apex of a zone with no DS records,
+	// or only DS records for algorithms we don't support.
+	unsignedCut
+)
+
+// delegation is the validated result of a DS query, cached by name.
+type delegation struct {
+	kind cutKind
+	// keys are the zone's DNSKEYs if kind is signedCut.
+	keys    []*dns.DNSKEY
+	expires time.Time
+}
+
+// validator chain-validates DNSSEC answers from a set of trust anchors.
+type validator struct {
+	// anchors are the trust anchor DS records, keyed by canonical zone name.
+	anchors map[string][]*dns.DS
+	// query sends a DNS query with the DO and CD bits set.
+	query func(ctx context.Context, name string, qtype uint16) (*dns.Msg, error)
+	clk   clock.Clock
+
+	sync.Mutex
+	delegations map[string]delegation
+}
+
+func newValidator(
+	anchors []*dns.DS,
+	query func(context.Context, string, uint16) (*dns.Msg, error),
+	clk clock.Clock,
+) *validator {
+	v := &validator{
+		anchors:     make(map[string][]*dns.DS),
+		query:       query,
+		clk:         clk,
+		delegations: make(map[string]delegation),
+	}
+	for _, ds := range anchors {
+		zone := dns.CanonicalName(ds.Hdr.Name)
+		v.anchors[zone] = append(v.anchors[zone], ds)
+	}
+	return v
+}
+
+// validate checks the response to a query for qname and qtype. It follows
+// any CNAMEs in the answer, validating each RRset in the chain in its own
+// zone, and for an answer with no records of qtype it checks the proof that
+// there are none. It returns a bogusError if validation fails.
+func (v *validator) validate(ctx context.Context, qname string, qtype uint16, resp *dns.Msg) (Security, error) {
+	name := dns.CanonicalName(qname)
+	security := Secure
+	for i := 0; i <= maxCNAMEs; i++ {
+		if rrset := rrsetOf(resp.Answer, name, qtype); len(rrset) > 0 {
+			s, err := v.validateRRset(ctx, resp, name, rrset)
+			return combine(security, s), err
+		}
+		cname := rrsetOf(resp.Answer, name, dns.TypeCNAME)
+		if len(cname) == 0 {
+			s, err := v.validateDenial(ctx, resp, name, qtype)
+			return combine(security, s), err
+		}
+		s, err := v.validateRRset(ctx, resp, name, cname)
+		if err != nil {
+			// A CNAME synthesized from a DNAME is unsigned (RFC 6672 Section
+			// 5.3.1), so validate the DNAME instead.
+			s, err = v.validateDNAME(ctx, resp, name, cname[0].(*dns.CNAME))
+			if err != nil {
+				return "", err
+			}
+		}
+		security = combine(security, s)
+		name = dns.CanonicalName(cname[0].(*dns.CNAME).Target)
+	}
+	return "", bogusf("more than %d CNAMEs following %s", maxCNAMEs, qname)
+}
+
+// validateRRset validates an RRset from the answer section of resp.
+func (v *validator) validateRRset(ctx context.Context, resp *dns.Msg, name string, rrset []dns.RR) (Security, error) {
+	zone, keys, security, err := v.zoneFor(ctx, name)
+	if err != nil || security == Insecure {
+		return security, err
+	}
+	sigLabels, err := v.verify(resp.Answer, rrset, zone, keys)
+	if err != nil {
+		return "", err
+	}
+	if sigLabels < dns.CountLabel(name) {
+		// The RRset was expanded from a wildcard, so there must be proof that
+		// no closer match exists (RFC 4035 Section 5.3.4).
+		labels := dns.SplitDomainName(name)
+		nextCloser := dns.Fqdn(strings.Join(labels[len(labels)-sigLabels-1:], "."))
+		nsecs, nsec3s, insecure := v.denials(resp.Ns, zone, keys)
+		if insecure {
+			return Insecure, nil
+		}
+		if !nsecCovered(nsecs, nextCloser) && !nsec3Covered(nsec3s, nextCloser) {
+			return "", bogusf("no proof that %s doesn't exist for wildcard answer at %s", nextCloser, name)
+		}
+	}
+	return Secure, nil
+}
+
+// validateDNAME validates a CNAME synthesized from a DNAME in resp's answer.
+func (v *validator) validateDNAME(ctx context.Context, resp *dns.Msg, name string, cname *dns.CNAME) (Security, error) {
+	for _, rr := range resp.Answer {
+		dname, ok := rr.(*dns.DNAME)
+		if !ok {
+			continue
+		}
+		owner := dns.CanonicalName(dname.Hdr.Name)
+		if owner == name || !dns.IsSubDomain(owner, name) {
+			continue
+		}
+		synthesized := strings.TrimSuffix(name, owner) + dns.CanonicalName(dname.Target)
+		if synthesized != dns.CanonicalName(cname.Target) {
+			return "", bogusf("CNAME for %s doesn't match the DNAME at %s", name, owner)
+		}
+		return v.validateRRset(ctx, resp, owner, rrsetOf(resp.Answer, owner, dns.TypeDNAME))
+	}
+	return "", bogusf("no valid signature over CNAME at %s", name)
+}
+
+// validateDenial checks that resp proves there are no records of qtype at
+// name, or that name doesn't exist, as its Rcode claims.
+func (v *validator) validateDenial(ctx context.Context, resp *dns.Msg, name string, qtype uint16) (Security, error) {
+	zone, keys, security, err := v.zoneFor(ctx, name)
+	if err != nil || security == Insecure {
+		return security, err
+	}
+	nsecs, nsec3s, insecure := v.denials(resp.Ns, zone, keys)
+	if insecure {
+		return Insecure, nil
+	}
+	if resp.Rcode == dns.RcodeNameError {
+		if nsecNameError(nsecs, name) || nsec3NameError(nsec3s, name, zone) {
+			return Secure, nil
+		}
+		return "", bogusf("no proof that %s doesn't exist", name)
+	}
+	if nsecNoData(nsecs, name, qtype) || nsec3NoData(nsec3s, name, zone, qtype) {
+		return Secure, nil
+	}
+	return "", bogusf("no proof that %s has no %s records", name, dns.TypeToString[qtype])
+}
+
+// zoneFor walks the delegations from the closest trust anchor above name
+// down to name. It returns the closest signed zone containing name and that
+// zone's keys, or Insecure if the walk passes through an unsigned
+// delegation or there's no trust anchor above name.
+func (v *validator) zoneFor(ctx context.Context, name string) (string, []*dns.DNSKEY, Security, error) {
+	labels := dns.SplitDomainName(name)
+	anchor := -1
+	for i := 0; i <= len(labels); i++ {
+		if _, ok := v.anchors[dns.Fqdn(strings.Join(labels[i:], "."))]; ok {
+			anchor = i
+			break
+		}
+	}
+	if anchor == -1 {
+		return "", nil, Insecure, nil
+	}
+
+	zone := dns.Fqdn(strings.Join(labels[anchor:], "."))
+	d, err := v.cached(zone, func() (delegation, error) {
+		return v.fetchKeys(ctx, zone, v.anchors[zone])
+	})
+	if err != nil {
+		return "", nil, "", err
+	}
+	if d.kind == unsignedCut {
+		return "", nil, Insecure, nil
+	}
+	keys := d.keys
+
+	for i := anchor - 1; i >= 0; i-- {
+		child := dns.Fqdn(strings.Join(labels[i:], "."))
+		parent, parentKeys := zone, keys
+		d, err := v.cached(child, func() (delegation, error) {
+			return v.delegation(ctx, parent, parentKeys, child)
+		})
+		if err != nil {
+			return "", nil, "", err
+		}
+		switch d.kind {
+		case signedCut:
+			zone, keys = child, d.keys
+		case unsignedCut:
+			return "", nil, Insecure, nil
+		}
+	}
+	return zone, keys, Secure, nil
+}
+
+// cached returns the cached delegation for name if there's an unexpired one,
+// and otherwise calls fetch and caches its result.
+func (v *validator) cached(name string, fetch func() (delegation, error)) (delegation, error) {
+	v.Lock()
+	d, ok := v.delegations[name]
+	v.Unlock()
+	if ok && v.clk.Now().Before(d.expires) {
+		return d, nil
+	}
+	d, err := fetch()
+	if err != nil {
+		return delegation{}, err
+	}
+	v.Lock()
+	if len(v.delegations) >= maxCachedDelegations {
+		v.purge()
+	}
+	v.delegations[name] = d
+	v.Unlock()
+	return d, nil
+}
+
+// purge removes the expired delegations from the cache and then, if it's
+// still at least half full, arbitrary others until it isn't, so that a full
+// cache isn't purged on every insertion. It must be called with v locked.
+func (v *validator) purge() {
+	now := v.clk.Now()
+	for name, d := range v.delegations {
+		if !now.Before(d.expires) {
+			delete(v.delegations, name)
+		}
+	}
+	for name := range v.delegations {
+		if len(v.delegations) < maxCachedDelegations/2 {
+			break
+		}
+		delete(v.delegations, name)
+	}
+}
+
+// delegation sends a DS query for child, which is directly beneath zone, and
+// validates the answer with zone's keys to find out whether child is the
+// apex of a signed zone, an unsigned zone, or neither.
+func (v *validator) delegation(ctx context.Context, zone string, keys []*dns.DNSKEY, child string) (delegation, error) {
+	resp, err := v.query(ctx, child, dns.TypeDS)
+	if err != nil {
+		return delegation{}, err
+	}
+	if resp.Rcode != dns.RcodeSuccess && resp.Rcode != dns.RcodeNameError {
+		return delegation{}, fmt.Errorf("DS query for %s: %s", child, dns.RcodeToString[resp.Rcode])
+	}
+	ttl := maxCacheTTL
+
+	if rrset := rrsetOf(resp.Answer, child, dns.TypeDS); len(rrset) > 0 {
+		if _, err := v.verify(resp.Answer, rrset, zone, keys); err != nil {
+			return delegation{}, err
+		}
+		var dss []*dns.DS
+		for _, rr := range rrset {
+			dss = append(dss, rr.(*dns.DS))
+		}
+		return v.fetchKeys(ctx, child, dss)
+	}
+
+	if cname := rrsetOf(resp.Answer, child, dns.TypeCNAME); len(cname) > 0 {
+		// A CNAME can't be at a zone cut. If the CNAME is bogus, that'll be
+		// found when it's validated as part of the answer.
+		return delegation{kind: notCut, expires: v.expiry(ttl, cname)}, nil
+	}
+
+	nsecs, nsec3s, insecure := v.denials(resp.Ns, zone, keys)
+	if insecure {
+		return delegation{kind: unsignedCut, expires: v.expiry(ttl, nil)}, nil
+	}
+	var proof []dns.RR
+	for _, nsec := range nsecs {
+		proof = append(proof, nsec)
+		if dns.CanonicalName(nsec.Hdr.Name) == child {
+			return v.cut(child, nsec.TypeBitMap, v.expiry(ttl, proof))
+		}
+	}
+	for _, nsec3 := range nsec3s {
+		proof = append(proof, nsec3)
+		if nsec3.Match(child) {
+			return v.cut(child, nsec3.TypeBitMap, v.expiry(ttl, proof))
+		}
+	}
+	if nsecCovered(nsecs, child) {
+		return delegation{kind: notCut, expires: v.expiry(ttl, proof)}, nil
+	}
+	for _, nsec3 := range nsec3s {
+		if nsec3.Cover(child) {
+			if nsec3.Flags&1 == 1 {
+				// The covering NSEC3 has the opt-out flag, so child may be an
+				// unsigned delegation (RFC 5155 Section 8.9).
+				return delegation{kind: unsignedCut, expires: v.expiry(ttl, proof)}, nil
+			}
+			return delegation{kind: notCut, expires: v.expiry(ttl, proof)}, nil
+		}
+	}
+	return delegation{}, bogusf("no proof that %s has no DS records", child)
+}
+
+// cut interprets the type bitmap of the NSEC or NSEC3 record which matched a
+// DS query for child.
+func (v *validator) cut(child string, types []uint16, expires time.Time) (delegation, error) {
+	if hasType(types, dns.TypeDS) {
+		return delegation{}, bogusf("DS records for %s were denied by a record listing them", child)
+	}
+	if hasType(types, dns.TypeNS) && !hasType(types, dns.TypeSOA) {
+		return delegation{kind: unsignedCut, expires: expires}, nil
+	}
+	return delegation{kind: notCut, expires: expires}, nil
+}
+
+// fetchKeys queries the DNSKEYs of zone and checks that the DNSKEY RRset is
+// signed by a key matching one of the given DS records.
+func (v *validator) fetchKeys(ctx context.Context, zone string, dss []*dns.DS) (delegation, error) {
+	var supported []*dns.DS
+	for _, ds := range dss {
+		if supportedAlgorithms[ds.Algorithm] && supportedDigests[ds.DigestType] {
+			supported = append(supported, ds)
+		}
+	}
+	if len(supported) == 0 {
+		return delegation{kind: unsignedCut, expires: v.expiry(maxCacheTTL, nil)}, nil
+	}
+
+	resp, err := v.query(ctx, zone, dns.TypeDNSKEY)
+	if err != nil {
+		return delegation{}, err
+	}
+	if resp.Rcode != dns.RcodeSuccess {
+		return delegation{}, fmt.Errorf("DNSKEY query for %s: %s", zone, dns.RcodeToString[resp.Rcode])
+	}
+	rrset := rrsetOf(resp.Answer, zone, dns.TypeDNSKEY)
+	var keys, trusted []*dns.DNSKEY
+	for _, rr := range rrset {
+		key := rr.(*dns.DNSKEY)
+		if key.Flags&dns.ZONE == 0 {
+			continue
+		}
+		keys = append(keys, key)
+		for _, ds := range supported {
+			if ds.KeyTag != key.KeyTag() || ds.Algorithm != key.Algorithm {
+				continue
+			}
+			if digest := key.ToDS(ds.DigestType); digest != nil && strings.EqualFold(digest.Digest, ds.Digest) {
+				trusted = append(trusted, key)
+				break
+			}
+		}
+	}
+	if len(trusted) == 0 {
+		return delegation{}, bogusf("no DNSKEY for %s matches its DS records", zone)
+	}
+	if _, err := v.verify(resp.Answer, rrset, zone, trusted); err != nil {
+		return delegation{}, err
+	}
+	return delegation{kind: signedCut, keys: keys, expires: v.expiry(maxCacheTTL, rrset)}, nil
+}
+
+// verify checks that one of the RRSIGs in section over rrset is currently
+// valid and was made by one of keys, which belong to zone. It returns the
+// number of labels in the owner name that signature covers, which is fewer
+// than the owner name has if the RRset was expanded from a wildcard.
+func (v *validator) verify(section []dns.RR, rrset []dns.RR, zone string, keys []*dns.DNSKEY) (int, error) {
+	owner := dns.CanonicalName(rrset[0].Header().Name)
+	rrtype := rrset[0].Header().Rrtype
+	reason := "no signature"
+	for _, rr := range section {
+		sig, ok := rr.(*dns.RRSIG)
+		if !ok || sig.TypeCovered != rrtype || dns.CanonicalName(sig.Hdr.Name) != owner {
+			continue
+		}
+		if dns.CanonicalName(sig.SignerName) != zone {
+			reason = fmt.Sprintf("signature by %s rather than %s", sig.SignerName, zone)
+			continue
+		}
+		if int(sig.Labels) > dns.CountLabel(owner) {
+			reason = "signature with too many labels"
+			continue
+		}
+		if !sig.ValidityPeriod(v.clk.Now()) {
+			reason = "signature outside its validity period"
+			continue
+		}
+		for _, key := range keys {
+			if key.KeyTag() != sig.KeyTag || key.Algorithm != sig.Algorithm {
+				continue
+			}
+			if err := sig.Verify(key, rrset); err == nil {
+				labels := int(sig.Labels)
+				if strings.HasPrefix(owner, "*.") && labels == dns.CountLabel(owner)-1 {
+					// This is the wildcard itself, not an expansion of it.
+					labels++
+				}
+				return labels, nil
+			}
+			reason = "signature which doesn't verify"
+		}
+	}
+	return 0, bogusf("%s over %s %s", reason, owner, dns.TypeToString[rrtype])
+}
+
+// denials returns the NSEC and NSEC3 records from section with valid
+// signatures by zone. If any validly signed NSEC3 record uses more hash
+// iterations than we're willing to compute, it reports the proof as
+// insecure.
+func (v *validator) denials(section []dns.RR, zone string, keys []*dns.DNSKEY) ([]*dns.NSEC, []*dns.NSEC3, bool) {
+	var nsecs []*dns.NSEC
+	var nsec3s []*dns.NSEC3
+	for _, rr := range section {
+		switch rr := rr.(type) {
+		case *dns.NSEC, *dns.NSEC3:
+			owner := dns.CanonicalName(rr.Header().Name)
+			if !dns.IsSubDomain(zone, owner) {
+				continue
+			}
+			if _, err := v.verify(section, rrsetOf(section, owner, rr.Header().Rrtype), zone, keys); err != nil {
+				continue
+			}
+			if nsec, ok := rr.(*dns.NSEC); ok {
+				nsecs = append(nsecs, nsec)
+			} else {
+				nsec3 := rr.(*dns.NSEC3)
+				if nsec3.Iterations > maxNSEC3Iterations {
+					return nil, nil, true
+				}
+				nsec3s = append(nsec3s, nsec3)
+			}
+		}
+	}
+	return nsecs, nsec3s, false
+}
+
+// expiry returns when a result derived from the given records should expire
+// from the cache: after the smallest of their TTLs, or after ttl if that's
+// smaller.
+func (v *validator) expiry(ttl time.Duration, rrs []dns.RR) time.Time {
+	for _, rr := range rrs {
+		if d := time.Duration(rr.Header().Ttl) * time.Second; d < ttl {
+			ttl = d
+		}
+	}
+	return v.clk.Now().Add(ttl)
+}
+
+// rrsetOf returns the records in section with the given owner name and type.
+func rrsetOf(section []dns.RR, name string, rrtype uint16) []dns.RR {
+	var rrset []dns.RR
+	for _, rr := range section {
+		if rr.Header().Rrtype == rrtype && dns.CanonicalName(rr.Header().Name) == name {
+			rrset = append(rrset, rr)
+		}
+	}
+	return rrset
+}
+
+func hasType(types []uint16, rrtype uint16) bool {
+	for _, t := range types {
+		if t == rrtype {
+			return true
+		}
+	}
+	return false
+}
+
+// canonicalCompare compares two names in the canonical order of RFC 4034
+// Section 6.1, returning a negative number, zero or a positive number if a
+// sorts before, the same as or after b.
+func canonicalCompare(a, b string) int {
+	la := dns.SplitDomainName(dns.CanonicalName(a))
+	lb := dns.SplitDomainName(dns.CanonicalName(b))
+	for i, j := len(la)-1, len(lb)-1; i >= 0 && j >= 0; i, j = i-1, j-1 {
+		if c := strings.Compare(la[i], lb[j]); c != 0 {
+			return c
+		}
+	}
+	return len(la) - len(lb)
+}
+
+// wildcardOf returns the wildcard name directly beneath name.
+func wildcardOf(name string) string {
+	if name == "." {
+		return "*."
+	}
+	return "*." + name
+}
+
+// nsecCovers reports whether name falls strictly between the owner and next
+// names of nsec, meaning the name doesn't exist unless it's an empty
+// non-terminal.
+func nsecCovers(nsec *dns.NSEC, name string) bool {
+	owner, next := nsec.Hdr.Name, nsec.NextDomain
+	if canonicalCompare(owner, name) >= 0 {
+		return false
+	}
+	// The last NSEC in a zone points back to the apex.
+	return canonicalCompare(owner, next) >= 0 || canonicalCompare(name, next) < 0
+}
+
+// nsecCovered reports whether any of nsecs proves that name doesn't exist.
+func nsecCovered(nsecs []*dns.NSEC, name string) bool {
+	for _, nsec := range nsecs {
+		if nsecCovers(nsec, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// nsecClosestEncloser returns the closest encloser of name, its longest
+// existing ancestor, given the NSEC which covers it: the longest ancestor of
+// name shared with either end of the NSEC.
+func nsecClosestEncloser(nsec *dns.NSEC, name string) string {
+	labels := dns.SplitDomainName(name)
+	shared := dns.CompareDomainName(name, dns.CanonicalName(nsec.Hdr.Name))
+	if n := dns.CompareDomainName(name, dns.CanonicalName(nsec.NextDomain)); n > shared {
+		shared = n
+	}
+	return dns.Fqdn(strings.Join(labels[len(labels)-shared:], "."))
+}
+
+// nsecNameError reports whether nsecs prove that name doesn't exist and
+// wasn't synthesized from a wildcard (RFC 4035 Section 5.4).
+func nsecNameError(nsecs []*dns.NSEC, name string) bool {
+	for _, nsec := range nsecs {
+		if !nsecCovers(nsec, name) || dns.IsSubDomain(name, dns.CanonicalName(nsec.NextDomain)) {
+			// Names with descendants are empty non-terminals, not missing.
+			continue
+		}
+		if nsecCovered(nsecs, wildcardOf(nsecClosestEncloser(nsec, name))) {
+			return true
+		}
+	}
+	return false
+}
+
+// nsecNoData reports whether nsecs prove that name has no records of qtype:
+// directly, because name is an empty non-terminal, or because name doesn't
+// exist and the wildcard it would be expanded from has no records of qtype
+// (RFC 4035 Section 5.4).
+func nsecNoData(nsecs []*dns.NSEC, name string, qtype uint16) bool {
+	noType := func(owner string) bool {
+		for _, nsec := range nsecs {
+			if dns.CanonicalName(nsec.Hdr.Name) == owner {
+				return !hasType(nsec.TypeBitMap, qtype) && !hasType(nsec.TypeBitMap, dns.TypeCNAME)
+			}
+		}
+		return false
+	}
+	if noType(name) {
+		return true
+	}
+	for _, nsec := range nsecs {
+		if !nsecCovers(nsec, name) {
+			continue
+		}
+		if dns.IsSubDomain(name, dns.CanonicalName(nsec.NextDomain)) {
+			return true
+		}
+		if noType(wildcardOf(nsecClosestEncloser(nsec, name))) {
+			return true
+		}
+	}
+	return false
+}
+
+// nsec3Covered reports whether any of nsec3s proves that name doesn't exist.
+func nsec3Covered(nsec3s []*dns.NSEC3, name string) bool {
+	for _, nsec3 := range nsec3s {
+		if nsec3.Cover(name) && !nsec3.Match(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// nsec3ClosestEncloser finds the closest encloser proof for name within zone
+// (RFC 5155 Section 8.3). It returns the closest encloser, the longest
+// existing ancestor of name, or "" if there's no proof.
+func nsec3ClosestEncloser(nsec3s []*dns.NSEC3, name, zone string) string {
+	labels := dns.SplitDomainName(name)
+	for i := 1; i <= len(labels); i++ {
+		candidate := dns.Fqdn(strings.Join(labels[i:], "."))
+		if !dns.IsSubDomain(zone, candidate) {
+			return ""
+		}
+		for _, nsec3 := range nsec3s {
+			if nsec3.Match(candidate) {
+				nextCloser := dns.Fqdn(strings.Join(labels[i-1:], "."))
+				if nsec3Covered(nsec3s, nextCloser) {
+					return candidate
+				}
+				return ""
+			}
+		}
+	}
+	return ""
+}
+
+// nsec3NameError reports whether nsec3s prove that name doesn't exist and
+// wasn't synthesized from a wildcard (RFC 5155 Section 8.4).
+func nsec3NameError(nsec3s []*dns.NSEC3, name, zone string) bool {
+	ce := nsec3ClosestEncloser(nsec3s, name, zone)
+	return ce != "" && nsec3Covered(nsec3s, wildcardOf(ce))
+}
+
+// nsec3NoData reports whether nsec3s prove that name has no records of
+// qtype, either directly or at the wildcard it would be expanded from (RFC
+// 5155 Sections 8.5 and 8.7).
+func nsec3NoData(nsec3s []*dns.NSEC3, name, zone string, qtype uint16) bool {
+	noType := func(owner string) bool {
+		for _, nsec3 := range nsec3s {
+			if nsec3.Match(owner) {
+				return !hasType(nsec3.TypeBitMap, qtype) && !hasType(nsec3.TypeBitMap, dns.TypeCNAME)
+			}
+		}
+		return false
+	}
+	if noType(name) {
+		return true
+	}
+	ce := nsec3ClosestEncloser(nsec3s, name, zone)
+	return ce != "" && noType(wildcardOf(ce))
+}
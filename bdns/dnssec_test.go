@@ -0,0 +1,604 @@
+package bdns
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/miekg/dns"
+	"github.com/prometheus/client_golang/prometheus"
+
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// testZone is a zone served by signingExchanger.
+type testZone struct {
+	name string
+	// key and priv are nil for an unsigned zone.
+	key      *dns.DNSKEY
+	priv     crypto.Signer
+	useNSEC3 bool
+	records  []dns.RR
+}
+
+func newTestZone(t *testing.T, name string, signed, nsec3 bool, records ...string) *testZone {
+	t.Helper()
+	z := &testZone{name: name, useNSEC3: nsec3}
+	records = append(records,
+		name+" 3600 IN SOA ns.test. hostmaster.test. 1 7200 3600 86400 300",
+		name+" 3600 IN NS ns.test.")
+	for _, r := range records {
+		rr, err := dns.NewRR(r)
+		test.AssertNotError(t, err, "parsing record")
+		z.records = append(z.records, rr)
+	}
+	if signed {
+		z.key = &dns.DNSKEY{
+			Hdr:       dns.RR_Header{Name: name, Rrtype: dns.TypeDNSKEY, Class: dns.ClassINET, Ttl: 3600},
+			Flags:     dns.ZONE | dns.SEP,
+			Protocol:  3,
+			Algorithm: dns.ECDSAP256SHA256,
+		}
+		priv, err := z.key.Generate(256)
+		test.AssertNotError(t, err, "generating key")
+		z.priv = priv.(crypto.Signer)
+		z.records = append(z.records, z.key)
+	}
+	return z
+}
+
+// delegate adds the NS record, and the DS record if child is signed, for
+// child to z.
+func (z *testZone) delegate(child *testZone) {
+	z.records = append(z.records, &dns.NS{
+		Hdr: dns.RR_Header{Name: child.name, Rrtype: dns.TypeNS, Class: dns.ClassINET, Ttl: 3600},
+		Ns:  "ns.test.",
+	})
+	if child.key != nil {
+		z.records = append(z.records, child.key.ToDS(dns.SHA256))
+	}
+}
+
+func parentOf(name string) string {
+	return dns.Fqdn(strings.Join(dns.SplitDomainName(name)[1:], "."))
+}
+
+func (z *testZone) rrset(name string, rrtype uint16) []dns.RR {
+	return rrsetOf(z.records, name, rrtype)
+}
+
+// exists reports whether name has records in z, or is an empty non-terminal.
+func (z *testZone) exists(name string) bool {
+	for _, rr := range z.records {
+		if dns.IsSubDomain(name, rr.Header().Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// names returns the names in z in canonical order, including empty
+// non-terminals if withENTs is set.
+func (z *testZone) names(withENTs bool) []string {
+	seen := make(map[string]bool)
+	for _, rr := range z.records {
+		name := rr.Header().Name
+		seen[name] = true
+		for withENTs && name != z.name {
+			name = parentOf(name)
+			seen[name] = true
+		}
+	}
+	var names []string
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return canonicalCompare(names[i], names[j]) < 0 })
+	return names
+}
+
+func (z *testZone) types(name string) []uint16 {
+	types := []uint16{dns.TypeRRSIG}
+	for _, rr := range z.records {
+		if rr.Header().Name == name && !hasType(types, rr.Header().Rrtype) {
+			types = append(types, rr.Header().Rrtype)
+		}
+	}
+	if !z.useNSEC3 {
+		types = append(types, dns.TypeNSEC)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// nsec returns the NSEC record which matches or covers name.
+func (z *testZone) nsec(name string) dns.RR {
+	names := z.names(false)
+	i := sort.Search(len(names), func(i int) bool { return canonicalCompare(names[i], name) > 0 }) - 1
+	return &dns.NSEC{
+		Hdr:        dns.RR_Header{Name: names[i], Rrtype: dns.TypeNSEC, Class: dns.ClassINET, Ttl: 300},
+		NextDomain: names[(i+1)%len(names)],
+		TypeBitMap: z.types(names[i]),
+	}
+}
+
+// nsec3 returns the NSEC3 record which matches or covers name.
+func (z *testZone) nsec3(name string) dns.RR {
+	hashes := make(map[string]string)
+	var sorted []string
+	for _, n := range z.names(true) {
+		h := dns.HashName(n, dns.SHA1, 0, "")
+		hashes[h] = n
+		sorted = append(sorted, h)
+	}
+	sort.Strings(sorted)
+	target := dns.HashName(name, dns.SHA1, 0, "")
+	i := sort.Search(len(sorted), func(i int) bool { return sorted[i] > target }) - 1
+	if i < 0 {
+		// Hashes before the first are covered by the last NSEC3.
+		i = len(sorted) - 1
+	}
+	return &dns.NSEC3{
+		Hdr:        dns.RR_Header{Name: sorted[i] + "." + z.name, Rrtype: dns.TypeNSEC3, Class: dns.ClassINET, Ttl: 300},
+		Hash:       dns.SHA1,
+		HashLength: 20,
+		NextDomain: sorted[(i+1)%len(sorted)],
+		TypeBitMap: z.types(hashes[sorted[i]]),
+	}
+}
+
+// signingExchanger answers queries from a set of zones as a recursive
+// resolver would, with signatures generated as they're needed.
+type signingExchanger struct {
+	zones                 []*testZone
+	inception, expiration uint32
+	// mangle, if set, modifies responses before they're returned.
+	mangle  func(m *dns.Msg)
+	queries []*dns.Msg
+}
+
+func (e *signingExchanger) Exchange(q *dns.Msg, _ string) (*dns.Msg, time.Duration, error) {
+	e.queries = append(e.queries, q)
+	m := new(dns.Msg)
+	m.SetReply(q)
+	err := e.resolve(m, dns.CanonicalName(q.Question[0].Name), q.Question[0].Qtype)
+	if err != nil {
+		return nil, 0, err
+	}
+	if e.mangle != nil {
+		e.mangle(m)
+	}
+	return m, 0, nil
+}
+
+func (e *signingExchanger) zoneFor(name string, qtype uint16) *testZone {
+	var found *testZone
+	for _, z := range e.zones {
+		if !dns.IsSubDomain(z.name, name) || (qtype == dns.TypeDS && z.name == name) {
+			continue
+		}
+		if found == nil || dns.CountLabel(z.name) > dns.CountLabel(found.name) {
+			found = z
+		}
+	}
+	return found
+}
+
+func (e *signingExchanger) sign(z *testZone, section *[]dns.RR, rrset []dns.RR) error {
+	*section = append(*section, rrset...)
+	if z.key == nil {
+		return nil
+	}
+	sig := &dns.RRSIG{
+		Algorithm:  z.key.Algorithm,
+		KeyTag:     z.key.KeyTag(),
+		SignerName: z.name,
+		Inception:  e.inception,
+		Expiration: e.expiration,
+	}
+	sig.Hdr.Ttl = rrset[0].Header().Ttl
+	err := sig.Sign(z.priv, rrset)
+	if err != nil {
+		return err
+	}
+	*section = append(*section, sig)
+	return nil
+}
+
+func (e *signingExchanger) resolve(m *dns.Msg, name string, qtype uint16) error {
+	z := e.zoneFor(name, qtype)
+	if rrset := z.rrset(name, qtype); len(rrset) > 0 {
+		return e.sign(z, &m.Answer, rrset)
+	}
+	if cname := z.rrset(name, dns.TypeCNAME); len(cname) > 0 {
+		err := e.sign(z, &m.Answer, cname)
+		if err != nil {
+			return err
+		}
+		return e.resolve(m, dns.CanonicalName(cname[0].(*dns.CNAME).Target), qtype)
+	}
+
+	var denial []dns.RR
+	if z.exists(name) {
+		if z.useNSEC3 {
+			denial = append(denial, z.nsec3(name))
+		} else {
+			denial = append(denial, z.nsec(name))
+		}
+	} else {
+		ce := name
+		for !z.exists(ce) {
+			ce = parentOf(ce)
+		}
+		labels := dns.SplitDomainName(name)
+		nextCloser := dns.Fqdn(strings.Join(labels[len(labels)-dns.CountLabel(ce)-1:], "."))
+		wildcard := wildcardOf(ce)
+		if z.useNSEC3 {
+			denial = append(denial, z.nsec3(ce), z.nsec3(nextCloser))
+		} else {
+			denial = append(denial, z.nsec(name))
+		}
+
+		if rrset := z.rrset(wildcard, qtype); len(rrset) > 0 {
+			// Synthesize the answer from the wildcard, with its signature.
+			var answer []dns.RR
+			err := e.sign(z, &answer, rrset)
+			if err != nil {
+				return err
+			}
+			for _, rr := range answer {
+				rr = dns.Copy(rr)
+				rr.Header().Name = name
+				m.Answer = append(m.Answer, rr)
+			}
+			for _, rr := range denial {
+				err := e.sign(z, &m.Ns, []dns.RR{rr})
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		if z.useNSEC3 {
+			denial = append(denial, z.nsec3(wildcard))
+		} else {
+			denial = append(denial, z.nsec(wildcard))
+		}
+		if !z.exists(wildcard) {
+			m.Rcode = dns.RcodeNameError
+		}
+	}
+
+	err := e.sign(z, &m.Ns, z.rrset(z.name, dns.TypeSOA))
+	if err != nil {
+		return err
+	}
+	if z.key == nil {
+		return nil
+	}
+	seen := make(map[string]bool)
+	for _, rr := range denial {
+		if seen[rr.Header().Name] {
+			continue
+		}
+		seen[rr.Header().Name] = true
+		err := e.sign(z, &m.Ns, []dns.RR{rr})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setupDNSSEC returns a validating client for a hierarchy of test zones, the
+// exchanger serving them, and the client's clock.
+func setupDNSSEC(t *testing.T) (*impl, *signingExchanger, clock.FakeClock) {
+	t.Helper()
+	root := newTestZone(t, ".", true, false)
+	com := newTestZone(t, "com.", true, false)
+	example := newTestZone(t, "example.com.", true, false,
+		"example.com. 300 IN A 192.0.2.1",
+		"_acme-challenge.example.com. 300 IN TXT \"secure\"",
+		"caa.example.com. 300 IN CAA 0 issue \"letsencrypt.org\"",
+		"alias.example.com. 300 IN CNAME www.insecure.com.",
+		"wild.example.com. 300 IN A 192.0.2.2",
+		"*.wild.example.com. 300 IN TXT \"wildcard\"")
+	insecure := newTestZone(t, "insecure.com.", false, false,
+		"www.insecure.com. 300 IN A 192.0.2.3",
+		"_acme-challenge.insecure.com. 300 IN TXT \"insecure\"")
+	nsec3 := newTestZone(t, "nsec3.com.", true, true,
+		"nsec3.com. 300 IN AAAA 2001:db8::1",
+		"_acme-challenge.nsec3.com. 300 IN TXT \"nsec3\"")
+	root.delegate(com)
+	for _, z := range []*testZone{example, insecure, nsec3} {
+		com.delegate(z)
+	}
+
+	fc := clock.NewFake()
+	fc.Set(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	e := &signingExchanger{
+		zones:      []*testZone{root, com, example, insecure, nsec3},
+		inception:  uint32(fc.Now().Add(-time.Hour).Unix()),
+		expiration: uint32(fc.Now().Add(24 * time.Hour).Unix()),
+	}
+	anchors := []*dns.DS{root.key.ToDS(dns.SHA256)}
+	client := NewTest(time.Second, []string{dnsLoopbackAddr}, anchors, metrics.NoopRegisterer, fc, 1, blog.UseMock()).(*impl)
+	client.dnsClient = e
+	return client, e, fc
+}
+
+func TestDNSSECLookupTXT(t *testing.T) {
+	testCases := []struct {
+		name     string
+		hostname string
+		txts     []string
+		security Security
+	}{
+		{"signed zone", "_acme-challenge.example.com", []string{"secure"}, Secure},
+		{"unsigned delegation", "_acme-challenge.insecure.com", []string{"insecure"}, Insecure},
+		{"NSEC3 zone", "_acme-challenge.nsec3.com", []string{"nsec3"}, Secure},
+		{"wildcard", "_acme-challenge.x.wild.example.com", []string{"wildcard"}, Secure},
+		{"no records", "example.com", nil, Secure},
+		{"no records in NSEC3 zone", "nsec3.com", nil, Secure},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, e, _ := setupDNSSEC(t)
+			txts, security, err := client.LookupTXT(context.Background(), tc.hostname)
+			test.AssertNotError(t, err, "LookupTXT failed")
+			test.AssertDeepEquals(t, txts, tc.txts)
+			test.AssertEquals(t, security, tc.security)
+			for _, q := range e.queries {
+				test.Assert(t, q.IsEdns0().Do(), "query didn't set the DO bit")
+				test.Assert(t, q.CheckingDisabled, "query didn't set the CD bit")
+			}
+		})
+	}
+}
+
+func TestDNSSECLookupHost(t *testing.T) {
+	client, _, _ := setupDNSSEC(t)
+
+	// example.com has only an A record, so this also validates the proof that
+	// it has no AAAA records.
+	addrs, security, err := client.LookupHost(context.Background(), "example.com")
+	test.AssertNotError(t, err, "LookupHost failed")
+	test.AssertEquals(t, len(addrs), 1)
+	test.AssertEquals(t, addrs[0].String(), "192.0.2.1")
+	test.AssertEquals(t, security, Secure)
+
+	addrs, security, err = client.LookupHost(context.Background(), "nsec3.com")
+	test.AssertNotError(t, err, "LookupHost failed")
+	test.AssertEquals(t, len(addrs), 1)
+	test.AssertEquals(t, addrs[0].String(), "2001:db8::1")
+	test.AssertEquals(t, security, Secure)
+
+	// A CNAME from a signed zone into an unsigned one is only as secure as
+	// the unsigned zone.
+	addrs, security, err = client.LookupHost(context.Background(), "alias.example.com")
+	test.AssertNotError(t, err, "LookupHost failed")
+	test.AssertEquals(t, len(addrs), 1)
+	test.AssertEquals(t, addrs[0].String(), "192.0.2.3")
+	test.AssertEquals(t, security, Insecure)
+}
+
+func TestDNSSECLookupCAA(t *testing.T) {
+	client, _, _ := setupDNSSEC(t)
+	for _, hostname := range []string{"example.com", "missing.example.com", "x.wild.example.com", "nsec3.com", "missing.nsec3.com"} {
+		caas, _, err := client.LookupCAA(context.Background(), hostname)
+		test.AssertNotError(t, err, "LookupCAA of "+hostname+" failed")
+		test.AssertEquals(t, len(caas), 0)
+	}
+	caas, _, err := client.LookupCAA(context.Background(), "caa.example.com")
+	test.AssertNotError(t, err, "LookupCAA failed")
+	test.AssertEquals(t, len(caas), 1)
+}
+
+func TestDNSSECBogus(t *testing.T) {
+	stripSigs := func(m *dns.Msg) {
+		if m.Question[0].Qtype != dns.TypeTXT {
+			return
+		}
+		var answer []dns.RR
+		for _, rr := range m.Answer {
+			if rr.Header().Rrtype != dns.TypeRRSIG {
+				answer = append(answer, rr)
+			}
+		}
+		m.Answer = answer
+	}
+	tamper := func(m *dns.Msg) {
+		for _, rr := range m.Answer {
+			if txt, ok := rr.(*dns.TXT); ok {
+				txt.Txt = []string{"forged"}
+			}
+		}
+	}
+	// hideCAA replaces CAA records with an empty answer, as an attacker who
+	// wants to get around a domain's CAA policy would.
+	hideCAA := func(m *dns.Msg) {
+		if m.Question[0].Qtype == dns.TypeCAA {
+			m.Answer = nil
+		}
+	}
+	// stripDS makes example.com look like an unsigned delegation.
+	stripDS := func(m *dns.Msg) {
+		if m.Question[0].Qtype == dns.TypeDS && m.Question[0].Name == "example.com." {
+			m.Answer = nil
+		}
+	}
+	forgeA := func(m *dns.Msg) {
+		for _, rr := range m.Answer {
+			if a, ok := rr.(*dns.A); ok {
+				a.A = net.ParseIP("203.0.113.1")
+			}
+		}
+	}
+	testCases := []struct {
+		name   string
+		mangle func(*dns.Msg)
+		lookup func(*impl) error
+		reason string
+	}{
+		{
+			name:   "missing signatures",
+			mangle: stripSigs,
+			lookup: func(c *impl) error {
+				_, _, err := c.LookupTXT(context.Background(), "_acme-challenge.example.com")
+				return err
+			},
+			reason: "no signature over _acme-challenge.example.com. TXT",
+		},
+		{
+			name:   "tampered answer",
+			mangle: tamper,
+			lookup: func(c *impl) error {
+				_, _, err := c.LookupTXT(context.Background(), "_acme-challenge.nsec3.com")
+				return err
+			},
+			reason: "signature which doesn't verify over _acme-challenge.nsec3.com. TXT",
+		},
+		{
+			name:   "hidden CAA records",
+			mangle: hideCAA,
+			lookup: func(c *impl) error {
+				_, _, err := c.LookupCAA(context.Background(), "caa.example.com")
+				return err
+			},
+			reason: "no proof that caa.example.com. has no CAA records",
+		},
+		{
+			name:   "stripped DS records",
+			mangle: stripDS,
+			lookup: func(c *impl) error {
+				_, _, err := c.LookupTXT(context.Background(), "_acme-challenge.example.com")
+				return err
+			},
+			reason: "no proof that example.com. has no DS records",
+		},
+		{
+			name:   "forged A record",
+			mangle: forgeA,
+			lookup: func(c *impl) error {
+				// The AAAA lookup succeeds, but the lookup as a whole fails.
+				_, _, err := c.LookupHost(context.Background(), "example.com")
+				return err
+			},
+			reason: "signature which doesn't verify over example.com. A",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, e, _ := setupDNSSEC(t)
+			e.mangle = tc.mangle
+			err := tc.lookup(client)
+			test.AssertError(t, err, "lookup of a bogus answer succeeded")
+			test.AssertContains(t, err.Error(), "DNS problem: DNSSEC validation failure")
+			test.AssertContains(t, err.Error(), tc.reason)
+			dnsErr, ok := err.(*Error)
+			test.Assert(t, ok, "error wasn't a bdns.Error")
+			test.Assert(t, dnsErr.bogus(), "error wasn't bogus")
+		})
+	}
+}
+
+func TestDNSSECExpiredSignatures(t *testing.T) {
+	client, _, fc := setupDNSSEC(t)
+	fc.Add(48 * time.Hour)
+	_, _, err := client.LookupTXT(context.Background(), "_acme-challenge.example.com")
+	test.AssertError(t, err, "lookup with expired signatures succeeded")
+	test.AssertContains(t, err.Error(), "signature outside its validity period over . DNSKEY")
+	test.AssertEquals(t, test.CountCounter(client.dnssecCounter.With(prometheus.Labels{
+		"qtype":  "TXT",
+		"result": "bogus",
+	})), 1)
+}
+
+func TestDNSSECWrongTrustAnchor(t *testing.T) {
+	client, _, _ := setupDNSSEC(t)
+	other := newTestZone(t, ".", true, false)
+	client.validator.anchors = map[string][]*dns.DS{".": {other.key.ToDS(dns.SHA256)}}
+	_, _, err := client.LookupTXT(context.Background(), "_acme-challenge.example.com")
+	test.AssertError(t, err, "lookup with the wrong trust anchor succeeded")
+	test.AssertContains(t, err.Error(), "no DNSKEY for . matches its DS records")
+}
+
+func TestDNSSECDisabled(t *testing.T) {
+	client, e, _ := setupDNSSEC(t)
+	client.validator = nil
+	e.mangle = func(m *dns.Msg) { m.Answer = rrsetOf(m.Answer, "_acme-challenge.example.com.", dns.TypeTXT) }
+	txts, security, err := client.LookupTXT(context.Background(), "_acme-challenge.example.com")
+	test.AssertNotError(t, err, "LookupTXT failed")
+	test.AssertDeepEquals(t, txts, []string{"secure"})
+	test.AssertEquals(t, security, Unvalidated)
+	test.AssertEquals(t, len(e.queries), 1)
+	test.Assert(t, !e.queries[0].IsEdns0().Do(), "query set the DO bit")
+	test.Assert(t, !e.queries[0].CheckingDisabled, "query set the CD bit")
+}
+
+func TestDelegationCacheBounded(t *testing.T) {
+	fc := clock.NewFake()
+	v := newValidator(nil, nil, fc)
+	fetch := func() (delegation, error) {
+		return delegation{kind: notCut, expires: fc.Now().Add(time.Minute)}, nil
+	}
+
+	_, err := v.cached("expired.example.com.", fetch)
+	test.AssertNotError(t, err, "caching delegation")
+	fc.Add(time.Hour)
+	for i := 1; i < maxCachedDelegations; i++ {
+		_, err := v.cached(fmt.Sprintf("%d.example.com.", i), fetch)
+		test.AssertNotError(t, err, "caching delegation")
+	}
+	test.AssertEquals(t, len(v.delegations), maxCachedDelegations)
+
+	// Adding to a full cache purges expired delegations first, then others,
+	// until it's less than half full.
+	_, err = v.cached("new.example.com.", fetch)
+	test.AssertNotError(t, err, "caching delegation")
+	_, ok := v.delegations["expired.example.com."]
+	test.Assert(t, !ok, "expired delegation wasn't purged")
+	_, ok = v.delegations["new.example.com."]
+	test.Assert(t, ok, "new delegation wasn't cached")
+	test.AssertEquals(t, len(v.delegations), maxCachedDelegations/2)
+}
+
+func TestParseTrustAnchors(t *testing.T) {
+	anchors, err := ParseTrustAnchors([]string{
+		". IN DS 20326 8 2 E06D44B80B8F1D39A95C0B0D7C65D08458E880409BBC683457104237C7F8EC8D",
+	})
+	test.AssertNotError(t, err, "parsing root trust anchor")
+	test.AssertEquals(t, len(anchors), 1)
+	test.AssertEquals(t, anchors[0].KeyTag, uint16(20326))
+
+	_, err = ParseTrustAnchors([]string{". IN DNSKEY 257 3 8 AwEAAaz/tAm8yTn4Mfeh"})
+	test.AssertError(t, err, "parsed a DNSKEY as a trust anchor")
+	_, err = ParseTrustAnchors([]string{"not a record"})
+	test.AssertError(t, err, "parsed garbage as a trust anchor")
+}
+
+func TestCanonicalCompare(t *testing.T) {
+	// The example from RFC 4034 Section 6.1, without the names with escapes.
+	ordered := []string{
+		"example.",
+		"a.example.",
+		"yljkjljk.a.example.",
+		"Z.a.example.",
+		"zABC.a.EXAMPLE.",
+		"z.example.",
+		"*.z.example.",
+	}
+	for i := 0; i < len(ordered)-1; i++ {
+		test.Assert(t, canonicalCompare(ordered[i], ordered[i+1]) < 0, ordered[i]+" didn't sort before "+ordered[i+1])
+		test.Assert(t, canonicalCompare(ordered[i+1], ordered[i]) > 0, ordered[i+1]+" didn't sort after "+ordered[i])
+	}
+	test.AssertEquals(t, canonicalCompare("Example.", "example."), 0)
+}
@@ -34,6 +34,9 @@ func (d Error) Error() string {
 			// happens for `*net.OpError` underlying types!
 		} else if d.underlying == context.Canceled || d.underlying == context.DeadlineExceeded {
 			detail = detailDNSTimeout
+		} else if bogus, ok := d.underlying.(bogusError); ok {
+			detail = detailDNSSECBogus
+			additional = " - " + bogus.reason
 		} else {
 			detail = detailServerFailure
 		}
@@ -49,6 +52,12 @@ func (d Error) Error() string {
 		dns.TypeToString[d.recordType], d.hostname, additional)
 }
 
+// bogus returns true if the answer failed DNSSEC validation
+func (d Error) bogus() bool {
+	_, ok := d.underlying.(bogusError)
+	return ok
+}
+
 // Timeout returns true if the underlying error was a timeout
 func (d Error) Timeout() bool {
 	if netErr, ok := d.underlying.(*net.OpError); ok {
@@ -62,6 +71,7 @@ func (d Error) Timeout() bool {
 const detailDNSTimeout = "query timed out"
 const detailDNSNetFailure = "networking error"
 const detailServerFailure = "server failure at resolver"
+const detailDNSSECBogus = "DNSSEC validation failure"
 
 // rcodeExplanations provide additional friendly explanatory text to be included in DNS
 // error messages, for select inscrutable RCODEs.
@@ -35,6 +35,9 @@ func TestError(t *testing.T) {
 		}, {
 			&Error{dns.TypeA, "hostname", nil, dns.RcodeFormatError},
 			"DNS problem: FORMERR looking up A for hostname",
+		}, {
+			&Error{dns.TypeCAA, "hostname", bogusf("no signature over hostname. CAA"), -1},
+			"DNS problem: DNSSEC validation failure looking up CAA for hostname - no signature over hostname. CAA",
 		},
 	}
 	for _, tc := range testCases {
@@ -7,6 +7,7 @@ import (
 	"crypto/ecdsa"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
 	"encoding/asn1"
 	"encoding/hex"
@@ -16,6 +17,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/beeker1121/goque"
@@ -38,6 +40,7 @@ import (
 	"github.com/letsencrypt/boulder/features"
 	"github.com/letsencrypt/boulder/goodkey"
 	"github.com/letsencrypt/boulder/issuance"
+	"github.com/letsencrypt/boulder/lint"
 	blog "github.com/letsencrypt/boulder/log"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 )
@@ -127,8 +130,12 @@ type issuerMaps struct {
 // CertificateAuthorityImpl represents a CA that signs certificates, CRLs, and
 // OCSP responses.
 type CertificateAuthorityImpl struct {
-	sa                 certificateStorage
-	pa                 core.PolicyAuthority
+	sa certificateStorage
+	pa core.PolicyAuthority
+	// issuersMu guards issuers and ecdsaAllowedRegIDs, which ReloadIssuers
+	// replaces while the CA is running. Neither is modified in place, so
+	// readers only need the lock to fetch them; see currentIssuers.
+	issuersMu          sync.RWMutex
 	issuers            issuerMaps
 	ecdsaAllowedRegIDs map[int64]bool
 	cfsslRSAProfile    string
@@ -138,6 +145,7 @@ type CertificateAuthorityImpl struct {
 	backdate           time.Duration
 	maxNames           int
 	ocspLifetime       time.Duration
+	ignoredLints       []string
 	keyPolicy          goodkey.KeyPolicy
 	orphanQueue        *goque.Queue
 	ocspLogQueue       *ocspLogQueue
@@ -148,6 +156,7 @@ type CertificateAuthorityImpl struct {
 	orphanCount        *prometheus.CounterVec
 	adoptedOrphanCount *prometheus.CounterVec
 	signErrorCounter   *prometheus.CounterVec
+	lintErrorCount     *prometheus.CounterVec
 }
 
 // Issuer represents a single issuer certificate, along with its key.
@@ -169,13 +178,29 @@ type localSigner interface {
 type internalIssuer struct {
 	cert       *issuance.Certificate
 	ocspSigner crypto.Signer
+	// ocspResponderCert is the certificate of the delegated OCSP responder
+	// whose key is ocspSigner, or nil if ocspSigner is the issuer's own key.
+	ocspResponderCert *x509.Certificate
 
 	// Only one of cfsslSigner and boulderIssuer will be non-nill
 	cfsslSigner   localSigner
 	boulderIssuer *issuance.Issuer
+
+	// linter lints the issuer's OCSP responses and CRLs before they're
+	// signed. If it is nil they aren't linted.
+	linter *lint.RevocationLinter
+}
+
+// crlSigner returns the key which signs the issuer's CRLs. This is always the
+// issuer's own key, even if a delegated responder signs its OCSP responses.
+func (ii *internalIssuer) crlSigner() crypto.Signer {
+	if ii.boulderIssuer != nil {
+		return ii.boulderIssuer.Signer
+	}
+	return ii.ocspSigner
 }
 
-func makeInternalIssuers(issuers []*issuance.Issuer, lifespanOCSP time.Duration) (issuerMaps, error) {
+func makeInternalIssuers(issuers []*issuance.Issuer, lifespanOCSP time.Duration, ignoredLints []string) (issuerMaps, error) {
 	issuersByAlg := make(map[x509.PublicKeyAlgorithm]*internalIssuer, 2)
 	issuersByName := make(map[string]*internalIssuer, len(issuers))
 	issuersByID := make(map[issuance.IssuerID]*internalIssuer, len(issuers))
@@ -186,6 +211,15 @@ func makeInternalIssuers(issuers []*issuance.Issuer, lifespanOCSP time.Duration)
 			ocspSigner:    issuer.Signer,
 			boulderIssuer: issuer,
 		}
+		if responder := issuer.OCSPResponder(); responder != nil {
+			ii.ocspSigner = responder.Signer
+			ii.ocspResponderCert = responder.Cert.Certificate
+		}
+		linter, err := lint.NewRevocationLinter(issuer.Signer, ignoredLints)
+		if err != nil {
+			return issuerMaps{}, err
+		}
+		ii.linter = linter
 		for _, alg := range issuer.Algs() {
 			// TODO(#5259): Enforce that there is only one issuer for each algorithm,
 			// instead of taking the first issuer for each algorithm type.
@@ -203,7 +237,7 @@ func makeInternalIssuers(issuers []*issuance.Issuer, lifespanOCSP time.Duration)
 	return issuerMaps{issuersByAlg, issuersByName, issuersByID, issuersByNameID}, nil
 }
 
-func makeCFSSLInternalIssuers(issuers []Issuer, policy *cfsslConfig.Signing, lifespanOCSP time.Duration) (issuerMaps, error) {
+func makeCFSSLInternalIssuers(issuers []Issuer, policy *cfsslConfig.Signing, lifespanOCSP time.Duration, ignoredLints []string) (issuerMaps, error) {
 	if len(issuers) == 0 {
 		return issuerMaps{}, errors.New("No issuers specified.")
 	}
@@ -224,10 +258,16 @@ func makeCFSSLInternalIssuers(issuers []Issuer, policy *cfsslConfig.Signing, lif
 			return issuerMaps{}, errors.New("Multiple issuer certs with the same CommonName are not supported")
 		}
 
+		linter, err := lint.NewRevocationLinter(iss.Signer, ignoredLints)
+		if err != nil {
+			return issuerMaps{}, err
+		}
+
 		ii := &internalIssuer{
 			cert:        iss.Cert,
 			cfsslSigner: cfsslSigner,
 			ocspSigner:  iss.Signer,
+			linter:      linter,
 		}
 
 		// Rather than reading a config to pick which issuer to use for each alg,
@@ -265,6 +305,7 @@ func NewCertificateAuthorityImpl(
 	serialPrefix int,
 	maxNames int,
 	ocspLifetime time.Duration,
+	ignoredRevocationLints []string,
 	keyPolicy goodkey.KeyPolicy,
 	orphanQueue *goque.Queue,
 	ocspLogMaxLength int,
@@ -289,7 +330,7 @@ func NewCertificateAuthorityImpl(
 	}
 	var issuers issuerMaps
 	if features.Enabled(features.NonCFSSLSigner) {
-		issuers, err = makeInternalIssuers(boulderIssuers, ocspLifetime)
+		issuers, err = makeInternalIssuers(boulderIssuers, ocspLifetime, ignoredRevocationLints)
 		if err != nil {
 			return nil, err
 		}
@@ -315,7 +356,7 @@ func NewCertificateAuthorityImpl(
 			}
 		}
 
-		issuers, err = makeCFSSLInternalIssuers(cfsslIssuers, cfsslConfigObj.Signing, ocspLifetime)
+		issuers, err = makeCFSSLInternalIssuers(cfsslIssuers, cfsslConfigObj.Signing, ocspLifetime, ignoredRevocationLints)
 		if err != nil {
 			return nil, err
 		}
@@ -325,10 +366,7 @@ func NewCertificateAuthorityImpl(
 		}
 	}
 
-	ecdsaAllowedRegIDsMap := make(map[int64]bool, len(ecdsaAllowedRegIDs))
-	for _, regID := range ecdsaAllowedRegIDs {
-		ecdsaAllowedRegIDsMap[regID] = true
-	}
+	ecdsaAllowedRegIDsMap := makeECDSAAllowlist(ecdsaAllowedRegIDs)
 
 	csrExtensionCount := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -368,6 +406,12 @@ func NewCertificateAuthorityImpl(
 	}, []string{"type"})
 	stats.MustRegister(signErrorCounter)
 
+	lintErrorCount := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "lint_errors",
+		Help: "Number of OCSP responses and CRLs which failed each lint before signing",
+	}, []string{"purpose", "lint"})
+	stats.MustRegister(lintErrorCount)
+
 	var ocspLogQueue *ocspLogQueue
 	if ocspLogMaxLength > 0 {
 		ocspLogQueue = newOCSPLogQueue(ocspLogMaxLength, ocspLogPeriod, stats, logger)
@@ -385,6 +429,7 @@ func NewCertificateAuthorityImpl(
 		prefix:             serialPrefix,
 		maxNames:           maxNames,
 		ocspLifetime:       ocspLifetime,
+		ignoredLints:       ignoredRevocationLints,
 		keyPolicy:          keyPolicy,
 		orphanQueue:        orphanQueue,
 		ocspLogQueue:       ocspLogQueue,
@@ -394,12 +439,118 @@ func NewCertificateAuthorityImpl(
 		orphanCount:        orphanCount,
 		adoptedOrphanCount: adoptedOrphanCount,
 		signErrorCounter:   signErrorCounter,
+		lintErrorCount:     lintErrorCount,
 		clk:                clk,
 	}
 
 	return ca, nil
 }
 
+func makeECDSAAllowlist(regIDs []int64) map[int64]bool {
+	allowlist := make(map[int64]bool, len(regIDs))
+	for _, regID := range regIDs {
+		allowlist[regID] = true
+	}
+	return allowlist
+}
+
+// currentIssuers returns the CA's current set of issuers. The internalIssuer
+// found in it stays usable even if ReloadIssuers retires it, so a request
+// should look up its issuer once and then sign only with that.
+func (ca *CertificateAuthorityImpl) currentIssuers() issuerMaps {
+	issuers, _ := ca.currentIssuersAndAllowlist()
+	return issuers
+}
+
+// currentIssuersAndAllowlist returns the CA's current set of issuers and
+// ECDSA allowlist, which ReloadIssuers replaces together.
+func (ca *CertificateAuthorityImpl) currentIssuersAndAllowlist() (issuerMaps, map[int64]bool) {
+	ca.issuersMu.RLock()
+	defer ca.issuersMu.RUnlock()
+	return ca.issuers, ca.ecdsaAllowedRegIDs
+}
+
+// ReloadIssuers replaces the CA's issuers, along with their profiles, and its
+// ECDSA allowlist. Every issuer, and its delegated OCSP responder if it has
+// one, must first produce a signature which verifies against its certificate;
+// if any fails, the CA keeps its existing issuers. Requests which are already
+// signing when the issuers are replaced finish with the issuer they started
+// with. Reloading is only supported by the non-CFSSL signer.
+func (ca *CertificateAuthorityImpl) ReloadIssuers(boulderIssuers []*issuance.Issuer, ecdsaAllowedRegIDs []int64) error {
+	if !features.Enabled(features.NonCFSSLSigner) {
+		return errors.New("reloading issuers is not supported by the CFSSL signer")
+	}
+	if len(boulderIssuers) == 0 {
+		return errors.New("no issuers specified")
+	}
+	for _, issuer := range boulderIssuers {
+		err := testSignature(issuer.Cert.Certificate, issuer.Signer)
+		if err != nil {
+			return fmt.Errorf("issuer %q failed test signature: %w", issuer.Name(), err)
+		}
+		if responder := issuer.OCSPResponder(); responder != nil {
+			err = testSignature(responder.Cert.Certificate, responder.Signer)
+			if err != nil {
+				return fmt.Errorf("OCSP responder for issuer %q failed test signature: %w", issuer.Name(), err)
+			}
+		}
+	}
+	issuers, err := makeInternalIssuers(boulderIssuers, ca.ocspLifetime, ca.ignoredLints)
+	if err != nil {
+		return err
+	}
+	allowlist := makeECDSAAllowlist(ecdsaAllowedRegIDs)
+
+	ca.issuersMu.Lock()
+	ca.issuers = issuers
+	ca.ecdsaAllowedRegIDs = allowlist
+	ca.issuersMu.Unlock()
+
+	names := make([]string, 0, len(boulderIssuers))
+	for _, issuer := range boulderIssuers {
+		names = append(names, issuer.Name())
+	}
+	ca.log.Infof("Reloaded issuers: %s", strings.Join(names, ", "))
+	return nil
+}
+
+// testSignature checks that signer holds the private key for cert by signing
+// a random digest and verifying the signature with the certificate's key.
+func testSignature(cert *x509.Certificate, signer crypto.Signer) error {
+	var sigAlg x509.SignatureAlgorithm
+	switch cert.PublicKeyAlgorithm {
+	case x509.RSA:
+		sigAlg = x509.SHA256WithRSA
+	case x509.ECDSA:
+		sigAlg = x509.ECDSAWithSHA256
+	default:
+		return fmt.Errorf("unsupported public key algorithm %s", cert.PublicKeyAlgorithm)
+	}
+	message := make([]byte, 32)
+	_, err := rand.Read(message)
+	if err != nil {
+		return err
+	}
+	digest := sha256.Sum256(message)
+	signature, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return err
+	}
+	return cert.CheckSignature(sigAlg, message, signature)
+}
+
+// noteLintErrors counts each lint which err reports as failed, if it is a
+// lint.FailedLintsError.
+func (ca *CertificateAuthorityImpl) noteLintErrors(purpose string, err error) {
+	var lintErr *lint.FailedLintsError
+	if !errors.As(err, &lintErr) {
+		return
+	}
+	for _, name := range lintErr.Names() {
+		ca.lintErrorCount.WithLabelValues(purpose, name).Inc()
+	}
+}
+
 // noteSignError is called after operations that may cause a CFSSL
 // or PKCS11 signing error.
 func (ca *CertificateAuthorityImpl) noteSignError(err error) {
@@ -507,7 +658,7 @@ func (ca *CertificateAuthorityImpl) GenerateOCSP(ctx context.Context, req *capb.
 		}
 		serial = serialInt
 		var ok bool
-		issuer, ok = ca.issuers.byID[issuance.IssuerID(req.IssuerID)]
+		issuer, ok = ca.currentIssuers().byID[issuance.IssuerID(req.IssuerID)]
 		if !ok {
 			return nil, fmt.Errorf("This CA doesn't have an issuer cert with ID %d", req.IssuerID)
 		}
@@ -521,7 +672,7 @@ func (ca *CertificateAuthorityImpl) GenerateOCSP(ctx context.Context, req *capb.
 
 		serial = cert.SerialNumber
 		cn := cert.Issuer.CommonName
-		issuer = ca.issuers.byName[cn]
+		issuer = ca.currentIssuers().byName[cn]
 		if issuer == nil {
 			return nil, fmt.Errorf("This CA doesn't have an issuer cert with CommonName %q", cn)
 		}
@@ -549,7 +700,24 @@ func (ca *CertificateAuthorityImpl) GenerateOCSP(ctx context.Context, req *capb.
 		ca.ocspLogQueue.enqueue(serial.Bytes(), now, ocsp.ResponseStatus(tbsResponse.Status))
 	}
 
-	ocspResponse, err := ocsp.CreateResponse(issuer.cert.Certificate, issuer.cert.Certificate, tbsResponse, issuer.ocspSigner)
+	// A response signed by a delegated responder includes the responder's
+	// certificate, so that clients can verify it chains to the issuer.
+	responderCert := issuer.cert.Certificate
+	if issuer.ocspResponderCert != nil {
+		responderCert = issuer.ocspResponderCert
+		tbsResponse.Certificate = responderCert
+	}
+
+	if issuer.linter != nil {
+		err := issuer.linter.LintOCSP(tbsResponse, issuer.cert.Certificate)
+		if err != nil {
+			ca.noteLintErrors("ocsp", err)
+			ca.log.AuditErrf("OCSP response linting failed: serial=[%s] err=[%v]", core.SerialToString(serial), err)
+			return nil, berrors.InternalServerError("OCSP response linting failed: %s", err)
+		}
+	}
+
+	ocspResponse, err := ocsp.CreateResponse(issuer.cert.Certificate, responderCert, tbsResponse, issuer.ocspSigner)
 	ca.noteSignError(err)
 	if err == nil {
 		ca.signatureCount.With(prometheus.Labels{"purpose": "ocsp"}).Inc()
@@ -563,7 +731,20 @@ func (ca *CertificateAuthorityImpl) IssuePrecertificate(ctx context.Context, iss
 		return nil, berrors.InternalServerError("Incomplete issue certificate request")
 	}
 
-	serialBigInt, validity, err := ca.generateSerialNumberAndValidity()
+	validityPeriod, err := ca.validityPeriodForProfile(issueReq.CertificateProfileName)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestedNotBefore, requestedNotAfter time.Time
+	if issueReq.NotBefore != 0 {
+		requestedNotBefore = time.Unix(0, issueReq.NotBefore)
+	}
+	if issueReq.NotAfter != 0 {
+		requestedNotAfter = time.Unix(0, issueReq.NotAfter)
+	}
+
+	serialBigInt, validity, err := ca.generateSerialNumberAndValidity(validityPeriod, requestedNotBefore, requestedNotAfter)
 	if err != nil {
 		return nil, err
 	}
@@ -599,12 +780,18 @@ func (ca *CertificateAuthorityImpl) IssuePrecertificate(ctx context.Context, iss
 
 	issuerID := issuer.cert.ID()
 
+	crlShard, err := ca.crlShard(issuer, issueReq.CertificateProfileName, serialBigInt, validity.NotAfter)
+	if err != nil {
+		return nil, err
+	}
+
 	req := &sapb.AddCertificateRequest{
 		Der:      precertDER,
 		RegID:    regID,
 		Ocsp:     ocspResp.Response,
 		Issued:   nowNanos,
 		IssuerID: int64(issuerID),
+		CrlShard: crlShard,
 	}
 
 	_, err = ca.sa.AddPrecertificate(ctx, req)
@@ -622,6 +809,7 @@ func (ca *CertificateAuthorityImpl) IssuePrecertificate(ctx context.Context, iss
 				OCSPResp: ocspResp.Response,
 				Precert:  true,
 				IssuerID: int64(issuerID),
+				CRLShard: crlShard,
 			})
 		}
 		return nil, err
@@ -683,7 +871,7 @@ func (ca *CertificateAuthorityImpl) IssueCertificateForPrecertificate(ctx contex
 		scts = append(scts, sct)
 	}
 
-	issuer, ok := ca.issuers.byNameID[issuance.GetIssuerNameID(precert)]
+	issuer, ok := ca.currentIssuers().byNameID[issuance.GetIssuerNameID(precert)]
 	if !ok {
 		return nil, berrors.InternalServerError("no issuer found for Issuer Name %s", precert.Issuer)
 	}
@@ -694,6 +882,9 @@ func (ca *CertificateAuthorityImpl) IssueCertificateForPrecertificate(ctx contex
 		if err != nil {
 			return nil, err
 		}
+		// The final certificate must be issued using the same profile as the
+		// precertificate, or it won't match it.
+		issuanceReq.ProfileName = req.CertificateProfileName
 		certDER, err = issuer.boulderIssuer.Issue(issuanceReq)
 		if err != nil {
 			return nil, err
@@ -713,7 +904,7 @@ func (ca *CertificateAuthorityImpl) IssueCertificateForPrecertificate(ctx contex
 	}
 	ca.signatureCount.WithLabelValues(string(certType)).Inc()
 	ca.log.AuditInfof("Signing success: serial=[%s] names=[%s] csr=[%s] certificate=[%s]",
-		serialHex, strings.Join(precert.DNSNames, ", "), hex.EncodeToString(req.DER),
+		serialHex, strings.Join(core.SANNames(precert.DNSNames, precert.IPAddresses), ", "), hex.EncodeToString(req.DER),
 		hex.EncodeToString(certDER))
 	err = ca.storeCertificate(ctx, req.RegistrationID, req.OrderID, precert.SerialNumber, certDER, int64(issuer.cert.ID()))
 	if err != nil {
@@ -729,12 +920,61 @@ func (ca *CertificateAuthorityImpl) IssueCertificateForPrecertificate(ctx contex
 	}, nil
 }
 
+// crlShard returns the CRL shard a certificate issued by the given issuer
+// with the named profile is listed in, or nil if the profile doesn't shard
+// CRLs.
+func (ca *CertificateAuthorityImpl) crlShard(issuer *internalIssuer, profileName string, serial *big.Int, notAfter time.Time) (*sapb.CRLShard, error) {
+	if !features.Enabled(features.NonCFSSLSigner) {
+		return nil, nil
+	}
+	idx, sharded, err := issuer.boulderIssuer.CRLShard(&issuance.IssuanceRequest{
+		Serial:      serial.Bytes(),
+		NotAfter:    notAfter,
+		ProfileName: profileName,
+	})
+	if err != nil {
+		return nil, berrors.InternalServerError("failed to compute CRL shard: %s", err)
+	}
+	if !sharded {
+		return nil, nil
+	}
+	return &sapb.CRLShard{Idx: int64(idx)}, nil
+}
+
 type validity struct {
 	NotBefore time.Time
 	NotAfter  time.Time
 }
 
-func (ca *CertificateAuthorityImpl) generateSerialNumberAndValidity() (*big.Int, validity, error) {
+// validityPeriodForProfile returns the validity period of certificates issued
+// using the named certificate profile, or the CA's configured validity period
+// if no profile is named.
+func (ca *CertificateAuthorityImpl) validityPeriodForProfile(name string) (time.Duration, error) {
+	if name == "" {
+		return ca.validityPeriod, nil
+	}
+	if !features.Enabled(features.NonCFSSLSigner) {
+		return 0, berrors.InternalServerError("certificate profiles are not supported by the CFSSL signer")
+	}
+	// Every issuer is loaded with the same set of named profiles, so the first
+	// one which knows the profile is as good as any other.
+	for _, issuer := range ca.currentIssuers().byNameID {
+		validityPeriod, err := issuer.boulderIssuer.ProfileValidity(name)
+		if err == nil {
+			return validityPeriod, nil
+		}
+	}
+	return 0, berrors.InternalServerError("unknown certificate profile %q", name)
+}
+
+// generateSerialNumberAndValidity generates a random serial number and picks
+// the validity period of a new certificate. The requested NotBefore and
+// NotAfter are used in place of the CA's own choice when they are non-zero,
+// provided they don't make it valid for longer than validityPeriod. The RA
+// validates a requested NotBefore when the order is created, so one which has
+// since fallen further into the past than the CA backdates certificates is
+// clamped to the backdated issuance time rather than rejected.
+func (ca *CertificateAuthorityImpl) generateSerialNumberAndValidity(validityPeriod time.Duration, requestedNotBefore, requestedNotAfter time.Time) (*big.Int, validity, error) {
 	// We want 136 bits of random number, plus an 8-bit instance id prefix.
 	const randBits = 136
 	serialBytes := make([]byte, randBits/8+1)
@@ -749,9 +989,25 @@ func (ca *CertificateAuthorityImpl) generateSerialNumberAndValidity() (*big.Int,
 	serialBigInt = serialBigInt.SetBytes(serialBytes)
 
 	notBefore := ca.clk.Now().Add(-1 * ca.backdate)
+	if requestedNotBefore.After(notBefore) {
+		notBefore = requestedNotBefore
+	}
+	notAfter := notBefore.Add(validityPeriod)
+	if !requestedNotAfter.IsZero() {
+		if !requestedNotAfter.After(notBefore) {
+			return nil, validity{}, berrors.MalformedError(
+				"requested notAfter %s is not after notBefore %s",
+				requestedNotAfter.UTC().Format(time.RFC3339), notBefore.UTC().Format(time.RFC3339))
+		}
+		if requestedNotAfter.Sub(notBefore) > validityPeriod {
+			return nil, validity{}, berrors.MalformedError(
+				"requested validity period is longer than the maximum of %s", validityPeriod)
+		}
+		notAfter = requestedNotAfter
+	}
 	validity := validity{
 		NotBefore: notBefore,
-		NotAfter:  notBefore.Add(ca.validityPeriod),
+		NotAfter:  notAfter,
 	}
 
 	return serialBigInt, validity, nil
@@ -782,6 +1038,7 @@ func (ca *CertificateAuthorityImpl) issuePrecertificateInner(ctx context.Context
 		return nil, nil, err
 	}
 
+	issuers, ecdsaAllowedRegIDs := ca.currentIssuersAndAllowlist()
 	var issuer *internalIssuer
 	var ok bool
 	if issueReq.IssuerNameID == 0 {
@@ -789,15 +1046,15 @@ func (ca *CertificateAuthorityImpl) issuePrecertificateInner(ctx context.Context
 		// contained in the CSR, unless we have an allowlist of registration IDs
 		// for ECDSA, in which case switch all not-allowed accounts to RSA issuance.
 		alg := csr.PublicKeyAlgorithm
-		if alg == x509.ECDSA && !features.Enabled(features.ECDSAForAll) && !ca.ecdsaAllowedRegIDs[issueReq.RegistrationID] {
+		if alg == x509.ECDSA && !features.Enabled(features.ECDSAForAll) && !ecdsaAllowedRegIDs[issueReq.RegistrationID] {
 			alg = x509.RSA
 		}
-		issuer, ok = ca.issuers.byAlg[alg]
+		issuer, ok = issuers.byAlg[alg]
 		if !ok {
 			return nil, nil, berrors.InternalServerError("no issuer found for public key algorithm %s", csr.PublicKeyAlgorithm)
 		}
 	} else {
-		issuer, ok = ca.issuers.byNameID[issuance.IssuerNameID(issueReq.IssuerNameID)]
+		issuer, ok = issuers.byNameID[issuance.IssuerNameID(issueReq.IssuerNameID)]
 		if !ok {
 			return nil, nil, berrors.InternalServerError("no issuer found for IssuerNameID %d", issueReq.IssuerNameID)
 		}
@@ -810,20 +1067,25 @@ func (ca *CertificateAuthorityImpl) issuePrecertificateInner(ctx context.Context
 	}
 
 	serialHex := core.SerialToString(serialBigInt)
+	// names holds both the DNS names and IP addresses from the CSR. CFSSL sorts
+	// the hosts it is given into DNS name and IP address SANs itself.
+	names := core.SANNames(csr.DNSNames, csr.IPAddresses)
 
 	var certDER []byte
 	if features.Enabled(features.NonCFSSLSigner) {
 		ca.log.AuditInfof("Signing: serial=[%s] names=[%s] csr=[%s]",
-			serialHex, strings.Join(csr.DNSNames, ", "), hex.EncodeToString(csr.Raw))
+			serialHex, strings.Join(names, ", "), hex.EncodeToString(csr.Raw))
 		certDER, err = issuer.boulderIssuer.Issue(&issuance.IssuanceRequest{
 			PublicKey:         csr.PublicKey,
 			Serial:            serialBigInt.Bytes(),
 			CommonName:        csr.Subject.CommonName,
 			DNSNames:          csr.DNSNames,
+			IPAddresses:       csr.IPAddresses,
 			IncludeCTPoison:   true,
 			IncludeMustStaple: issuance.ContainsMustStaple(csr.Extensions),
 			NotBefore:         validity.NotBefore,
 			NotAfter:          validity.NotAfter,
+			ProfileName:       issueReq.CertificateProfileName,
 		})
 		ca.noteSignError(err)
 		if err != nil {
@@ -854,7 +1116,7 @@ func (ca *CertificateAuthorityImpl) issuePrecertificateInner(ctx context.Context
 		req := signer.SignRequest{
 			Request: csrPEM,
 			Profile: profile,
-			Hosts:   csr.DNSNames,
+			Hosts:   names,
 			Subject: &signer.Subject{
 				CN: csr.Subject.CommonName,
 			},
@@ -866,7 +1128,7 @@ func (ca *CertificateAuthorityImpl) issuePrecertificateInner(ctx context.Context
 		}
 
 		ca.log.AuditInfof("Signing: serial=[%s] names=[%s] csr=[%s]",
-			serialHex, strings.Join(csr.DNSNames, ", "), hex.EncodeToString(csr.Raw))
+			serialHex, strings.Join(names, ", "), hex.EncodeToString(csr.Raw))
 
 		certPEM, err := issuer.cfsslSigner.Sign(req)
 		ca.noteSignError(err)
@@ -905,7 +1167,7 @@ func (ca *CertificateAuthorityImpl) issuePrecertificateInner(ctx context.Context
 	ca.signatureCount.WithLabelValues(string(precertType)).Inc()
 
 	ca.log.AuditInfof("Signing success: serial=[%s] names=[%s] csr=[%s] precertificate=[%s]",
-		serialHex, strings.Join(csr.DNSNames, ", "), hex.EncodeToString(csr.Raw),
+		serialHex, strings.Join(names, ", "), hex.EncodeToString(csr.Raw),
 		hex.EncodeToString(certDER))
 
 	return certDER, issuer, nil
@@ -946,6 +1208,7 @@ type orphanedCert struct {
 	RegID    int64
 	Precert  bool
 	IssuerID int64
+	CRLShard *sapb.CRLShard
 }
 
 func (ca *CertificateAuthorityImpl) queueOrphan(o *orphanedCert) {
@@ -1021,6 +1284,7 @@ func (ca *CertificateAuthorityImpl) integrateOrphan() error {
 			Ocsp:     orphan.OCSPResp,
 			Issued:   issuedNanos,
 			IssuerID: orphan.IssuerID,
+			CrlShard: orphan.CRLShard,
 		})
 		if err != nil && !errors.Is(err, berrors.Duplicate) {
 			return fmt.Errorf("failed to store orphaned precertificate: %s", err)
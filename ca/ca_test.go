@@ -344,6 +344,7 @@ func TestFailNoSerialPrefix(t *testing.T) {
 		0,
 		testCtx.maxNames,
 		testCtx.ocspLifetime,
+		nil,
 		testCtx.keyPolicy,
 		nil,
 		0,
@@ -457,6 +458,7 @@ func issueCertificateSubTestSetup(t *testing.T, boulderIssuer bool) (*Certificat
 		testCtx.serialPrefix,
 		testCtx.maxNames,
 		testCtx.ocspLifetime,
+		nil,
 		testCtx.keyPolicy,
 		nil,
 		0,
@@ -522,6 +524,7 @@ func TestMultipleIssuers(t *testing.T) {
 		testCtx.serialPrefix,
 		testCtx.maxNames,
 		testCtx.ocspLifetime,
+		nil,
 		testCtx.keyPolicy,
 		nil,
 		0,
@@ -590,6 +593,7 @@ func TestOCSP(t *testing.T) {
 		testCtx.serialPrefix,
 		testCtx.maxNames,
 		testCtx.ocspLifetime,
+		nil,
 		testCtx.keyPolicy,
 		nil,
 		0,
@@ -652,6 +656,7 @@ func TestOCSP(t *testing.T) {
 		testCtx.serialPrefix,
 		testCtx.maxNames,
 		testCtx.ocspLifetime,
+		nil,
 		testCtx.keyPolicy,
 		nil,
 		0,
@@ -760,6 +765,7 @@ func TestInvalidCSRs(t *testing.T) {
 			testCtx.serialPrefix,
 			testCtx.maxNames,
 			testCtx.ocspLifetime,
+			nil,
 			testCtx.keyPolicy,
 			nil,
 			0,
@@ -802,6 +808,7 @@ func TestRejectValidityTooLong(t *testing.T) {
 		testCtx.serialPrefix,
 		testCtx.maxNames,
 		testCtx.ocspLifetime,
+		nil,
 		testCtx.keyPolicy,
 		nil,
 		0,
@@ -864,6 +871,7 @@ func TestSingleAIAEnforcement(t *testing.T) {
 		1,
 		1,
 		time.Second,
+		nil,
 		goodkey.KeyPolicy{},
 		nil,
 		0,
@@ -983,6 +991,7 @@ func TestIssueCertificateForPrecertificate(t *testing.T) {
 			testCtx.serialPrefix,
 			testCtx.maxNames,
 			testCtx.ocspLifetime,
+			nil,
 			testCtx.keyPolicy,
 			nil,
 			0,
@@ -1040,6 +1049,97 @@ func TestIssueCertificateForPrecertificate(t *testing.T) {
 	}
 }
 
+func TestIssueWithCertificateProfile(t *testing.T) {
+	testCtx := setup(t)
+	_ = features.Set(map[string]bool{"NonCFSSLSigner": true})
+	defer features.Reset()
+
+	// The first issuer is ECDSA-only, the second issues for both key types.
+	for i, useForRSALeaves := range []bool{false, true} {
+		profile, err := issuance.NewProfile(
+			issuance.ProfileConfig{
+				AllowCTPoison:   true,
+				AllowSCTList:    true,
+				AllowCommonName: true,
+				Policies: []issuance.PolicyInformation{
+					{OID: "2.23.140.1.2.1"},
+				},
+				MaxValidityPeriod:   cmd.ConfigDuration{Duration: 24 * time.Hour},
+				MaxValidityBackdate: cmd.ConfigDuration{Duration: time.Hour},
+				ValidityPeriod:      cmd.ConfigDuration{Duration: 24 * time.Hour},
+				ExtKeyUsages:        []string{"clientAuth"},
+			},
+			issuance.IssuerConfig{
+				UseForECDSALeaves: true,
+				UseForRSALeaves:   useForRSALeaves,
+				IssuerURL:         "http://not-example.com/issuer-url",
+				OCSPURL:           "http://not-example.com/ocsp",
+				CRLURL:            "http://not-example.com/crl",
+			},
+		)
+		test.AssertNotError(t, err, "Failed to create profile")
+		err = testCtx.boulderIssuers[i].AddProfile("client-auth", profile)
+		test.AssertNotError(t, err, "Failed to add profile")
+	}
+
+	ca, err := NewCertificateAuthorityImpl(
+		&mockSA{},
+		testCtx.pa,
+		testCtx.cfsslProfiles,
+		testCtx.cfsslRSAProfile,
+		testCtx.cfsslECDSAProfile,
+		nil,
+		testCtx.boulderIssuers,
+		nil,
+		testCtx.certExpiry,
+		testCtx.certBackdate,
+		testCtx.serialPrefix,
+		testCtx.maxNames,
+		testCtx.ocspLifetime,
+		nil,
+		testCtx.keyPolicy,
+		nil,
+		0,
+		time.Second,
+		testCtx.logger,
+		testCtx.stats,
+		testCtx.fc)
+	test.AssertNotError(t, err, "Failed to create CA")
+
+	_, err = ca.IssuePrecertificate(ctx, &capb.IssueCertificateRequest{
+		Csr:                    CNandSANCSR,
+		RegistrationID:         arbitraryRegID,
+		CertificateProfileName: "unknown",
+	})
+	test.AssertError(t, err, "Issued precertificate with unknown profile")
+	test.AssertErrorIs(t, err, berrors.InternalServer)
+
+	precert, err := ca.IssuePrecertificate(ctx, &capb.IssueCertificateRequest{
+		Csr:                    CNandSANCSR,
+		RegistrationID:         arbitraryRegID,
+		CertificateProfileName: "client-auth",
+	})
+	test.AssertNotError(t, err, "Failed to issue precertificate")
+	parsedPrecert, err := x509.ParseCertificate(precert.DER)
+	test.AssertNotError(t, err, "Failed to parse precertificate")
+	test.AssertEquals(t, parsedPrecert.NotAfter.Sub(parsedPrecert.NotBefore), 24*time.Hour)
+	test.AssertDeepEquals(t, parsedPrecert.ExtKeyUsage, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth})
+
+	sctBytes, err := makeSCTs()
+	test.AssertNotError(t, err, "Failed to make SCTs")
+	cert, err := ca.IssueCertificateForPrecertificate(ctx, &capb.IssueCertificateForPrecertificateRequest{
+		DER:                    precert.DER,
+		SCTs:                   sctBytes,
+		RegistrationID:         arbitraryRegID,
+		CertificateProfileName: "client-auth",
+	})
+	test.AssertNotError(t, err, "Failed to issue certificate for precertificate")
+	parsedCert, err := x509.ParseCertificate(cert.Der)
+	test.AssertNotError(t, err, "Failed to parse certificate")
+	test.AssertEquals(t, parsedCert.NotAfter, parsedPrecert.NotAfter)
+	test.AssertDeepEquals(t, parsedCert.ExtKeyUsage, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth})
+}
+
 // dupeSA returns a non-error to GetCertificate in order to simulate a request
 // to issue a final certificate with a duplicate serial.
 type dupeSA struct {
@@ -1076,6 +1176,7 @@ func TestIssueCertificateForPrecertificateDuplicateSerial(t *testing.T) {
 		testCtx.serialPrefix,
 		testCtx.maxNames,
 		testCtx.ocspLifetime,
+		nil,
 		testCtx.keyPolicy,
 		nil,
 		0,
@@ -1123,6 +1224,7 @@ func TestIssueCertificateForPrecertificateDuplicateSerial(t *testing.T) {
 		testCtx.serialPrefix,
 		testCtx.maxNames,
 		testCtx.ocspLifetime,
+		nil,
 		testCtx.keyPolicy,
 		nil,
 		0,
@@ -1207,6 +1309,7 @@ func TestPrecertOrphanQueue(t *testing.T) {
 		testCtx.serialPrefix,
 		testCtx.maxNames,
 		testCtx.ocspLifetime,
+		nil,
 		testCtx.keyPolicy,
 		orphanQueue,
 		0,
@@ -1280,6 +1383,7 @@ func TestOrphanQueue(t *testing.T) {
 		testCtx.serialPrefix,
 		testCtx.maxNames,
 		testCtx.ocspLifetime,
+		nil,
 		testCtx.keyPolicy,
 		orphanQueue,
 		0,
@@ -1403,6 +1507,7 @@ func TestIssuePrecertificateLinting(t *testing.T) {
 		testCtx.serialPrefix,
 		testCtx.maxNames,
 		testCtx.ocspLifetime,
+		nil,
 		testCtx.keyPolicy,
 		nil,
 		0,
@@ -1470,6 +1575,7 @@ func TestGenerateOCSPWithIssuerID(t *testing.T) {
 		testCtx.serialPrefix,
 		testCtx.maxNames,
 		testCtx.ocspLifetime,
+		nil,
 		testCtx.keyPolicy,
 		nil,
 		0,
@@ -1506,3 +1612,151 @@ func TestGenerateOCSPWithIssuerID(t *testing.T) {
 	})
 	test.AssertNotError(t, err, "GenerateOCSP failed")
 }
+
+func TestGenerateSerialNumberAndValidity(t *testing.T) {
+	fc := clock.NewFake()
+	fc.Set(time.Date(2021, 4, 1, 12, 0, 0, 0, time.UTC))
+	ca := &CertificateAuthorityImpl{
+		clk:      fc,
+		log:      blog.NewMock(),
+		prefix:   17,
+		backdate: time.Hour,
+	}
+	now := fc.Now()
+	validityPeriod := 90 * 24 * time.Hour
+
+	testCases := []struct {
+		name              string
+		notBefore         time.Time
+		notAfter          time.Time
+		expectedNotBefore time.Time
+		expectedNotAfter  time.Time
+		expectedErr       string
+	}{
+		{
+			name:              "nothing requested",
+			expectedNotBefore: now.Add(-time.Hour),
+			expectedNotAfter:  now.Add(-time.Hour).Add(validityPeriod),
+		},
+		{
+			name:              "requested notBefore",
+			notBefore:         now.Add(24 * time.Hour),
+			expectedNotBefore: now.Add(24 * time.Hour),
+			expectedNotAfter:  now.Add(24 * time.Hour).Add(validityPeriod),
+		},
+		{
+			name:              "requested notAfter",
+			notAfter:          now.Add(6 * time.Hour),
+			expectedNotBefore: now.Add(-time.Hour),
+			expectedNotAfter:  now.Add(6 * time.Hour),
+		},
+		{
+			name:              "requested notBefore and notAfter",
+			notBefore:         now.Add(time.Hour),
+			notAfter:          now.Add(3 * time.Hour),
+			expectedNotBefore: now.Add(time.Hour),
+			expectedNotAfter:  now.Add(3 * time.Hour),
+		},
+		{
+			name:              "notBefore too far in the past",
+			notBefore:         now.Add(-2 * time.Hour),
+			expectedNotBefore: now.Add(-time.Hour),
+			expectedNotAfter:  now.Add(-time.Hour).Add(validityPeriod),
+		},
+		{
+			name:              "notBefore in the past with notAfter",
+			notBefore:         now.Add(-48 * time.Hour),
+			notAfter:          now.Add(24 * time.Hour),
+			expectedNotBefore: now.Add(-time.Hour),
+			expectedNotAfter:  now.Add(24 * time.Hour),
+		},
+		{
+			name:        "notAfter before notBefore",
+			notBefore:   now.Add(2 * time.Hour),
+			notAfter:    now.Add(time.Hour),
+			expectedErr: "requested notAfter 2021-04-01T13:00:00Z is not after notBefore 2021-04-01T14:00:00Z",
+		},
+		{
+			name:        "validity too long",
+			notAfter:    now.Add(validityPeriod),
+			expectedErr: "requested validity period is longer than the maximum of 2160h0m0s",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			serial, validity, err := ca.generateSerialNumberAndValidity(validityPeriod, tc.notBefore, tc.notAfter)
+			if tc.expectedErr != "" {
+				test.AssertError(t, err, "generateSerialNumberAndValidity didn't fail")
+				test.AssertErrorIs(t, err, berrors.Malformed)
+				test.AssertEquals(t, err.Error(), tc.expectedErr)
+				return
+			}
+			test.AssertNotError(t, err, "generateSerialNumberAndValidity failed")
+			test.AssertEquals(t, serial.Bytes()[0], byte(17))
+			test.AssertEquals(t, validity.NotBefore, tc.expectedNotBefore)
+			test.AssertEquals(t, validity.NotAfter, tc.expectedNotAfter)
+		})
+	}
+}
+
+func TestReloadIssuers(t *testing.T) {
+	ca := setupCRL(t)
+	ca.ecdsaAllowedRegIDs = map[int64]bool{1: true}
+	oldIssuer := ca.currentIssuers().byNameID[caCert.NameID()]
+
+	profile, err := issuance.NewProfile(
+		issuance.ProfileConfig{
+			AllowCTPoison:       true,
+			Policies:            []issuance.PolicyInformation{{OID: "2.23.140.1.2.1"}},
+			MaxValidityPeriod:   cmd.ConfigDuration{Duration: 24 * time.Hour},
+			MaxValidityBackdate: cmd.ConfigDuration{Duration: time.Hour},
+		},
+		issuance.IssuerConfig{
+			UseForRSALeaves:   true,
+			UseForECDSALeaves: true,
+			IssuerURL:         "http://not-example.com/issuer-url",
+			OCSPURL:           "http://not-example.com/ocsp",
+		},
+	)
+	test.AssertNotError(t, err, "Failed to create profile")
+	newIssuer := &issuance.Issuer{Cert: caCert2, Signer: caKey, Profile: profile}
+
+	err = ca.ReloadIssuers([]*issuance.Issuer{newIssuer}, []int64{2})
+	test.AssertError(t, err, "ReloadIssuers should fail with the CFSSL signer")
+
+	_ = features.Set(map[string]bool{"NonCFSSLSigner": true})
+	defer features.Reset()
+
+	err = ca.ReloadIssuers(nil, []int64{2})
+	test.AssertError(t, err, "ReloadIssuers should fail without any issuers")
+
+	// An issuer whose key doesn't match its certificate fails its test
+	// signature, and leaves the existing issuers in place.
+	wrongKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "Failed to generate key")
+	badIssuer := &issuance.Issuer{Cert: caCert2, Signer: wrongKey, Profile: profile}
+	err = ca.ReloadIssuers([]*issuance.Issuer{badIssuer}, []int64{2})
+	test.AssertError(t, err, "ReloadIssuers should fail with a mismatched key")
+	test.AssertContains(t, err.Error(), "test signature")
+	issuers, allowlist := ca.currentIssuersAndAllowlist()
+	test.AssertEquals(t, issuers.byNameID[caCert.NameID()], oldIssuer)
+	test.Assert(t, allowlist[1], "ECDSA allowlist shouldn't have changed")
+
+	err = ca.ReloadIssuers([]*issuance.Issuer{newIssuer}, []int64{2})
+	test.AssertNotError(t, err, "ReloadIssuers failed")
+	issuers, allowlist = ca.currentIssuersAndAllowlist()
+	_, ok := issuers.byNameID[caCert.NameID()]
+	test.Assert(t, !ok, "Retired issuer is still present")
+	test.AssertEquals(t, issuers.byNameID[caCert2.NameID()].boulderIssuer, newIssuer)
+	test.AssertEquals(t, issuers.byAlg[x509.ECDSA].boulderIssuer, newIssuer)
+	test.Assert(t, !allowlist[1], "Old ECDSA allowlist is still present")
+	test.Assert(t, allowlist[2], "New ECDSA allowlist wasn't loaded")
+
+	_, err = ca.GenerateCRL(context.Background(), &capb.GenerateCRLRequest{
+		IssuerNameID: int64(caCert2.NameID()),
+		ThisUpdate:   time.Date(2021, 4, 10, 0, 0, 0, 0, time.UTC).UnixNano(),
+		NextUpdate:   time.Date(2021, 4, 11, 0, 0, 0, 0, time.UTC).UnixNano(),
+		IdpURL:       "http://c.example.com/1234/3.crl",
+	})
+	test.AssertNotError(t, err, "GenerateCRL failed with the reloaded issuer")
+}
@@ -0,0 +1,160 @@
+package ca
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	capb "github.com/letsencrypt/boulder/ca/proto"
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/crl"
+	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/issuance"
+	"github.com/letsencrypt/boulder/x509crl"
+)
+
+// maxCRLValidity is the longest time between a CRL's thisUpdate and nextUpdate
+// which the Baseline Requirements (Section 4.9.7) allow for CRLs covering
+// subscriber certificates.
+const maxCRLValidity = 10 * 24 * time.Hour
+
+var (
+	oidReasonCode               = asn1.ObjectIdentifier{2, 5, 29, 21}
+	oidIssuingDistributionPoint = asn1.ObjectIdentifier{2, 5, 29, 28}
+)
+
+// distributionPointName and issuingDistributionPoint are the ASN.1
+// structures of the IssuingDistributionPoint CRL extension (RFC 5280 Section
+// 5.2.5), limited to the fields Boulder sets.
+type distributionPointName struct {
+	FullName []asn1.RawValue `asn1:"optional,tag:0"`
+}
+
+type issuingDistributionPoint struct {
+	DistributionPoint     distributionPointName `asn1:"optional,tag:0"`
+	OnlyContainsUserCerts bool                  `asn1:"optional,tag:1"`
+}
+
+// makeIDPExtension returns a critical IssuingDistributionPoint extension
+// which scopes a CRL to the end-entity certificates pointing at the given
+// URL. Because Boulder shards its CRLs, this is what lets a relying party
+// know that a given CRL is complete only for the certificates of one shard.
+func makeIDPExtension(url string) (pkix.Extension, error) {
+	value, err := asn1.Marshal(issuingDistributionPoint{
+		DistributionPoint: distributionPointName{
+			FullName: []asn1.RawValue{
+				// GeneralName uniformResourceIdentifier [6] IA5String
+				{Tag: 6, Class: asn1.ClassContextSpecific, Bytes: []byte(url)},
+			},
+		},
+		OnlyContainsUserCerts: true,
+	})
+	if err != nil {
+		return pkix.Extension{}, err
+	}
+	return pkix.Extension{Id: oidIssuingDistributionPoint, Critical: true, Value: value}, nil
+}
+
+// shardingMatches returns true if the CRL sharding described by a
+// GenerateCRLRequest is the given sharding configuration.
+func shardingMatches(pb *capb.CRLSharding, config crl.ShardingConfig) bool {
+	return pb.Scheme == config.Scheme &&
+		pb.NumShards == int64(config.NumShards) &&
+		pb.ShardWidth == int64(config.ShardWidth.Duration)
+}
+
+// GenerateCRL signs a complete (not delta) CRL containing the given entries
+// with the key of the requested issuer. The CRL number is derived from the
+// thisUpdate time, so successive CRLs for the same shard are numbered in
+// increasing order without the CA needing to keep any state.
+func (ca *CertificateAuthorityImpl) GenerateCRL(ctx context.Context, req *capb.GenerateCRLRequest) (*capb.GenerateCRLResponse, error) {
+	if core.IsAnyNilOrZero(req, req.IssuerNameID, req.ThisUpdate, req.NextUpdate, req.IdpURL) {
+		return nil, berrors.InternalServerError("Incomplete GenerateCRL request")
+	}
+
+	issuer, ok := ca.currentIssuers().byNameID[issuance.IssuerNameID(req.IssuerNameID)]
+	if !ok {
+		return nil, berrors.InternalServerError("This CA doesn't have an issuer cert with NameID %d", req.IssuerNameID)
+	}
+
+	// A requester which assigns certificates to shards differently from the
+	// issuer would leave them off the CRL their distribution point names.
+	if req.Sharding != nil && issuer.boulderIssuer != nil {
+		sharding, ok := issuer.boulderIssuer.CRLSharding()
+		if ok && !shardingMatches(req.Sharding, sharding) {
+			return nil, berrors.InternalServerError(
+				"CRL sharding {%s %d %s} doesn't match the issuer's {%s %d %s}",
+				req.Sharding.Scheme, req.Sharding.NumShards, time.Duration(req.Sharding.ShardWidth),
+				sharding.Scheme, sharding.NumShards, sharding.ShardWidth.Duration)
+		}
+	}
+
+	thisUpdate := time.Unix(0, req.ThisUpdate)
+	nextUpdate := time.Unix(0, req.NextUpdate)
+	if !nextUpdate.After(thisUpdate) {
+		return nil, berrors.InternalServerError("CRL nextUpdate must be after thisUpdate")
+	}
+	if nextUpdate.Sub(thisUpdate) > maxCRLValidity {
+		return nil, berrors.InternalServerError("CRL validity period is longer than the maximum of %s", maxCRLValidity)
+	}
+
+	revokedCerts := make([]pkix.RevokedCertificate, len(req.Entries))
+	for i, entry := range req.Entries {
+		serial, err := core.StringToSerial(entry.Serial)
+		if err != nil {
+			return nil, berrors.InternalServerError("invalid CRL entry serial %q: %s", entry.Serial, err)
+		}
+		revokedCerts[i] = pkix.RevokedCertificate{
+			SerialNumber:   serial,
+			RevocationTime: time.Unix(0, entry.RevokedAt),
+		}
+		// The reasonCode extension must be omitted rather than set to
+		// unspecified (0) (BRs Section 7.2.2).
+		if entry.Reason != 0 {
+			reason, err := asn1.Marshal(asn1.Enumerated(entry.Reason))
+			if err != nil {
+				return nil, err
+			}
+			revokedCerts[i].Extensions = []pkix.Extension{{Id: oidReasonCode, Value: reason}}
+		}
+	}
+
+	idp, err := makeIDPExtension(req.IdpURL)
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509crl.RevocationList{
+		RevokedCertificates: revokedCerts,
+		Number:              big.NewInt(thisUpdate.UnixNano()),
+		ThisUpdate:          thisUpdate,
+		NextUpdate:          nextUpdate,
+		ExtraExtensions:     []pkix.Extension{idp},
+	}
+
+	if issuer.linter != nil {
+		err := issuer.linter.LintCRL(template, issuer.cert.Certificate)
+		if err != nil {
+			ca.noteLintErrors("crl", err)
+			ca.log.AuditErrf("CRL linting failed: issuer=[%s] shard=[%d] err=[%v]", issuer.cert.Subject.CommonName, req.Shard, err)
+			return nil, berrors.InternalServerError("CRL linting failed: %s", err)
+		}
+	}
+
+	crlBytes, err := x509crl.CreateRevocationList(rand.Reader, template, issuer.cert.Certificate, issuer.crlSigner())
+	ca.noteSignError(err)
+	if err != nil {
+		return nil, err
+	}
+	ca.signatureCount.With(prometheus.Labels{"purpose": "crl"}).Inc()
+	ca.log.AuditInfof("Signed CRL: issuer=[%s] shard=[%d] number=[%d] thisUpdate=[%s] nextUpdate=[%s] entries=[%d]",
+		issuer.cert.Subject.CommonName, req.Shard, template.Number, thisUpdate.UTC().Format(time.RFC3339),
+		nextUpdate.UTC().Format(time.RFC3339), len(revokedCerts))
+
+	return &capb.GenerateCRLResponse{Crl: crlBytes}, nil
+}
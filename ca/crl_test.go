@@ -0,0 +1,256 @@
+package ca
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/asn1"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	capb "github.com/letsencrypt/boulder/ca/proto"
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/crl"
+	"github.com/letsencrypt/boulder/features"
+	"github.com/letsencrypt/boulder/issuance"
+	"github.com/letsencrypt/boulder/lint"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func setupCRL(t *testing.T) *CertificateAuthorityImpl {
+	t.Helper()
+	linter, err := lint.NewRevocationLinter(caKey, nil)
+	test.AssertNotError(t, err, "Failed to create linter")
+	ii := &internalIssuer{cert: caCert, ocspSigner: caKey, linter: linter}
+	return &CertificateAuthorityImpl{
+		issuers: issuerMaps{
+			byNameID: map[issuance.IssuerNameID]*internalIssuer{caCert.NameID(): ii},
+		},
+		clk: clock.NewFake(),
+		log: blog.NewMock(),
+		signatureCount: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "signatures",
+		}, []string{"purpose"}),
+		signErrorCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "signature_errors",
+		}, []string{"type"}),
+		lintErrorCount: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "lint_errors",
+		}, []string{"purpose", "lint"}),
+	}
+}
+
+func TestGenerateCRL(t *testing.T) {
+	ca := setupCRL(t)
+	thisUpdate := time.Date(2021, 4, 10, 0, 0, 0, 0, time.UTC)
+	revokedAt := thisUpdate.Add(-time.Hour)
+
+	resp, err := ca.GenerateCRL(context.Background(), &capb.GenerateCRLRequest{
+		IssuerNameID: int64(caCert.NameID()),
+		Shard:        3,
+		ThisUpdate:   thisUpdate.UnixNano(),
+		NextUpdate:   thisUpdate.Add(24 * time.Hour).UnixNano(),
+		IdpURL:       "http://c.example.com/1234/3.crl",
+		Entries: []*capb.CRLEntry{
+			{Serial: "000000000000000000000000000000000001", Reason: 0, RevokedAt: revokedAt.UnixNano()},
+			{Serial: "000000000000000000000000000000000002", Reason: 1, RevokedAt: revokedAt.UnixNano()},
+		},
+	})
+	test.AssertNotError(t, err, "GenerateCRL failed")
+
+	list, err := x509.ParseCRL(resp.Crl)
+	test.AssertNotError(t, err, "Failed to parse generated CRL")
+	test.AssertNotError(t, caCert.CheckCRLSignature(list), "CRL signature didn't verify")
+	test.AssertEquals(t, list.TBSCertList.ThisUpdate, thisUpdate)
+	test.AssertEquals(t, list.TBSCertList.NextUpdate, thisUpdate.Add(24*time.Hour))
+
+	revoked := list.TBSCertList.RevokedCertificates
+	test.AssertEquals(t, len(revoked), 2)
+	test.AssertEquals(t, revoked[0].SerialNumber.Cmp(big.NewInt(1)), 0)
+	test.AssertEquals(t, revoked[0].RevocationTime, revokedAt)
+	test.AssertEquals(t, len(revoked[0].Extensions), 0)
+	test.AssertEquals(t, revoked[1].SerialNumber.Cmp(big.NewInt(2)), 0)
+	test.AssertEquals(t, len(revoked[1].Extensions), 1)
+	test.Assert(t, revoked[1].Extensions[0].Id.Equal(oidReasonCode), "Expected a reasonCode extension")
+	var reason asn1.Enumerated
+	_, err = asn1.Unmarshal(revoked[1].Extensions[0].Value, &reason)
+	test.AssertNotError(t, err, "Failed to parse reasonCode")
+	test.AssertEquals(t, reason, asn1.Enumerated(1))
+
+	var foundIDP, foundNumber bool
+	for _, ext := range list.TBSCertList.Extensions {
+		if ext.Id.Equal(oidIssuingDistributionPoint) {
+			foundIDP = true
+			test.Assert(t, ext.Critical, "IssuingDistributionPoint extension should be critical")
+			var idp issuingDistributionPoint
+			_, err = asn1.Unmarshal(ext.Value, &idp)
+			test.AssertNotError(t, err, "Failed to parse IssuingDistributionPoint")
+			test.Assert(t, idp.OnlyContainsUserCerts, "Expected onlyContainsUserCerts")
+			test.AssertEquals(t, len(idp.DistributionPoint.FullName), 1)
+			test.AssertEquals(t, string(idp.DistributionPoint.FullName[0].Bytes), "http://c.example.com/1234/3.crl")
+		}
+		if ext.Id.Equal(asn1.ObjectIdentifier{2, 5, 29, 20}) {
+			foundNumber = true
+			var number *big.Int
+			_, err = asn1.Unmarshal(ext.Value, &number)
+			test.AssertNotError(t, err, "Failed to parse CRL number")
+			test.AssertEquals(t, number.Int64(), thisUpdate.UnixNano())
+		}
+	}
+	test.Assert(t, foundIDP, "CRL is missing the IssuingDistributionPoint extension")
+	test.Assert(t, foundNumber, "CRL is missing the CRL number extension")
+}
+
+func TestGenerateCRLErrors(t *testing.T) {
+	ca := setupCRL(t)
+	thisUpdate := time.Date(2021, 4, 10, 0, 0, 0, 0, time.UTC)
+	validRequest := func() *capb.GenerateCRLRequest {
+		return &capb.GenerateCRLRequest{
+			IssuerNameID: int64(caCert.NameID()),
+			ThisUpdate:   thisUpdate.UnixNano(),
+			NextUpdate:   thisUpdate.Add(24 * time.Hour).UnixNano(),
+			IdpURL:       "http://c.example.com/1234/0.crl",
+		}
+	}
+
+	_, err := ca.GenerateCRL(context.Background(), validRequest())
+	test.AssertNotError(t, err, "GenerateCRL failed for an empty CRL")
+
+	req := validRequest()
+	req.IdpURL = ""
+	_, err = ca.GenerateCRL(context.Background(), req)
+	test.AssertError(t, err, "GenerateCRL should fail without an IDP URL")
+
+	req = validRequest()
+	req.IssuerNameID = 1
+	_, err = ca.GenerateCRL(context.Background(), req)
+	test.AssertError(t, err, "GenerateCRL should fail for an unknown issuer")
+
+	req = validRequest()
+	req.NextUpdate = req.ThisUpdate
+	_, err = ca.GenerateCRL(context.Background(), req)
+	test.AssertError(t, err, "GenerateCRL should fail when nextUpdate isn't after thisUpdate")
+
+	req = validRequest()
+	req.NextUpdate = thisUpdate.Add(maxCRLValidity + time.Second).UnixNano()
+	_, err = ca.GenerateCRL(context.Background(), req)
+	test.AssertError(t, err, "GenerateCRL should fail when the CRL is valid for too long")
+
+	req = validRequest()
+	req.Entries = []*capb.CRLEntry{{Serial: "not a serial", RevokedAt: thisUpdate.UnixNano()}}
+	_, err = ca.GenerateCRL(context.Background(), req)
+	test.AssertError(t, err, "GenerateCRL should fail for an invalid serial")
+}
+
+func TestCRLShard(t *testing.T) {
+	ca := setupCRL(t)
+	issuerConfig := issuance.IssuerConfig{
+		UseForRSALeaves:   true,
+		UseForECDSALeaves: true,
+		IssuerURL:         "http://not-example.com/issuer-url",
+		OCSPURL:           "http://not-example.com/ocsp",
+	}
+	profileConfig := issuance.ProfileConfig{
+		AllowCTPoison:       true,
+		AllowSCTList:        true,
+		Policies:            []issuance.PolicyInformation{{OID: "2.23.140.1.2.1"}},
+		MaxValidityPeriod:   cmd.ConfigDuration{Duration: 24 * time.Hour},
+		MaxValidityBackdate: cmd.ConfigDuration{Duration: time.Hour},
+	}
+	defaultProfile, err := issuance.NewProfile(profileConfig, issuerConfig)
+	test.AssertNotError(t, err, "Failed to create profile")
+	profileConfig.ValidityPeriod = cmd.ConfigDuration{Duration: 24 * time.Hour}
+	profileConfig.CRLURLTemplate = "http://not-example.com/crl/{issuer}/{shard}.crl"
+	profileConfig.CRLSharding = crl.ShardingConfig{Scheme: crl.ShardBySerial, NumShards: 4}
+	shardedProfile, err := issuance.NewProfile(profileConfig, issuerConfig)
+	test.AssertNotError(t, err, "Failed to create profile")
+
+	ii := ca.issuers.byNameID[caCert.NameID()]
+	ii.boulderIssuer = &issuance.Issuer{Cert: caCert, Signer: caKey, Profile: defaultProfile}
+	err = ii.boulderIssuer.AddProfile("sharded", shardedProfile)
+	test.AssertNotError(t, err, "Failed to add profile")
+
+	_ = features.Set(map[string]bool{"NonCFSSLSigner": true})
+	defer features.Reset()
+
+	notAfter := time.Date(2021, 4, 10, 0, 0, 0, 0, time.UTC)
+	shard, err := ca.crlShard(ii, "", big.NewInt(11), notAfter)
+	test.AssertNotError(t, err, "crlShard failed")
+	test.Assert(t, shard == nil, "Default profile shouldn't shard CRLs")
+
+	shard, err = ca.crlShard(ii, "sharded", big.NewInt(11), notAfter)
+	test.AssertNotError(t, err, "crlShard failed")
+	test.AssertEquals(t, shard.Idx, int64(3))
+
+	_, err = ca.crlShard(ii, "unknown", big.NewInt(11), notAfter)
+	test.AssertError(t, err, "crlShard should fail for an unknown profile")
+
+	// CRLs may only be generated for the issuer's own sharding.
+	thisUpdate := time.Date(2021, 4, 10, 0, 0, 0, 0, time.UTC)
+	req := &capb.GenerateCRLRequest{
+		IssuerNameID: int64(caCert.NameID()),
+		Shard:        3,
+		ThisUpdate:   thisUpdate.UnixNano(),
+		NextUpdate:   thisUpdate.Add(24 * time.Hour).UnixNano(),
+		IdpURL:       "http://not-example.com/crl/1234/3.crl",
+		Sharding:     &capb.CRLSharding{Scheme: crl.ShardBySerial, NumShards: 4},
+	}
+	_, err = ca.GenerateCRL(context.Background(), req)
+	test.AssertNotError(t, err, "GenerateCRL failed with matching sharding")
+
+	req.Sharding.NumShards = 8
+	_, err = ca.GenerateCRL(context.Background(), req)
+	test.AssertError(t, err, "GenerateCRL should fail with mismatched sharding")
+	test.AssertContains(t, err.Error(), "doesn't match the issuer's")
+}
+
+func TestGenerateCRLDelegatedOCSP(t *testing.T) {
+	ca := setupCRL(t)
+	// A delegated OCSP responder's key must not be used to sign CRLs.
+	responderKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate responder key")
+	ii := ca.issuers.byNameID[caCert.NameID()]
+	ii.ocspSigner = responderKey
+	ii.boulderIssuer = &issuance.Issuer{Cert: caCert, Signer: caKey}
+
+	thisUpdate := time.Date(2021, 4, 10, 0, 0, 0, 0, time.UTC)
+	resp, err := ca.GenerateCRL(context.Background(), &capb.GenerateCRLRequest{
+		IssuerNameID: int64(caCert.NameID()),
+		ThisUpdate:   thisUpdate.UnixNano(),
+		NextUpdate:   thisUpdate.Add(24 * time.Hour).UnixNano(),
+		IdpURL:       "http://c.example.com/1234/3.crl",
+	})
+	test.AssertNotError(t, err, "GenerateCRL failed")
+	list, err := x509.ParseCRL(resp.Crl)
+	test.AssertNotError(t, err, "failed to parse CRL")
+	test.AssertNotError(t, caCert.CheckCRLSignature(list), "CRL wasn't signed by the issuer key")
+}
+
+func TestGenerateCRLLintFailure(t *testing.T) {
+	ca := setupCRL(t)
+	thisUpdate := time.Date(2021, 4, 10, 0, 0, 0, 0, time.UTC)
+
+	// certificateHold is forbidden by the Baseline Requirements, so the CRL
+	// must not be signed.
+	_, err := ca.GenerateCRL(context.Background(), &capb.GenerateCRLRequest{
+		IssuerNameID: int64(caCert.NameID()),
+		Shard:        3,
+		ThisUpdate:   thisUpdate.UnixNano(),
+		NextUpdate:   thisUpdate.Add(24 * time.Hour).UnixNano(),
+		IdpURL:       "http://c.example.com/1234/3.crl",
+		Entries: []*capb.CRLEntry{
+			{Serial: "000000000000000000000000000000000001", Reason: 6, RevokedAt: thisUpdate.Add(-time.Hour).UnixNano()},
+		},
+	})
+	test.AssertError(t, err, "GenerateCRL should fail linting")
+	test.AssertContains(t, err.Error(), "e_crl_reason_code")
+	test.AssertEquals(t, test.CountCounter(ca.lintErrorCount.With(prometheus.Labels{"purpose": "crl", "lint": "e_crl_reason_code"})), 1)
+	test.AssertEquals(t, test.CountCounterVec("purpose", "crl", ca.signatureCount), 0)
+}
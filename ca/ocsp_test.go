@@ -1,10 +1,25 @@
 package ca
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/hex"
+	"math/big"
 	"testing"
 	"time"
 
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	capb "github.com/letsencrypt/boulder/ca/proto"
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/features"
+	"github.com/letsencrypt/boulder/issuance"
+	"github.com/letsencrypt/boulder/lint"
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/metrics"
 	"github.com/letsencrypt/boulder/test"
@@ -131,3 +146,101 @@ func TestOcspLogPanicsOnEnqueueAfterStop(t *testing.T) {
 
 	queue.enqueue(serial(t), time.Now(), ocsp.ResponseStatus(ocsp.Good))
 }
+
+func TestGenerateOCSPDelegatedResponder(t *testing.T) {
+	responderKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate responder key")
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "delegated OCSP responder"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageOCSPSigning},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert.Certificate, responderKey.Public(), caKey)
+	test.AssertNotError(t, err, "failed to create responder certificate")
+	responderCert, err := x509.ParseCertificate(der)
+	test.AssertNotError(t, err, "failed to parse responder certificate")
+
+	ii := &internalIssuer{cert: caCert, ocspSigner: responderKey, ocspResponderCert: responderCert}
+	ca := &CertificateAuthorityImpl{
+		issuers: issuerMaps{
+			byID: map[issuance.IssuerID]*internalIssuer{caCert.ID(): ii},
+		},
+		ocspLifetime: 96 * time.Hour,
+		clk:          clock.NewFake(),
+		log:          blog.NewMock(),
+		signatureCount: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "signatures",
+		}, []string{"purpose"}),
+		signErrorCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "signature_errors",
+		}, []string{"type"}),
+	}
+
+	err = features.Set(map[string]bool{"StoreIssuerInfo": true})
+	test.AssertNotError(t, err, "failed to set features")
+	defer features.Reset()
+
+	resp, err := ca.GenerateOCSP(context.Background(), &capb.GenerateOCSPRequest{
+		Serial:   "000000000000000000000000000000000001",
+		IssuerID: int64(caCert.ID()),
+		Status:   string(core.OCSPStatusGood),
+	})
+	test.AssertNotError(t, err, "GenerateOCSP failed")
+
+	// The response is signed by the responder, which is included so that it
+	// can be verified against the issuer.
+	parsed, err := ocsp.ParseResponse(resp.Response, caCert.Certificate)
+	test.AssertNotError(t, err, "failed to parse and verify response")
+	test.AssertNotNil(t, parsed.Certificate, "response doesn't include the responder certificate")
+	test.AssertByteEquals(t, parsed.Certificate.Raw, responderCert.Raw)
+	test.AssertEquals(t, parsed.Status, ocsp.Good)
+}
+
+func TestGenerateOCSPLintFailure(t *testing.T) {
+	linter, err := lint.NewRevocationLinter(caKey, nil)
+	test.AssertNotError(t, err, "failed to create linter")
+	ii := &internalIssuer{cert: caCert, ocspSigner: caKey, linter: linter}
+	ca := &CertificateAuthorityImpl{
+		issuers: issuerMaps{
+			byID: map[issuance.IssuerID]*internalIssuer{caCert.ID(): ii},
+		},
+		ocspLifetime: 96 * time.Hour,
+		clk:          clock.NewFake(),
+		log:          blog.NewMock(),
+		signatureCount: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "signatures",
+		}, []string{"purpose"}),
+		signErrorCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "signature_errors",
+		}, []string{"type"}),
+		lintErrorCount: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "lint_errors",
+		}, []string{"purpose", "lint"}),
+	}
+
+	err = features.Set(map[string]bool{"StoreIssuerInfo": true})
+	test.AssertNotError(t, err, "failed to set features")
+	defer features.Reset()
+
+	req := &capb.GenerateOCSPRequest{
+		Serial:    "000000000000000000000000000000000001",
+		IssuerID:  int64(caCert.ID()),
+		Status:    string(core.OCSPStatusRevoked),
+		Reason:    ocsp.KeyCompromise,
+		RevokedAt: ca.clk.Now().Add(-time.Hour).UnixNano(),
+	}
+	_, err = ca.GenerateOCSP(context.Background(), req)
+	test.AssertNotError(t, err, "GenerateOCSP failed")
+
+	// certificateHold is forbidden by the Baseline Requirements, so the
+	// response must not be signed.
+	req.Reason = ocsp.CertificateHold
+	_, err = ca.GenerateOCSP(context.Background(), req)
+	test.AssertError(t, err, "GenerateOCSP should fail linting")
+	test.AssertContains(t, err.Error(), "e_ocsp_revocation_reason")
+	test.AssertEquals(t, test.CountCounter(ca.lintErrorCount.With(prometheus.Labels{"purpose": "ocsp", "lint": "e_ocsp_revocation_reason"})), 1)
+	test.AssertEquals(t, test.CountCounterVec("purpose", "ocsp", ca.signatureCount), 1)
+}
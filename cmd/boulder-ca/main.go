@@ -1,12 +1,14 @@
 package main
 
 import (
+	"container/list"
 	"crypto"
 	"crypto/x509"
 	"encoding/json"
 	"flag"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
 	"sync"
 
@@ -14,6 +16,7 @@ import (
 	cfsslConfig "github.com/cloudflare/cfssl/config"
 	"github.com/cloudflare/cfssl/helpers"
 	pkcs11key "github.com/letsencrypt/pkcs11key/v4"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
@@ -27,6 +30,7 @@ import (
 	"github.com/letsencrypt/boulder/issuance"
 	"github.com/letsencrypt/boulder/lint"
 	"github.com/letsencrypt/boulder/policy"
+	"github.com/letsencrypt/boulder/reloader"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 )
 
@@ -42,6 +46,9 @@ type config struct {
 
 		GRPCCA            *cmd.GRPCServerConfig
 		GRPCOCSPGenerator *cmd.GRPCServerConfig
+		// GRPCCRLGenerator is optional. If it is unset, the CA doesn't serve
+		// the CRLGenerator service.
+		GRPCCRLGenerator *cmd.GRPCServerConfig
 
 		SAService *cmd.GRPCClientConfig
 
@@ -58,11 +65,21 @@ type config struct {
 
 		// Issuance contains all information necessary to load and initialize non-CFSSL issuers.
 		Issuance struct {
-			Profile      issuance.ProfileConfig
+			Profile issuance.ProfileConfig
+			// Profiles are additional named profiles which an order can
+			// request in place of Profile. Each must specify a validity period.
+			Profiles     map[string]issuance.ProfileConfig
 			Issuers      []issuance.IssuerConfig
 			IgnoredLints []string
 		}
 
+		// ReloadIssuance, if true, makes the CA watch its config file and,
+		// whenever it changes, reload the Issuance section and
+		// ECDSAAllowedAccounts from it without restarting. Keys and
+		// certificates are only loaded again if their locations change. Only
+		// supported by the non-CFSSL signer.
+		ReloadIssuance bool
+
 		// How long issued certificates are valid for, should match expiry field
 		// in cfssl config.
 		Expiry cmd.ConfigDuration
@@ -81,6 +98,10 @@ type config struct {
 		// than the minTimeToExpiry field for the OCSP Updater.
 		LifespanOCSP cmd.ConfigDuration
 
+		// IgnoredRevocationLints names any of the lints run against OCSP
+		// responses and CRLs before they're signed which should be skipped.
+		IgnoredRevocationLints []string
+
 		// WeakKeyFile is the path to a JSON file containing truncated RSA modulus
 		// hashes of known easily enumerable keys.
 		WeakKeyFile string
@@ -210,7 +231,70 @@ func loadCFSSLSigner(issuerConfig IssuerConfig, cert *x509.Certificate) (crypto.
 		pkcs11Config.TokenLabel, pkcs11Config.PIN, cert.PublicKey)
 }
 
-func loadBoulderIssuers(profileConfig issuance.ProfileConfig, issuerConfigs []issuance.IssuerConfig, ignoredLints []string) ([]*issuance.Issuer, error) {
+// keyCache remembers the certificate and key loaded from each location, so
+// that reloading the config reuses them, and any open HSM sessions, rather
+// than loading them again. Files which change in place are therefore not
+// noticed: a rotated key or certificate needs a new location.
+//
+// It holds up to maxKeys locations, evicting the least recently loaded one.
+// Every location in a config is loaded each time it's reloaded, so as long as
+// a config has no more than maxKeys locations only ones it no longer uses are
+// evicted. An evicted key is only dropped from the cache, not closed, since
+// issuers from an earlier config may still be using it.
+type keyCache struct {
+	sync.Mutex
+	maxKeys int
+	// lru holds *cachedKey values, most recently loaded first.
+	lru  *list.List
+	keys map[string]*list.Element
+}
+
+type cachedKey struct {
+	id     string
+	cert   *issuance.Certificate
+	signer crypto.Signer
+}
+
+// keyCacheSize is the number of locations the CA's keyCache holds, which is
+// far more than the issuers and OCSP responders of any one config.
+const keyCacheSize = 64
+
+func newKeyCache(maxKeys int) *keyCache {
+	return &keyCache{
+		maxKeys: maxKeys,
+		lru:     list.New(),
+		keys:    make(map[string]*list.Element),
+	}
+}
+
+// load returns the certificate and key at location, loading them if this is
+// the first time location has been seen.
+func (kc *keyCache) load(location issuance.IssuerLoc) (*issuance.Certificate, crypto.Signer, error) {
+	id, err := json.Marshal(location)
+	if err != nil {
+		return nil, nil, err
+	}
+	kc.Lock()
+	defer kc.Unlock()
+	if elem, ok := kc.keys[string(id)]; ok {
+		kc.lru.MoveToFront(elem)
+		key := elem.Value.(*cachedKey)
+		return key.cert, key.signer, nil
+	}
+	cert, signer, err := issuance.LoadIssuer(location)
+	if err != nil {
+		return nil, nil, err
+	}
+	kc.keys[string(id)] = kc.lru.PushFront(&cachedKey{id: string(id), cert: cert, signer: signer})
+	for kc.lru.Len() > kc.maxKeys {
+		oldest := kc.lru.Back()
+		kc.lru.Remove(oldest)
+		delete(kc.keys, oldest.Value.(*cachedKey).id)
+	}
+	return cert, signer, nil
+}
+
+func loadBoulderIssuers(keys *keyCache, profileConfig issuance.ProfileConfig, namedProfileConfigs map[string]issuance.ProfileConfig, issuerConfigs []issuance.IssuerConfig, ignoredLints []string) ([]*issuance.Issuer, error) {
 	issuers := make([]*issuance.Issuer, 0, len(issuerConfigs))
 	for _, issuerConfig := range issuerConfigs {
 		profile, err := issuance.NewProfile(profileConfig, issuerConfig)
@@ -218,7 +302,7 @@ func loadBoulderIssuers(profileConfig issuance.ProfileConfig, issuerConfigs []is
 			return nil, err
 		}
 
-		cert, signer, err := issuance.LoadIssuer(issuerConfig.Location)
+		cert, signer, err := keys.load(issuerConfig.Location)
 		if err != nil {
 			return nil, err
 		}
@@ -233,6 +317,32 @@ func loadBoulderIssuers(profileConfig issuance.ProfileConfig, issuerConfigs []is
 			return nil, err
 		}
 
+		if issuerConfig.OCSPResponder != nil {
+			responderCert, responderSigner, err := keys.load(*issuerConfig.OCSPResponder)
+			if err != nil {
+				return nil, fmt.Errorf("loading OCSP responder: %w", err)
+			}
+			responder, err := issuance.NewOCSPResponder(responderCert, responderSigner, cert)
+			if err != nil {
+				return nil, fmt.Errorf("OCSP responder cert %s: %w", issuerConfig.OCSPResponder.CertFile, err)
+			}
+			err = issuer.SetOCSPResponder(responder)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for name, namedProfileConfig := range namedProfileConfigs {
+			namedProfile, err := issuance.NewProfile(namedProfileConfig, issuerConfig)
+			if err != nil {
+				return nil, fmt.Errorf("loading profile %q: %w", name, err)
+			}
+			err = issuer.AddProfile(name, namedProfile)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		issuers = append(issuers, issuer)
 	}
 	return issuers, nil
@@ -241,6 +351,7 @@ func loadBoulderIssuers(profileConfig issuance.ProfileConfig, issuerConfigs []is
 func main() {
 	caAddr := flag.String("ca-addr", "", "CA gRPC listen address override")
 	ocspAddr := flag.String("ocsp-addr", "", "OCSP gRPC listen address override")
+	crlAddr := flag.String("crl-addr", "", "CRL gRPC listen address override")
 	debugAddr := flag.String("debug-addr", "", "Debug server address override")
 	configFile := flag.String("config", "", "File path to the configuration file for this service")
 	flag.Parse()
@@ -262,6 +373,9 @@ func main() {
 	if *ocspAddr != "" {
 		c.CA.GRPCOCSPGenerator.Address = *ocspAddr
 	}
+	if *crlAddr != "" && c.CA.GRPCCRLGenerator != nil {
+		c.CA.GRPCCRLGenerator.Address = *crlAddr
+	}
 	if *debugAddr != "" {
 		c.CA.DebugAddr = *debugAddr
 	}
@@ -285,10 +399,15 @@ func main() {
 	err = pa.SetHostnamePolicyFile(c.CA.HostnamePolicyFile)
 	cmd.FailOnError(err, "Couldn't load hostname policy file")
 
+	if c.CA.ReloadIssuance && !features.Enabled(features.NonCFSSLSigner) {
+		cmd.Fail("Error in CA config: ReloadIssuance requires the NonCFSSLSigner feature")
+	}
+
+	keys := newKeyCache(keyCacheSize)
 	var cfsslIssuers []ca.Issuer
 	var boulderIssuers []*issuance.Issuer
 	if features.Enabled(features.NonCFSSLSigner) {
-		boulderIssuers, err = loadBoulderIssuers(c.CA.Issuance.Profile, c.CA.Issuance.Issuers, c.CA.Issuance.IgnoredLints)
+		boulderIssuers, err = loadBoulderIssuers(keys, c.CA.Issuance.Profile, c.CA.Issuance.Profiles, c.CA.Issuance.Issuers, c.CA.Issuance.IgnoredLints)
 		cmd.FailOnError(err, "Couldn't load issuers")
 	} else {
 		cfsslIssuers, err = loadCFSSLIssuers(c.CA.Issuers)
@@ -329,6 +448,7 @@ func main() {
 		c.CA.SerialPrefix,
 		c.CA.MaxNames,
 		c.CA.LifespanOCSP.Duration,
+		c.CA.IgnoredRevocationLints,
 		kp,
 		orphanQueue,
 		c.CA.OCSPLogMaxLength,
@@ -338,6 +458,25 @@ func main() {
 		clk)
 	cmd.FailOnError(err, "Failed to create CA impl")
 
+	if c.CA.ReloadIssuance {
+		_, err = reloader.New(*configFile, func(contents []byte) error {
+			var newConfig config
+			err := json.Unmarshal(contents, &newConfig)
+			if err != nil {
+				return err
+			}
+			ic := newConfig.CA.Issuance
+			issuers, err := loadBoulderIssuers(keys, ic.Profile, ic.Profiles, ic.Issuers, ic.IgnoredLints)
+			if err != nil {
+				return err
+			}
+			return cai.ReloadIssuers(issuers, newConfig.CA.ECDSAAllowedAccounts)
+		}, func(err error) {
+			logger.Errf("error reloading issuers: %s", err)
+		})
+		cmd.FailOnError(err, "Couldn't watch config file for issuer changes")
+	}
+
 	if orphanQueue != nil {
 		go cai.OrphanIntegrationLoop()
 	}
@@ -369,11 +508,31 @@ func main() {
 		wg.Done()
 	}()
 
+	var crlSrv *grpc.Server
+	crlHealth := health.NewServer()
+	if c.CA.GRPCCRLGenerator != nil {
+		var crlListener net.Listener
+		crlSrv, crlListener, err = bgrpc.NewServer(c.CA.GRPCCRLGenerator, tlsConfig, serverMetrics, clk)
+		cmd.FailOnError(err, "Unable to setup CA gRPC server")
+		capb.RegisterCRLGeneratorServer(crlSrv, cai)
+		healthpb.RegisterHealthServer(crlSrv, crlHealth)
+		wg.Add(1)
+		go func() {
+			cmd.FailOnError(cmd.FilterShutdownErrors(crlSrv.Serve(crlListener)),
+				"CRLGenerator gRPC service failed")
+			wg.Done()
+		}()
+	}
+
 	go cmd.CatchSignals(logger, func() {
 		caHealth.Shutdown()
 		ocspHealth.Shutdown()
+		crlHealth.Shutdown()
 		caSrv.GracefulStop()
 		ocspSrv.GracefulStop()
+		if crlSrv != nil {
+			crlSrv.GracefulStop()
+		}
 		wg.Wait()
 		cai.Stop()
 	})
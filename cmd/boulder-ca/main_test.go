@@ -2,6 +2,8 @@ package main
 
 import (
 	"testing"
+
+	"github.com/letsencrypt/boulder/issuance"
 )
 
 func TestLoadIssuerSuccess(t *testing.T) {
@@ -39,3 +41,61 @@ func TestLoadIssuerBadCert(t *testing.T) {
 		t.Fatal("loadIssuer succeeded when loading key from /dev/null")
 	}
 }
+
+func TestKeyCacheReusesKeys(t *testing.T) {
+	keys := newKeyCache(keyCacheSize)
+	location := issuance.IssuerLoc{
+		File:     "../../test/test-ca.key",
+		CertFile: "../../test/test-ca2.pem",
+	}
+	cert, signer, err := keys.load(location)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert2, signer2, err := keys.load(location)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cert2 != cert || signer2 != signer {
+		t.Fatal("keyCache loaded the same location twice")
+	}
+
+	location.CertFile = "../../test/test-ca.pem"
+	cert3, _, err := keys.load(location)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cert3 == cert {
+		t.Fatal("keyCache reused a key for a different location")
+	}
+}
+
+func TestKeyCacheEvicts(t *testing.T) {
+	keys := newKeyCache(1)
+	first := issuance.IssuerLoc{
+		File:     "../../test/test-ca.key",
+		CertFile: "../../test/test-ca2.pem",
+	}
+	second := issuance.IssuerLoc{
+		File:     "../../test/test-ca.key",
+		CertFile: "../../test/test-ca.pem",
+	}
+	cert, _, err := keys.load(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = keys.load(second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys.keys) != 1 || keys.lru.Len() != 1 {
+		t.Fatalf("keyCache holds %d keys, expected 1", len(keys.keys))
+	}
+	cert2, _, err := keys.load(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cert2 == cert {
+		t.Fatal("keyCache reused an evicted key")
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"os"
 	"runtime"
@@ -11,11 +12,14 @@ import (
 
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/ctpolicy/ctconfig"
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
 	"github.com/letsencrypt/boulder/features"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
 	"github.com/letsencrypt/boulder/issuance"
 	"github.com/letsencrypt/boulder/publisher"
 	pubpb "github.com/letsencrypt/boulder/publisher/proto"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
 )
 
 type config struct {
@@ -33,6 +37,34 @@ type config struct {
 		// a chain, starting with the issuing intermediate, followed by one or
 		// more additional certificates, up to and including a root.
 		Chains [][]string
+
+		// LogList, if set, is a v3 log list used to refuse submissions to logs
+		// which aren't in one of its States, or whose temporal interval doesn't
+		// include the certificate's expiration. Logs which aren't in the list
+		// are unaffected.
+		LogList *ctconfig.LogListConfig
+
+		// SAService, if set, is used to store every verified SCT, so that we
+		// know which logs have each certificate.
+		SAService *cmd.GRPCClientConfig
+
+		// FinalCertQueue, if set, makes the publisher work through the queue of
+		// final certificate submissions which the RA writes to the SA when the
+		// FinalCertCTQueue feature is enabled. It requires SAService.
+		FinalCertQueue *struct {
+			// How often to process a batch of due submissions.
+			Frequency cmd.ConfigDuration
+			// How many submissions to process in each batch.
+			BatchSize int
+			// How long to wait before retrying a failed submission, doubling
+			// with each further failure up to MaxBackoff.
+			BaseBackoff cmd.ConfigDuration
+			MaxBackoff  cmd.ConfigDuration
+			// How many times to attempt a submission before dropping it.
+			MaxAttempts int
+			// How long each submission may take.
+			Timeout cmd.ConfigDuration
+		}
 	}
 
 	Syslog cmd.SyslogConfig
@@ -107,7 +139,35 @@ func main() {
 
 	clk := cmd.Clock()
 
-	pubi := publisher.New(bundles, c.Publisher.UserAgent, logger, scope)
+	var logList *loglist.Source
+	if c.Publisher.LogList != nil {
+		logList, err = loglist.NewSourceFromConfig(*c.Publisher.LogList, logger)
+		cmd.FailOnError(err, "Failed to load CT log list")
+	}
+
+	var sac core.StorageAuthority
+	if c.Publisher.SAService != nil {
+		clientMetrics := bgrpc.NewClientMetrics(scope)
+		saConn, err := bgrpc.ClientSetup(c.Publisher.SAService, tlsConfig, clientMetrics, clk)
+		cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to SA")
+		sac = bgrpc.NewStorageAuthorityClient(sapb.NewStorageAuthorityClient(saConn))
+	}
+
+	pubi := publisher.New(bundles, c.Publisher.UserAgent, logList, sac, logger, scope)
+
+	queueCtx, stopQueue := context.WithCancel(context.Background())
+	if qc := c.Publisher.FinalCertQueue; qc != nil {
+		if sac == nil {
+			cmd.Fail("FinalCertQueue requires SAService")
+		}
+		if qc.Frequency.Duration == 0 || qc.BatchSize == 0 || qc.BaseBackoff.Duration == 0 ||
+			qc.MaxBackoff.Duration == 0 || qc.MaxAttempts == 0 || qc.Timeout.Duration == 0 {
+			cmd.Fail("FinalCertQueue requires Frequency, BatchSize, BaseBackoff, MaxBackoff, MaxAttempts and Timeout")
+		}
+		queue := publisher.NewFinalCertQueue(pubi, sac, clk, qc.BatchSize, qc.BaseBackoff.Duration,
+			qc.MaxBackoff.Duration, qc.MaxAttempts, qc.Timeout.Duration, logger, scope)
+		go queue.Run(queueCtx, qc.Frequency.Duration)
+	}
 
 	serverMetrics := bgrpc.NewServerMetrics(scope)
 	grpcSrv, l, err := bgrpc.NewServer(c.Publisher.GRPC, tlsConfig, serverMetrics, clk)
@@ -117,8 +177,10 @@ func main() {
 	healthpb.RegisterHealthServer(grpcSrv, hs)
 
 	go cmd.CatchSignals(logger, func() {
+		stopQueue()
 		hs.Shutdown()
 		grpcSrv.GracefulStop()
+		pubi.WaitForStorage()
 	})
 
 	err = cmd.FilterShutdownErrors(grpcSrv.Serve(l))
@@ -14,6 +14,7 @@ import (
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/ctpolicy"
 	"github.com/letsencrypt/boulder/ctpolicy/ctconfig"
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
 	"github.com/letsencrypt/boulder/features"
 	"github.com/letsencrypt/boulder/goodkey"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
@@ -70,14 +71,41 @@ type config struct {
 		// administratively blocked.
 		BlockedKeyFile string
 
+		// AllowEd25519AccountKeys permits Ed25519 keys to be used as ACME
+		// account keys. Certificate keys are unaffected.
+		AllowEd25519AccountKeys bool
+
 		OrderLifetime cmd.ConfigDuration
 
+		// CertificateProfiles lists the certificate profiles which orders may
+		// request, mapped to the IDs of the accounts allowed to request each
+		// of them. An empty list allows every account. Each profile must also
+		// be configured in the CA, and orders may only request a profile
+		// when the StoreCertificateProfileName feature is enabled in both the
+		// RA and the SA.
+		CertificateProfiles map[string][]int64
+
+		// OrderValidity bounds the certificate validity periods which orders
+		// may request with notBefore and notAfter. If MaxPeriod is unset, or
+		// the StoreOrderValidity feature isn't enabled in both the RA and the
+		// SA, orders can't request one. Since the CA refuses requests which
+		// would make a certificate valid for longer than its own validity
+		// period, MaxPeriod should leave room for the CA's backdating.
+		OrderValidity struct {
+			MinPeriod         cmd.ConfigDuration
+			MaxPeriod         cmd.ConfigDuration
+			MaxNotBeforeDelay cmd.ConfigDuration
+		}
+
 		// CTLogGroups contains groupings of CT logs which we want SCTs from.
 		// When we retrieve SCTs we will submit the certificate to each log
 		// in a group and the first SCT returned will be used. This allows
 		// us to comply with Chrome CT policy which requires one SCT from a
 		// Google log and one SCT from any other log included in their policy.
 		CTLogGroups2 []ctconfig.CTGroup
+		// CTLogList, if set, is used instead of CTLogGroups2: the RA gets
+		// SCTs from two distinct operators' logs out of a v3 log list.
+		CTLogList *ctconfig.LogListConfig
 		// InformationalCTLogs are a set of CT logs we will always submit to
 		// but won't ever use the SCTs from. This may be because we want to
 		// test them or because they are not yet approved by a browser/root
@@ -178,9 +206,9 @@ func main() {
 	// Boulder's components assume that there will always be CT logs configured.
 	// Issuing a certificate without SCTs embedded is a miss-issuance event in the
 	// environment Boulder is built for. Exit early if there is no CTLogGroups2
-	// configured.
-	if len(c.RA.CTLogGroups2) == 0 {
-		cmd.Fail("CTLogGroups2 must not be empty")
+	// or CTLogList configured.
+	if len(c.RA.CTLogGroups2) == 0 && c.RA.CTLogList == nil {
+		cmd.Fail("one of CTLogGroups2 or CTLogList must be set")
 	}
 
 	for i, g := range c.RA.CTLogGroups2 {
@@ -196,7 +224,16 @@ func main() {
 			}
 		}
 	}
-	ctp = ctpolicy.New(pubc, c.RA.CTLogGroups2, c.RA.InformationalCTLogs, logger, scope)
+	if c.RA.CTLogList != nil {
+		if len(c.RA.CTLogGroups2) != 0 {
+			cmd.Fail("CTLogGroups2 and CTLogList are mutually exclusive")
+		}
+		logList, err := loglist.NewSourceFromConfig(*c.RA.CTLogList, logger)
+		cmd.FailOnError(err, "Failed to load CT log list")
+		ctp = ctpolicy.NewFromLogList(pubc, logList, c.RA.CTLogList.Stagger.Duration, c.RA.InformationalCTLogs, logger, scope)
+	} else {
+		ctp = ctpolicy.New(pubc, c.RA.CTLogGroups2, c.RA.InformationalCTLogs, logger, scope)
+	}
 
 	saConn, err := bgrpc.ClientSetup(c.RA.SAService, tlsConfig, clientMetrics, clk)
 	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to SA")
@@ -216,6 +253,10 @@ func main() {
 
 	kp, err := goodkey.NewKeyPolicy(c.RA.WeakKeyFile, c.RA.BlockedKeyFile, sac.KeyBlocked)
 	cmd.FailOnError(err, "Unable to create key policy")
+	// Ed25519 is only allowed for account keys: the CAs can't issue
+	// certificates for it, so CSRs are checked against kp unchanged.
+	accountKP := kp
+	accountKP.AllowEd25519 = c.RA.AllowEd25519AccountKeys
 
 	if c.RA.MaxNames == 0 {
 		cmd.Fail("Error in RA config: MaxNames must not be 0")
@@ -241,6 +282,14 @@ func main() {
 
 	policyErr := rai.SetRateLimitPoliciesFile(c.RA.RateLimitPoliciesFilename)
 	cmd.FailOnError(policyErr, "Couldn't load rate limit policies file")
+	err = rai.SetCertificateProfiles(c.RA.CertificateProfiles)
+	cmd.FailOnError(err, "Invalid certificate profiles")
+	rai.SetAccountKeyPolicy(accountKP)
+	rai.SetOrderValidityBounds(ra.OrderValidityBounds{
+		MinPeriod:         c.RA.OrderValidity.MinPeriod.Duration,
+		MaxPeriod:         c.RA.OrderValidity.MaxPeriod.Duration,
+		MaxNotBeforeDelay: c.RA.OrderValidity.MaxNotBeforeDelay.Duration,
+	})
 	rai.PA = pa
 
 	rai.VA = vac
@@ -40,6 +40,12 @@ type config struct {
 		DNSTries     int
 		DNSResolvers []string
 
+		// DNSSECTrustAnchors are DS records in zone file format, normally
+		// those of the root zone. If set, the VA validates DNSSEC itself,
+		// starting from these, rather than trusting its resolvers to. Answers
+		// which fail validation are treated as DNS errors.
+		DNSSECTrustAnchors []string
+
 		RemoteVAs                   []cmd.GRPCClientConfig
 		MaxRemoteValidationFailures int
 
@@ -106,6 +112,8 @@ func main() {
 	if dnsTries < 1 {
 		dnsTries = 1
 	}
+	trustAnchors, err := bdns.ParseTrustAnchors(c.VA.DNSSECTrustAnchors)
+	cmd.FailOnError(err, "Couldn't parse DNSSEC trust anchors")
 	clk := cmd.Clock()
 	var resolver bdns.Client
 	if len(c.Common.DNSResolver) != 0 {
@@ -115,6 +123,7 @@ func main() {
 		r := bdns.New(
 			dnsTimeout,
 			c.VA.DNSResolvers,
+			trustAnchors,
 			scope,
 			clk,
 			dnsTries,
@@ -124,6 +133,7 @@ func main() {
 		r := bdns.NewTest(
 			dnsTimeout,
 			c.VA.DNSResolvers,
+			trustAnchors,
 			scope,
 			clk,
 			dnsTries,
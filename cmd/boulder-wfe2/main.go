@@ -93,6 +93,12 @@ type config struct {
 		// "website" field.
 		DirectoryWebsite string
 
+		// ExternalAccountRequired, if true, requires that all new-account
+		// requests include an external account binding (RFC 8555 Section 7.3.4)
+		// signed with a key created by the eab-keys tool. It is also exposed in
+		// the /directory response's "meta" element.
+		ExternalAccountRequired bool
+
 		// ACMEv2 requests (outside some registration/revocation messages) use a JWS with
 		// a KeyID header containing the full account URL. For new accounts this
 		// will be a KeyID based on the HTTP request's Host header and the ACMEv2
@@ -107,6 +113,16 @@ type config struct {
 		// administratively blocked.
 		BlockedKeyFile string
 
+		// AllowEd25519AccountKeys permits Ed25519 keys to be used as ACME
+		// account keys. Certificate keys are unaffected.
+		AllowEd25519AccountKeys bool
+
+		// RenewalInfoOverridesFile is the path to a YAML file containing lists of
+		// serials whose suggested renewal window, as served by the renewalInfo
+		// endpoint, should be replaced with a fixed window. This allows
+		// operators to pull renewal forward for chosen certificates.
+		RenewalInfoOverridesFile string
+
 		// StaleTimeout determines how old should data be to be accessed via Boulder-specific GET-able APIs
 		StaleTimeout cmd.ConfigDuration
 
@@ -374,6 +390,7 @@ func main() {
 	// don't load any weak keys, but do load blocked keys
 	kp, err := goodkey.NewKeyPolicy("", c.WFE.BlockedKeyFile, sac.KeyBlocked)
 	cmd.FailOnError(err, "Unable to create key policy")
+	kp.AllowEd25519 = c.WFE.AllowEd25519AccountKeys
 
 	if c.WFE.StaleTimeout.Duration == 0 {
 		c.WFE.StaleTimeout.Duration = time.Minute * 10
@@ -398,8 +415,14 @@ func main() {
 	wfe.AllowOrigins = c.WFE.AllowOrigins
 	wfe.DirectoryCAAIdentity = c.WFE.DirectoryCAAIdentity
 	wfe.DirectoryWebsite = c.WFE.DirectoryWebsite
+	wfe.ExternalAccountRequired = c.WFE.ExternalAccountRequired
 	wfe.LegacyKeyIDPrefix = c.WFE.LegacyKeyIDPrefix
 
+	if c.WFE.RenewalInfoOverridesFile != "" {
+		err = wfe.SetRenewalInfoOverridesFile(c.WFE.RenewalInfoOverridesFile)
+		cmd.FailOnError(err, "Unable to load renewal info overrides")
+	}
+
 	logger.Infof("WFE using key policy: %#v", kp)
 
 	logger.Infof("Server running, listening on %s....", c.WFE.ListenAddress)
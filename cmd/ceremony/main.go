@@ -301,6 +301,56 @@ func (orc ocspRespConfig) validate() error {
 	return nil
 }
 
+type ocspResponderConfig struct {
+	CeremonyType string              `yaml:"ceremony-type"`
+	PKCS11       PKCS11KeyGenConfig  `yaml:"pkcs11"`
+	IssuerPKCS11 PKCS11SigningConfig `yaml:"issuer-pkcs11"`
+	Key          keyGenConfig        `yaml:"key"`
+	Inputs       struct {
+		IssuerCertificatePath string `yaml:"issuer-certificate-path"`
+	} `yaml:"inputs"`
+	Outputs struct {
+		PublicKeyPath   string `yaml:"public-key-path"`
+		CertificatePath string `yaml:"certificate-path"`
+	} `yaml:"outputs"`
+	CertProfile certProfile `yaml:"certificate-profile"`
+	SkipLints   []string    `yaml:"skip-lints"`
+}
+
+func (orc ocspResponderConfig) validate() error {
+	if err := orc.PKCS11.validate(); err != nil {
+		return err
+	}
+	if err := orc.IssuerPKCS11.validate(); err != nil {
+		return fmt.Errorf("issuer-%s", err)
+	}
+
+	// Key gen fields
+	if err := orc.Key.validate(); err != nil {
+		return err
+	}
+
+	// Input fields
+	if orc.Inputs.IssuerCertificatePath == "" {
+		return errors.New("inputs.issuer-certificate-path is required")
+	}
+
+	// Output fields
+	if err := checkOutputFile(orc.Outputs.PublicKeyPath, "public-key-path"); err != nil {
+		return err
+	}
+	if err := checkOutputFile(orc.Outputs.CertificatePath, "certificate-path"); err != nil {
+		return err
+	}
+
+	// Certificate profile
+	if err := orc.CertProfile.verifyProfile(ocspCert); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 type crlConfig struct {
 	CeremonyType string              `yaml:"ceremony-type"`
 	PKCS11       PKCS11SigningConfig `yaml:"pkcs11"`
@@ -524,6 +574,52 @@ func intermediateCeremony(configBytes []byte, ct certType) error {
 	return nil
 }
 
+// ocspResponderCeremony generates a key for a delegated OCSP responder and
+// issues its certificate from an issuer whose key is already on a HSM, so that
+// the CA can sign OCSP responses without using the issuer's key.
+func ocspResponderCeremony(configBytes []byte) error {
+	var config ocspResponderConfig
+	err := yaml.UnmarshalStrict(configBytes, &config)
+	if err != nil {
+		return fmt.Errorf("failed to parse config: %s", err)
+	}
+	if err := config.validate(); err != nil {
+		return fmt.Errorf("failed to validate config: %s", err)
+	}
+
+	issuer, err := loadCert(config.Inputs.IssuerCertificatePath)
+	if err != nil {
+		return fmt.Errorf("failed to load issuer certificate %q: %s", config.Inputs.IssuerCertificatePath, err)
+	}
+	signer, randReader, err := openSigner(config.IssuerPKCS11, issuer.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	session, err := pkcs11helpers.Initialize(config.PKCS11.Module, config.PKCS11.StoreSlot, config.PKCS11.PIN)
+	if err != nil {
+		return fmt.Errorf("failed to setup session and PKCS#11 context for slot %d: %s", config.PKCS11.StoreSlot, err)
+	}
+	log.Printf("Opened PKCS#11 session for slot %d\n", config.PKCS11.StoreSlot)
+	keyInfo, err := generateKey(session, config.PKCS11.StoreLabel, config.Outputs.PublicKeyPath, config.Key)
+	if err != nil {
+		return err
+	}
+
+	template, err := makeTemplate(randReader, &config.CertProfile, keyInfo.der, ocspCert)
+	if err != nil {
+		return fmt.Errorf("failed to create certificate profile: %s", err)
+	}
+	template.AuthorityKeyId = issuer.SubjectKeyId
+
+	err = signAndWriteCert(template, issuer, keyInfo.key, signer, config.Outputs.CertificatePath, config.SkipLints)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func csrCeremony(configBytes []byte) error {
 	var config csrConfig
 	err := yaml.UnmarshalStrict(configBytes, &config)
@@ -766,6 +862,11 @@ func main() {
 		if err != nil {
 			log.Fatalf("ocsp signer ceremony failed: %s", err)
 		}
+	case "ocsp-responder":
+		err = ocspResponderCeremony(configBytes)
+		if err != nil {
+			log.Fatalf("ocsp responder ceremony failed: %s", err)
+		}
 	case "key":
 		err = keyCeremony(configBytes)
 		if err != nil {
@@ -787,6 +888,6 @@ func main() {
 			log.Fatalf("crl signer ceremony failed: %s", err)
 		}
 	default:
-		log.Fatalf("unknown ceremony-type, must be one of: root, intermediate, ocsp-signer, crl-signer, key, ocsp-response")
+		log.Fatalf("unknown ceremony-type, must be one of: root, intermediate, cross-certificate, cross-csr, ocsp-signer, ocsp-responder, crl-signer, key, ocsp-response, crl")
 	}
 }
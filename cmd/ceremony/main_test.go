@@ -570,6 +570,141 @@ func TestKeyConfigValidate(t *testing.T) {
 	}
 }
 
+func TestOCSPResponderConfigValidate(t *testing.T) {
+	keyGen := PKCS11KeyGenConfig{
+		Module:     "module",
+		StoreLabel: "responder",
+	}
+	issuerSigning := PKCS11SigningConfig{
+		Module:       "module",
+		SigningLabel: "issuer",
+	}
+	key := keyGenConfig{
+		Type:         "rsa",
+		RSAModLength: 2048,
+	}
+	cases := []struct {
+		name          string
+		config        ocspResponderConfig
+		expectedError string
+	}{
+		{
+			name:          "no pkcs11.module",
+			config:        ocspResponderConfig{},
+			expectedError: "pkcs11.module is required",
+		},
+		{
+			name: "no issuer-pkcs11.signing-key-label",
+			config: ocspResponderConfig{
+				PKCS11: keyGen,
+				IssuerPKCS11: PKCS11SigningConfig{
+					Module: "module",
+				},
+			},
+			expectedError: "issuer-pkcs11.signing-key-label is required",
+		},
+		{
+			name: "bad key fields",
+			config: ocspResponderConfig{
+				PKCS11:       keyGen,
+				IssuerPKCS11: issuerSigning,
+			},
+			expectedError: "key.type is required",
+		},
+		{
+			name: "no inputs.issuer-certificate-path",
+			config: ocspResponderConfig{
+				PKCS11:       keyGen,
+				IssuerPKCS11: issuerSigning,
+				Key:          key,
+			},
+			expectedError: "inputs.issuer-certificate-path is required",
+		},
+		{
+			name: "no outputs.public-key-path",
+			config: ocspResponderConfig{
+				PKCS11:       keyGen,
+				IssuerPKCS11: issuerSigning,
+				Key:          key,
+				Inputs: struct {
+					IssuerCertificatePath string `yaml:"issuer-certificate-path"`
+				}{
+					IssuerCertificatePath: "path",
+				},
+			},
+			expectedError: "outputs.public-key-path is required",
+		},
+		{
+			name: "bad certificate-profile",
+			config: ocspResponderConfig{
+				PKCS11:       keyGen,
+				IssuerPKCS11: issuerSigning,
+				Key:          key,
+				Inputs: struct {
+					IssuerCertificatePath string `yaml:"issuer-certificate-path"`
+				}{
+					IssuerCertificatePath: "path",
+				},
+				Outputs: struct {
+					PublicKeyPath   string `yaml:"public-key-path"`
+					CertificatePath string `yaml:"certificate-path"`
+				}{
+					PublicKeyPath:   "path",
+					CertificatePath: "path",
+				},
+				CertProfile: certProfile{
+					NotBefore:          "a",
+					NotAfter:           "b",
+					SignatureAlgorithm: "c",
+					CommonName:         "d",
+					Organization:       "e",
+					Country:            "f",
+					KeyUsages:          []string{"g"},
+				},
+			},
+			expectedError: "key-usages cannot be set for a delegated signer",
+		},
+		{
+			name: "good config",
+			config: ocspResponderConfig{
+				PKCS11:       keyGen,
+				IssuerPKCS11: issuerSigning,
+				Key:          key,
+				Inputs: struct {
+					IssuerCertificatePath string `yaml:"issuer-certificate-path"`
+				}{
+					IssuerCertificatePath: "path",
+				},
+				Outputs: struct {
+					PublicKeyPath   string `yaml:"public-key-path"`
+					CertificatePath string `yaml:"certificate-path"`
+				}{
+					PublicKeyPath:   "path",
+					CertificatePath: "path",
+				},
+				CertProfile: certProfile{
+					NotBefore:          "a",
+					NotAfter:           "b",
+					SignatureAlgorithm: "c",
+					CommonName:         "d",
+					Organization:       "e",
+					Country:            "f",
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.validate()
+			if err != nil && err.Error() != tc.expectedError {
+				t.Fatalf("Unexpected error, wanted: %q, got: %q", tc.expectedError, err)
+			} else if err == nil && tc.expectedError != "" {
+				t.Fatalf("validate didn't fail, wanted: %q", err)
+			}
+		})
+	}
+}
+
 func TestOCSPRespConfig(t *testing.T) {
 	cases := []struct {
 		name          string
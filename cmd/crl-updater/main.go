@@ -0,0 +1,355 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	capb "github.com/letsencrypt/boulder/ca/proto"
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/crl"
+	"github.com/letsencrypt/boulder/features"
+	bgrpc "github.com/letsencrypt/boulder/grpc"
+	"github.com/letsencrypt/boulder/issuance"
+	blog "github.com/letsencrypt/boulder/log"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// crlUpdater periodically generates a complete set of sharded CRLs for each
+// of its issuers. It reads revoked certificates from the SA, has the CA sign
+// a CRL for each shard, and hands the results to a crlStorer.
+type crlUpdater struct {
+	issuers  []*issuance.Certificate
+	sharding crl.ShardingConfig
+
+	crlLifetime time.Duration
+	urlTemplate string
+	// queryByShard makes the updater ask the SA for each shard's certificates
+	// separately, using the shard recorded at issuance, rather than fetching
+	// all of them and partitioning them itself.
+	queryByShard bool
+
+	sa     sapb.StorageAuthorityClient
+	cg     capb.CRLGeneratorClient
+	storer crlStorer
+
+	clk clock.Clock
+	log blog.Logger
+
+	generatedCounter *prometheus.CounterVec
+	tickHistogram    *prometheus.HistogramVec
+}
+
+func newUpdater(
+	issuers []*issuance.Certificate,
+	config CRLUpdaterConfig,
+	sa sapb.StorageAuthorityClient,
+	cg capb.CRLGeneratorClient,
+	storer crlStorer,
+	stats prometheus.Registerer,
+	clk clock.Clock,
+	log blog.Logger,
+) (*crlUpdater, error) {
+	if len(issuers) == 0 {
+		return nil, errors.New("at least one issuer certificate is required")
+	}
+	err := config.Sharding.Validate()
+	if err != nil {
+		return nil, err
+	}
+	if config.UpdatePeriod.Duration <= 0 {
+		return nil, errors.New("update period must be positive")
+	}
+	if config.CRLLifetime.Duration <= config.UpdatePeriod.Duration {
+		return nil, errors.New("CRL lifetime must be longer than the update period")
+	}
+	err = crl.ValidateURLTemplate(config.CRLURLTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	generatedCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "crl_updater_generated",
+		Help: "A counter of CRL shards generated and stored, labelled by result",
+	}, []string{"result"})
+	stats.MustRegister(generatedCounter)
+	tickHistogram := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "crl_updater_ticks",
+		Help: "A histogram of crl-updater tick latencies labelled by result",
+	}, []string{"result"})
+	stats.MustRegister(tickHistogram)
+
+	return &crlUpdater{
+		issuers:          issuers,
+		sharding:         config.Sharding,
+		crlLifetime:      config.CRLLifetime.Duration,
+		urlTemplate:      config.CRLURLTemplate,
+		queryByShard:     config.QueryByShard,
+		sa:               sa,
+		cg:               cg,
+		storer:           storer,
+		clk:              clk,
+		log:              log,
+		generatedCounter: generatedCounter,
+		tickHistogram:    tickHistogram,
+	}, nil
+}
+
+// getRevokedCerts streams the certificates of the given issuer which were
+// unexpired and revoked as of thisUpdate from the SA, calling fn for each
+// one. Later revocations are left for the next CRL, since an entry's
+// revocation time may not be after the CRL's thisUpdate. If shard is non-nil only the
+// certificates recorded as belonging to it are requested.
+func (cu *crlUpdater) getRevokedCerts(ctx context.Context, thisUpdate time.Time, issuer *issuance.Certificate, shard *sapb.CRLShard, fn func(*sapb.RevokedCert) error) error {
+	stream, err := cu.sa.GetRevokedCerts(ctx, &sapb.GetRevokedCertsRequest{
+		IssuerID:      int64(issuer.ID()),
+		ExpiresAfter:  thisUpdate.UnixNano(),
+		Shard:         shard,
+		RevokedBefore: thisUpdate.UnixNano(),
+	})
+	if err != nil {
+		return fmt.Errorf("requesting revoked certificates: %w", err)
+	}
+	for {
+		rc, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading revoked certificates: %w", err)
+		}
+		err = fn(rc)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// getShardedEntries fetches the revoked, unexpired certificates of the given
+// issuer and partitions them into CRL entries for each shard. Every shard is
+// present in the result, even if it has no entries, since an empty CRL still
+// has to be published for it.
+func (cu *crlUpdater) getShardedEntries(ctx context.Context, thisUpdate time.Time, issuer *issuance.Certificate) ([][]*capb.CRLEntry, error) {
+	shards := make([][]*capb.CRLEntry, cu.sharding.NumShards)
+	if cu.queryByShard {
+		for idx := range shards {
+			err := cu.getRevokedCerts(ctx, thisUpdate, issuer, &sapb.CRLShard{Idx: int64(idx)}, func(rc *sapb.RevokedCert) error {
+				shards[idx] = append(shards[idx], crlEntry(rc))
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		return shards, nil
+	}
+
+	err := cu.getRevokedCerts(ctx, thisUpdate, issuer, nil, func(rc *sapb.RevokedCert) error {
+		serial, err := core.StringToSerial(rc.Serial)
+		if err != nil {
+			return fmt.Errorf("parsing serial of revoked certificate: %w", err)
+		}
+		idx := cu.sharding.Shard(serial, time.Unix(0, rc.NotAfter))
+		shards[idx] = append(shards[idx], crlEntry(rc))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return shards, nil
+}
+
+// crlEntry converts a revoked certificate from the SA into a CRL entry.
+func crlEntry(rc *sapb.RevokedCert) *capb.CRLEntry {
+	return &capb.CRLEntry{
+		Serial:    rc.Serial,
+		Reason:    int32(rc.Reason),
+		RevokedAt: rc.RevokedDate,
+	}
+}
+
+// updateIssuer generates and stores the CRLs for every shard of the given
+// issuer. A failure for one shard doesn't prevent the others from being
+// updated.
+func (cu *crlUpdater) updateIssuer(ctx context.Context, thisUpdate time.Time, issuer *issuance.Certificate) error {
+	shards, err := cu.getShardedEntries(ctx, thisUpdate, issuer)
+	if err != nil {
+		return err
+	}
+
+	nameID := int64(issuer.NameID())
+	sharding := &capb.CRLSharding{
+		Scheme:     cu.sharding.Scheme,
+		NumShards:  int64(cu.sharding.NumShards),
+		ShardWidth: int64(cu.sharding.ShardWidth.Duration),
+	}
+	var failed int
+	for idx, entries := range shards {
+		resp, err := cu.cg.GenerateCRL(ctx, &capb.GenerateCRLRequest{
+			IssuerNameID: nameID,
+			Shard:        int64(idx),
+			ThisUpdate:   thisUpdate.UnixNano(),
+			NextUpdate:   thisUpdate.Add(cu.crlLifetime).UnixNano(),
+			IdpURL:       crl.URL(cu.urlTemplate, nameID, idx),
+			Entries:      entries,
+			Sharding:     sharding,
+		})
+		if err == nil {
+			err = cu.storer.storeCRL(ctx, nameID, idx, resp.Crl)
+		}
+		if err != nil {
+			cu.log.AuditErrf("Failed to update CRL shard %d of issuer %q: %s",
+				idx, issuer.Subject.CommonName, err)
+			cu.generatedCounter.WithLabelValues("failed").Inc()
+			failed++
+			continue
+		}
+		cu.generatedCounter.WithLabelValues("success").Inc()
+		cu.log.Infof("Updated CRL shard %d of issuer %q with %d entries",
+			idx, issuer.Subject.CommonName, len(entries))
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to update %d of %d CRL shards", failed, len(shards))
+	}
+	return nil
+}
+
+// tick updates the CRLs of every issuer once, using the current time as their
+// thisUpdate.
+func (cu *crlUpdater) tick(ctx context.Context) error {
+	start := cu.clk.Now()
+	var failed int
+	for _, issuer := range cu.issuers {
+		err := cu.updateIssuer(ctx, start, issuer)
+		if err != nil {
+			cu.log.AuditErrf("Failed to update CRLs of issuer %q: %s", issuer.Subject.CommonName, err)
+			failed++
+		}
+	}
+	result := "success"
+	if failed > 0 {
+		result = "failed"
+	}
+	cu.tickHistogram.WithLabelValues(result).Observe(cu.clk.Since(start).Seconds())
+	if failed > 0 {
+		return fmt.Errorf("failed to update CRLs of %d of %d issuers", failed, len(cu.issuers))
+	}
+	return nil
+}
+
+type config struct {
+	CRLUpdater CRLUpdaterConfig
+
+	Syslog cmd.SyslogConfig
+}
+
+// CRLUpdaterConfig configures which CRLs the crl-updater produces, how often,
+// and where it publishes them.
+type CRLUpdaterConfig struct {
+	cmd.ServiceConfig
+
+	// IssuerCerts are the paths of the certificates of the issuers to
+	// generate CRLs for. The CA must have the keys for all of them.
+	IssuerCerts []string
+
+	// Sharding determines which CRL each certificate is listed in. It must
+	// match the CRLSharding of the issuers' profiles in the CA, which refuses
+	// to sign CRLs for any other sharding.
+	Sharding crl.ShardingConfig
+
+	// UpdatePeriod is how often a complete new set of CRLs is generated.
+	UpdatePeriod cmd.ConfigDuration
+
+	// CRLLifetime is the time between each CRL's thisUpdate and nextUpdate.
+	// It must be longer than UpdatePeriod, and should be long enough that
+	// relying parties don't see an expired CRL if a few updates fail.
+	CRLLifetime cmd.ConfigDuration
+
+	// CRLURLTemplate is the template of the URLs the stored CRLs are served
+	// at, as described by crl.URL. Each CRL's IssuingDistributionPoint is
+	// expanded from it, and it must match the template the CA uses for the
+	// CRLDistributionPoints of the issuers' certificates.
+	CRLURLTemplate string
+
+	// QueryByShard makes the updater fetch each shard's revoked certificates
+	// from the SA using the CRL shard the CA recorded at issuance, instead of
+	// fetching all of them and computing their shards itself. It must only be
+	// enabled once every unexpired certificate of the issuers has a recorded
+	// shard, or certificates without one will be missing from every CRL.
+	QueryByShard bool
+
+	Storage StorageConfig
+
+	SAService           *cmd.GRPCClientConfig
+	CRLGeneratorService *cmd.GRPCClientConfig
+
+	Features map[string]bool
+}
+
+func main() {
+	configFile := flag.String("config", "", "File path to the configuration file for this service")
+	flag.Parse()
+	if *configFile == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	var c config
+	err := cmd.ReadConfigFile(*configFile, &c)
+	cmd.FailOnError(err, "Reading JSON config file into config structure")
+
+	conf := c.CRLUpdater
+	err = features.Set(conf.Features)
+	cmd.FailOnError(err, "Failed to set feature flags")
+
+	stats, logger := cmd.StatsAndLogging(c.Syslog, conf.DebugAddr)
+	defer logger.AuditPanic()
+	logger.Info(cmd.VersionString())
+
+	var issuers []*issuance.Certificate
+	for _, path := range conf.IssuerCerts {
+		issuer, err := issuance.LoadCertificate(path)
+		cmd.FailOnError(err, "Failed to load issuer certificate")
+		issuers = append(issuers, issuer)
+	}
+
+	storer, err := newStorer(conf.Storage)
+	cmd.FailOnError(err, "Failed to configure CRL storage")
+
+	clk := cmd.Clock()
+
+	tlsConfig, err := conf.TLS.Load()
+	cmd.FailOnError(err, "TLS config")
+	clientMetrics := bgrpc.NewClientMetrics(stats)
+
+	saConn, err := bgrpc.ClientSetup(conf.SAService, tlsConfig, clientMetrics, clk)
+	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to SA")
+	sac := sapb.NewStorageAuthorityClient(saConn)
+
+	caConn, err := bgrpc.ClientSetup(conf.CRLGeneratorService, tlsConfig, clientMetrics, clk)
+	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to CA")
+	cgc := capb.NewCRLGeneratorClient(caConn)
+
+	updater, err := newUpdater(issuers, conf, sac, cgc, storer, stats, clk, logger)
+	cmd.FailOnError(err, "Failed to create updater")
+
+	go cmd.CatchSignals(logger, nil)
+
+	for {
+		start := clk.Now()
+		ctx, cancel := context.WithTimeout(context.Background(), conf.UpdatePeriod.Duration)
+		// Errors are logged by tick itself. Failed shards are retried on the
+		// next tick, which comes well before their current CRLs expire.
+		_ = updater.tick(ctx)
+		cancel()
+		clk.Sleep(start.Add(conf.UpdatePeriod.Duration).Sub(clk.Now()))
+	}
+}
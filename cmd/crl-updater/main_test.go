@@ -0,0 +1,251 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"google.golang.org/grpc"
+
+	capb "github.com/letsencrypt/boulder/ca/proto"
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/crl"
+	"github.com/letsencrypt/boulder/issuance"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// fakeRevokedCertsStream returns the given RevokedCerts one at a time.
+type fakeRevokedCertsStream struct {
+	grpc.ClientStream
+	certs []*sapb.RevokedCert
+}
+
+func (s *fakeRevokedCertsStream) Recv() (*sapb.RevokedCert, error) {
+	if len(s.certs) == 0 {
+		return nil, io.EOF
+	}
+	rc := s.certs[0]
+	s.certs = s.certs[1:]
+	return rc, nil
+}
+
+type fakeSAC struct {
+	sapb.StorageAuthorityClient
+	certs []*sapb.RevokedCert
+	// shardCerts are returned instead of certs for requests for a single
+	// shard, as if they had been recorded in that shard at issuance.
+	shardCerts map[int64][]*sapb.RevokedCert
+	requests   []*sapb.GetRevokedCertsRequest
+}
+
+func (sac *fakeSAC) GetRevokedCerts(_ context.Context, req *sapb.GetRevokedCertsRequest, _ ...grpc.CallOption) (sapb.StorageAuthority_GetRevokedCertsClient, error) {
+	sac.requests = append(sac.requests, req)
+	if req.Shard != nil {
+		return &fakeRevokedCertsStream{certs: sac.shardCerts[req.Shard.Idx]}, nil
+	}
+	return &fakeRevokedCertsStream{certs: sac.certs}, nil
+}
+
+type fakeCGC struct {
+	requests  []*capb.GenerateCRLRequest
+	failShard int64
+}
+
+func (cgc *fakeCGC) GenerateCRL(_ context.Context, req *capb.GenerateCRLRequest, _ ...grpc.CallOption) (*capb.GenerateCRLResponse, error) {
+	cgc.requests = append(cgc.requests, req)
+	if req.Shard == cgc.failShard {
+		return nil, errors.New("signing failed")
+	}
+	return &capb.GenerateCRLResponse{Crl: []byte(req.IdpURL)}, nil
+}
+
+type memoryStorer struct {
+	crls map[string][]byte
+}
+
+func (ms *memoryStorer) storeCRL(_ context.Context, issuerNameID int64, shard int, der []byte) error {
+	ms.crls[crl.Path(issuerNameID, shard)] = der
+	return nil
+}
+
+func testConfig() CRLUpdaterConfig {
+	return CRLUpdaterConfig{
+		Sharding:       crl.ShardingConfig{Scheme: crl.ShardBySerial, NumShards: 2},
+		UpdatePeriod:   cmd.ConfigDuration{Duration: 6 * time.Hour},
+		CRLLifetime:    cmd.ConfigDuration{Duration: 24 * time.Hour},
+		CRLURLTemplate: "http://c.example.com/{issuer}/{shard}.crl",
+	}
+}
+
+func setup(t *testing.T) (*crlUpdater, *fakeSAC, *fakeCGC, *memoryStorer, *issuance.Certificate, clock.FakeClock) {
+	t.Helper()
+	issuer, err := issuance.LoadCertificate("../../test/test-ca.pem")
+	test.AssertNotError(t, err, "Failed to load issuer certificate")
+	sac := &fakeSAC{}
+	cgc := &fakeCGC{failShard: -1}
+	storer := &memoryStorer{crls: make(map[string][]byte)}
+	fc := clock.NewFake()
+	fc.Set(time.Date(2021, 4, 10, 0, 0, 0, 0, time.UTC))
+	updater, err := newUpdater(
+		[]*issuance.Certificate{issuer},
+		testConfig(),
+		sac,
+		cgc,
+		storer,
+		metrics.NoopRegisterer,
+		fc,
+		blog.NewMock(),
+	)
+	test.AssertNotError(t, err, "Failed to create updater")
+	return updater, sac, cgc, storer, issuer, fc
+}
+
+func TestNewUpdaterValidation(t *testing.T) {
+	issuer, err := issuance.LoadCertificate("../../test/test-ca.pem")
+	test.AssertNotError(t, err, "Failed to load issuer certificate")
+	issuers := []*issuance.Certificate{issuer}
+
+	testCases := []struct {
+		name    string
+		issuers []*issuance.Certificate
+		modify  func(*CRLUpdaterConfig)
+	}{
+		{"no issuers", nil, func(*CRLUpdaterConfig) {}},
+		{"bad sharding", issuers, func(c *CRLUpdaterConfig) { c.Sharding.NumShards = 0 }},
+		{"no update period", issuers, func(c *CRLUpdaterConfig) { c.UpdatePeriod.Duration = 0 }},
+		{"lifetime too short", issuers, func(c *CRLUpdaterConfig) { c.CRLLifetime = c.UpdatePeriod }},
+		{"bad URL template", issuers, func(c *CRLUpdaterConfig) { c.CRLURLTemplate = "http://c.example.com/crl" }},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := testConfig()
+			tc.modify(&config)
+			_, err := newUpdater(tc.issuers, config, &fakeSAC{}, &fakeCGC{}, &memoryStorer{},
+				metrics.NoopRegisterer, clock.NewFake(), blog.NewMock())
+			test.AssertError(t, err, "newUpdater should have failed")
+		})
+	}
+}
+
+func TestTick(t *testing.T) {
+	updater, sac, cgc, storer, issuer, fc := setup(t)
+	now := fc.Now()
+	notAfter := now.Add(30 * 24 * time.Hour).UnixNano()
+	sac.certs = []*sapb.RevokedCert{
+		{Serial: "000000000000000000000000000000000001", Reason: 1, RevokedDate: 10, NotAfter: notAfter},
+		{Serial: "000000000000000000000000000000000002", Reason: 0, RevokedDate: 20, NotAfter: notAfter},
+		{Serial: "000000000000000000000000000000000003", Reason: 4, RevokedDate: 30, NotAfter: notAfter},
+	}
+
+	err := updater.tick(context.Background())
+	test.AssertNotError(t, err, "tick failed")
+
+	test.AssertEquals(t, len(sac.requests), 1)
+	test.AssertEquals(t, sac.requests[0].IssuerID, int64(issuer.ID()))
+	test.AssertEquals(t, sac.requests[0].ExpiresAfter, now.UnixNano())
+	test.AssertEquals(t, sac.requests[0].RevokedBefore, now.UnixNano())
+	// Every unexpired revoked certificate is listed, however long it has left.
+	test.AssertEquals(t, sac.requests[0].ExpiresBefore, int64(0))
+
+	// Both shards get a CRL, with the entries partitioned by serial.
+	nameID := int64(issuer.NameID())
+	test.AssertEquals(t, len(cgc.requests), 2)
+	for i, req := range cgc.requests {
+		test.AssertEquals(t, req.IssuerNameID, nameID)
+		test.AssertEquals(t, req.Shard, int64(i))
+		test.AssertEquals(t, req.ThisUpdate, now.UnixNano())
+		test.AssertEquals(t, req.NextUpdate, now.Add(24*time.Hour).UnixNano())
+		test.AssertEquals(t, req.IdpURL, crl.URL("http://c.example.com/{issuer}/{shard}.crl", nameID, i))
+		test.AssertDeepEquals(t, req.Sharding, &capb.CRLSharding{Scheme: crl.ShardBySerial, NumShards: 2})
+	}
+	test.AssertDeepEquals(t, cgc.requests[0].Entries, []*capb.CRLEntry{
+		{Serial: "000000000000000000000000000000000002", Reason: 0, RevokedAt: 20},
+	})
+	test.AssertDeepEquals(t, cgc.requests[1].Entries, []*capb.CRLEntry{
+		{Serial: "000000000000000000000000000000000001", Reason: 1, RevokedAt: 10},
+		{Serial: "000000000000000000000000000000000003", Reason: 4, RevokedAt: 30},
+	})
+
+	test.AssertEquals(t, len(storer.crls), 2)
+	test.AssertEquals(t, string(storer.crls[crl.Path(nameID, 0)]), crl.URL("http://c.example.com/{issuer}/{shard}.crl", nameID, 0))
+	test.AssertEquals(t, string(storer.crls[crl.Path(nameID, 1)]), crl.URL("http://c.example.com/{issuer}/{shard}.crl", nameID, 1))
+}
+
+func TestTickQueryByShard(t *testing.T) {
+	updater, sac, cgc, _, issuer, fc := setup(t)
+	updater.queryByShard = true
+	notAfter := fc.Now().Add(30 * 24 * time.Hour).UnixNano()
+	// The recorded shards are trusted, even where they differ from what the
+	// updater's own sharding config would compute.
+	sac.shardCerts = map[int64][]*sapb.RevokedCert{
+		0: {{Serial: "000000000000000000000000000000000001", Reason: 1, RevokedDate: 10, NotAfter: notAfter}},
+		1: {{Serial: "000000000000000000000000000000000002", Reason: 0, RevokedDate: 20, NotAfter: notAfter}},
+	}
+
+	err := updater.tick(context.Background())
+	test.AssertNotError(t, err, "tick failed")
+
+	test.AssertEquals(t, len(sac.requests), 2)
+	for i, req := range sac.requests {
+		test.AssertEquals(t, req.IssuerID, int64(issuer.ID()))
+		test.AssertEquals(t, req.Shard.Idx, int64(i))
+	}
+	test.AssertEquals(t, len(cgc.requests), 2)
+	test.AssertDeepEquals(t, cgc.requests[0].Entries, []*capb.CRLEntry{
+		{Serial: "000000000000000000000000000000000001", Reason: 1, RevokedAt: 10},
+	})
+	test.AssertDeepEquals(t, cgc.requests[1].Entries, []*capb.CRLEntry{
+		{Serial: "000000000000000000000000000000000002", Reason: 0, RevokedAt: 20},
+	})
+}
+
+func TestTickShardFailure(t *testing.T) {
+	updater, _, cgc, storer, issuer, _ := setup(t)
+	cgc.failShard = 0
+
+	err := updater.tick(context.Background())
+	test.AssertError(t, err, "tick should fail when a shard fails")
+
+	// The failure of the first shard must not stop the second from being
+	// updated.
+	test.AssertEquals(t, len(cgc.requests), 2)
+	test.AssertEquals(t, len(storer.crls), 1)
+	_, ok := storer.crls[crl.Path(int64(issuer.NameID()), 1)]
+	test.Assert(t, ok, "Second shard wasn't stored")
+}
+
+func TestDiskStorer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "crl-updater")
+	test.AssertNotError(t, err, "Failed to create temporary directory")
+	defer os.RemoveAll(dir)
+
+	_, err = newStorer(StorageConfig{Type: "disk"})
+	test.AssertError(t, err, "newStorer should fail without a directory")
+	_, err = newStorer(StorageConfig{Type: "s3", Directory: dir})
+	test.AssertError(t, err, "newStorer should fail for an unknown type")
+
+	storer, err := newStorer(StorageConfig{Type: "disk", Directory: dir})
+	test.AssertNotError(t, err, "newStorer failed")
+
+	err = storer.storeCRL(context.Background(), 1234, 5, []byte{1, 2, 3})
+	test.AssertNotError(t, err, "storeCRL failed")
+	err = storer.storeCRL(context.Background(), 1234, 5, []byte{4, 5, 6})
+	test.AssertNotError(t, err, "storeCRL failed to replace a CRL")
+
+	contents, err := ioutil.ReadFile(filepath.Join(dir, "1234", "5.crl"))
+	test.AssertNotError(t, err, "Failed to read stored CRL")
+	test.AssertDeepEquals(t, contents, []byte{4, 5, 6})
+
+	files, err := ioutil.ReadDir(filepath.Join(dir, "1234"))
+	test.AssertNotError(t, err, "Failed to list CRL directory")
+	test.AssertEquals(t, len(files), 1)
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/letsencrypt/boulder/crl"
+)
+
+// crlStorer publishes signed CRLs. Each call replaces the previously stored
+// CRL for the same shard of the same issuer.
+type crlStorer interface {
+	storeCRL(ctx context.Context, issuerNameID int64, shard int, der []byte) error
+}
+
+// StorageConfig selects the backend the crl-updater stores CRLs with.
+type StorageConfig struct {
+	// Type is the storage backend to use. Currently only "disk" is supported.
+	Type string
+	// Directory is the directory the "disk" backend writes CRLs into, laid
+	// out as <issuerNameID>/<shard>.crl so that it can be served directly
+	// under the configured CRLURLTemplate.
+	Directory string
+}
+
+// newStorer returns the crlStorer described by the given config.
+func newStorer(c StorageConfig) (crlStorer, error) {
+	switch c.Type {
+	case "disk":
+		if c.Directory == "" {
+			return nil, fmt.Errorf("disk CRL storage requires a directory")
+		}
+		return &diskStorer{dir: c.Directory}, nil
+	default:
+		return nil, fmt.Errorf("unknown CRL storage type %q", c.Type)
+	}
+}
+
+// diskStorer stores CRLs as DER files in a local directory.
+type diskStorer struct {
+	dir string
+}
+
+// storeCRL writes the CRL to a temporary file next to its final location and
+// then renames it into place, so that readers never see a partially written
+// CRL.
+func (ds *diskStorer) storeCRL(_ context.Context, issuerNameID int64, shard int, der []byte) error {
+	path := filepath.Join(ds.dir, filepath.FromSlash(crl.Path(issuerNameID, shard)))
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(der)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+	err = os.Chmod(tmp.Name(), 0644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
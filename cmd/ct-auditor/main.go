@@ -0,0 +1,449 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	ct "github.com/google/certificate-transparency-go"
+	"github.com/google/certificate-transparency-go/client"
+	"github.com/google/certificate-transparency-go/jsonclient"
+	cttls "github.com/google/certificate-transparency-go/tls"
+	ctx509 "github.com/google/certificate-transparency-go/x509"
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/cmd"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/ctpolicy/ctconfig"
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
+	bgrpc "github.com/letsencrypt/boulder/grpc"
+	"github.com/letsencrypt/boulder/issuance"
+	blog "github.com/letsencrypt/boulder/log"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// The results of auditing an SCT, used as metric labels.
+const (
+	// resultIncluded means the log proved the entry is in its tree.
+	resultIncluded = "included"
+	// resultNotIncluded means the entry is missing from a tree the log signed
+	// after the SCT's MMD had passed.
+	resultNotIncluded = "not_included"
+	// resultBadProof means the log's inclusion proof didn't verify against its
+	// signed tree head.
+	resultBadProof = "bad_proof"
+	// resultStaleSTH means the log hadn't signed a tree head since the SCT's
+	// MMD passed, so it couldn't demonstrate that the entry was merged.
+	resultStaleSTH = "stale_sth"
+	// resultUnknownLog means the SCT is from a log which isn't in the log
+	// list.
+	resultUnknownLog = "unknown_log"
+	// resultError means the audit couldn't be completed, for instance because
+	// the log or the SA couldn't be reached.
+	resultError = "error"
+)
+
+// ctAuditor checks that CT logs have merged the certificates and
+// precertificates they issued SCTs for into their Merkle trees within their
+// maximum merge delay (MMD). It works through the SCTs which the publisher
+// stored in the SA in the order they were stored. Once an SCT's MMD has
+// passed, it fetches the log's latest signed tree head (STH) and an inclusion
+// proof for the entry, and verifies the proof against the STH.
+//
+// Each SCT is audited once it reaches a final result: the auditor only
+// remembers how far through the SCTs it's got, and stops there when an audit
+// couldn't be completed so that it's retried on the next batch. An SCT which
+// never gets a final result is given up on once it's older than the lookback.
+type ctAuditor struct {
+	sa      sapb.StorageAuthorityClient
+	logs    *loglist.Source
+	issuers map[issuance.IssuerNameID]*issuance.Certificate
+	// clients are the CT clients for each log, by base64 log ID.
+	clients   map[string]*client.LogClient
+	userAgent string
+	batchSize int
+	// lookback bounds the age of the SCTs the auditor looks at, which matters
+	// when it starts and doesn't know how far through the SCTs it got before.
+	lookback time.Duration
+	// cursor is the ID of the last SCT with a final audit result.
+	cursor int64
+
+	clk clock.Clock
+	log blog.Logger
+
+	auditedCounter *prometheus.CounterVec
+	lagGauge       prometheus.Gauge
+}
+
+func newAuditor(
+	issuers []*issuance.Certificate,
+	config CTAuditorConfig,
+	sa sapb.StorageAuthorityClient,
+	logs *loglist.Source,
+	stats prometheus.Registerer,
+	clk clock.Clock,
+	log blog.Logger,
+) (*ctAuditor, error) {
+	if config.BatchSize <= 0 {
+		return nil, errors.New("batch size must be positive")
+	}
+	if config.Lookback.Duration <= 0 {
+		return nil, errors.New("lookback must be positive")
+	}
+
+	issuersByNameID := make(map[issuance.IssuerNameID]*issuance.Certificate)
+	for _, issuer := range issuers {
+		issuersByNameID[issuer.NameID()] = issuer
+	}
+
+	auditedCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ct_auditor_scts",
+		Help: "A counter of SCTs audited, labelled by log and result",
+	}, []string{"log", "result"})
+	stats.MustRegister(auditedCounter)
+	lagGauge := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "ct_auditor_lag_seconds",
+		Help: "How long after its MMD passed the most recently audited SCT was audited",
+	})
+	stats.MustRegister(lagGauge)
+
+	return &ctAuditor{
+		sa:             sa,
+		logs:           logs,
+		issuers:        issuersByNameID,
+		clients:        make(map[string]*client.LogClient),
+		userAgent:      config.UserAgent,
+		batchSize:      config.BatchSize,
+		lookback:       config.Lookback.Duration,
+		clk:            clk,
+		log:            log,
+		auditedCounter: auditedCounter,
+		lagGauge:       lagGauge,
+	}, nil
+}
+
+// auditBatch audits the next batch of stored SCTs whose MMD has passed, and
+// returns how many it audited. Fewer than the batch size means the auditor
+// has caught up.
+func (a *ctAuditor) auditBatch(ctx context.Context) (int, error) {
+	now := a.clk.Now()
+	resp, err := a.sa.GetRecentSCTs(ctx, &sapb.GetRecentSCTsRequest{
+		AfterID: a.cursor,
+		Since:   now.Add(-a.lookback).UnixNano(),
+		Limit:   int64(a.batchSize),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("getting recent SCTs: %w", err)
+	}
+
+	logs := a.logs.All()
+	// Each log's STH is fetched once per batch, and shared by all of the
+	// batch's SCTs from that log.
+	sths := make(map[string]*ct.SignedTreeHead)
+	var audited int
+	for _, sct := range resp.Scts {
+		logID := base64.StdEncoding.EncodeToString(sct.LogID)
+		l, ok := logs.FindByID(logID)
+		if !ok {
+			a.record(sct, sct.LogURL, resultUnknownLog, fmt.Errorf("log ID %s isn't in the log list", logID))
+			a.cursor = sct.Id
+			audited++
+			continue
+		}
+		deadline := time.Unix(0, sct.Timestamp).Add(l.MMD)
+		if now.Before(deadline) {
+			// SCTs are stored in roughly the order they were issued, so the
+			// rest of the batch isn't due yet either.
+			break
+		}
+		result, err := a.audit(ctx, sct, l, deadline, sths)
+		a.record(sct, l.URL, result, err)
+		if result == resultError || result == resultStaleSTH {
+			// Leave the cursor here so that this SCT, and everything after
+			// it, is audited again on the next batch.
+			break
+		}
+		a.lagGauge.Set(now.Sub(deadline).Seconds())
+		a.cursor = sct.Id
+		audited++
+	}
+	return audited, nil
+}
+
+// record counts the result of auditing an SCT, and audit logs it if the log
+// didn't prove that it merged the entry.
+func (a *ctAuditor) record(sct *sapb.SCT, logURL string, result string, err error) {
+	a.auditedCounter.WithLabelValues(logURL, result).Inc()
+	if result == resultIncluded {
+		return
+	}
+	a.log.AuditErrf("CT audit of SCT from %s for serial %s (precertificate: %t, timestamp: %s): %s: %s",
+		logURL, sct.Serial, sct.Precertificate, time.Unix(0, sct.Timestamp).UTC(), result, err)
+}
+
+// audit checks that the entry for an SCT is included in the log's latest
+// STH, which must have been signed after the given MMD deadline.
+func (a *ctAuditor) audit(ctx context.Context, sct *sapb.SCT, l loglist.Log, deadline time.Time, sths map[string]*ct.SignedTreeHead) (string, error) {
+	hash, err := a.leafHash(ctx, sct)
+	if err != nil {
+		return resultError, err
+	}
+	lc, err := a.client(l)
+	if err != nil {
+		return resultError, err
+	}
+	sth, ok := sths[l.ID]
+	if !ok {
+		sth, err = lc.GetSTH(ctx)
+		if err != nil {
+			return resultError, fmt.Errorf("getting STH: %w", err)
+		}
+		sths[l.ID] = sth
+	}
+
+	sthTime := time.Unix(0, int64(sth.Timestamp)*int64(time.Millisecond))
+	if sthTime.Before(deadline) {
+		return resultStaleSTH, fmt.Errorf("latest STH is from %s, before the MMD deadline of %s", sthTime.UTC(), deadline.UTC())
+	}
+	if sth.TreeSize == 0 {
+		return resultNotIncluded, errors.New("tree is empty")
+	}
+	proof, err := lc.GetProofByHash(ctx, hash[:], sth.TreeSize)
+	if err != nil {
+		var rspErr jsonclient.RspError
+		if errors.As(err, &rspErr) && rspErr.StatusCode == http.StatusNotFound {
+			return resultNotIncluded, fmt.Errorf("leaf hash %x not found in tree of size %d: %s", hash, sth.TreeSize, rspErr.Body)
+		}
+		return resultError, fmt.Errorf("getting inclusion proof: %w", err)
+	}
+	err = verifyInclusion(proof.LeafIndex, sth.TreeSize, hash, proof.AuditPath, sth.SHA256RootHash)
+	if err != nil {
+		return resultBadProof, fmt.Errorf("verifying inclusion proof in tree of size %d: %w", sth.TreeSize, err)
+	}
+	return resultIncluded, nil
+}
+
+// leafHash computes the Merkle tree leaf hash of the log entry an SCT was
+// issued for, from the certificate or precertificate stored in the SA. The
+// entry's timestamp is taken from the stored SCT itself, since it's part of
+// the leaf and must be exact to the millisecond.
+func (a *ctAuditor) leafHash(ctx context.Context, sct *sapb.SCT) ([sha256.Size]byte, error) {
+	var parsedSCT ct.SignedCertificateTimestamp
+	_, err := cttls.Unmarshal(sct.Sct, &parsedSCT)
+	if err != nil {
+		return [sha256.Size]byte{}, fmt.Errorf("parsing SCT: %w", err)
+	}
+	var certPB *corepb.Certificate
+	if sct.Precertificate {
+		certPB, err = a.sa.GetPrecertificate(ctx, &sapb.Serial{Serial: sct.Serial})
+	} else {
+		certPB, err = a.sa.GetCertificate(ctx, &sapb.Serial{Serial: sct.Serial})
+	}
+	if err != nil {
+		return [sha256.Size]byte{}, fmt.Errorf("getting certificate: %w", err)
+	}
+	cert, err := x509.ParseCertificate(certPB.Der)
+	if err != nil {
+		return [sha256.Size]byte{}, fmt.Errorf("parsing certificate: %w", err)
+	}
+
+	entry := &ct.TimestampedEntry{
+		EntryType: ct.X509LogEntryType,
+		Timestamp: parsedSCT.Timestamp,
+		X509Entry: &ct.ASN1Cert{Data: cert.Raw},
+	}
+	if sct.Precertificate {
+		issuer, ok := a.issuers[issuance.GetIssuerNameID(cert)]
+		if !ok {
+			return [sha256.Size]byte{}, fmt.Errorf("no issuer certificate for %q", cert.Issuer)
+		}
+		tbs, err := ctx509.BuildPrecertTBS(cert.RawTBSCertificate, nil)
+		if err != nil {
+			return [sha256.Size]byte{}, fmt.Errorf("removing poison extension: %w", err)
+		}
+		entry.EntryType = ct.PrecertLogEntryType
+		entry.X509Entry = nil
+		entry.PrecertEntry = &ct.PreCert{
+			IssuerKeyHash:  sha256.Sum256(issuer.RawSubjectPublicKeyInfo),
+			TBSCertificate: tbs,
+		}
+	}
+	return ct.LeafHashForLeaf(&ct.MerkleTreeLeaf{
+		Version:          ct.V1,
+		LeafType:         ct.TimestampedEntryLeafType,
+		TimestampedEntry: entry,
+	})
+}
+
+// client returns a CT client for the log, creating it if necessary. The
+// client verifies the signatures of the STHs it fetches.
+func (a *ctAuditor) client(l loglist.Log) (*client.LogClient, error) {
+	lc, ok := a.clients[l.ID]
+	if ok {
+		return lc, nil
+	}
+	der, err := base64.StdEncoding.DecodeString(l.Key)
+	if err != nil {
+		return nil, fmt.Errorf("decoding log public key: %w", err)
+	}
+	lc, err = client.New(strings.TrimSuffix(l.URL, "/"), &http.Client{}, jsonclient.Options{
+		PublicKeyDER: der,
+		UserAgent:    a.userAgent,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating CT client: %w", err)
+	}
+	a.clients[l.ID] = lc
+	return lc, nil
+}
+
+// hashChildren returns the hash of an interior Merkle tree node with the
+// given children, as defined in RFC 6962 section 2.1.
+func hashChildren(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{1})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// verifyInclusion checks that the audit path proves the inclusion of the leaf
+// hash at the given index in the tree of the given size and root hash, using
+// the algorithm of RFC 9162 section 2.1.3.2.
+func verifyInclusion(index int64, treeSize uint64, leafHash [sha256.Size]byte, path [][]byte, root ct.SHA256Hash) error {
+	if index < 0 || uint64(index) >= treeSize {
+		return fmt.Errorf("leaf index %d is outside the tree", index)
+	}
+	fn, sn := uint64(index), treeSize-1
+	r := leafHash[:]
+	for _, p := range path {
+		if sn == 0 {
+			return errors.New("audit path is too long")
+		}
+		if fn&1 == 1 || fn == sn {
+			r = hashChildren(p, r)
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			r = hashChildren(r, p)
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+	if sn != 0 {
+		return errors.New("audit path is too short")
+	}
+	if string(r) != string(root[:]) {
+		return fmt.Errorf("computed root hash %x doesn't match the STH's %x", r, root)
+	}
+	return nil
+}
+
+type config struct {
+	CTAuditor CTAuditorConfig
+
+	Syslog cmd.SyslogConfig
+}
+
+// CTAuditorConfig configures which SCTs the ct-auditor audits, and how often.
+type CTAuditorConfig struct {
+	cmd.ServiceConfig
+
+	// LogList is the v3 log list which each log's URL, key and MMD are taken
+	// from. SCTs from logs in every state are audited, so its States are
+	// ignored.
+	LogList ctconfig.LogListConfig
+
+	// IssuerCerts are the paths of the certificates of the issuers of the
+	// audited precertificates, which are needed to compute their log entries.
+	IssuerCerts []string
+
+	// Lookback is how far before the current time the SCTs the auditor audits
+	// may be from. When the auditor starts, it begins with the earliest SCT
+	// within it. It should be longer than the longest MMD of the logs.
+	Lookback cmd.ConfigDuration
+
+	// BatchSize is the number of SCTs to fetch from the SA at once.
+	BatchSize int
+
+	// Frequency is how long to wait before looking for more SCTs once the
+	// auditor has caught up.
+	Frequency cmd.ConfigDuration
+
+	// Timeout bounds the time spent auditing each batch.
+	Timeout cmd.ConfigDuration
+
+	// UserAgent is sent with each request to the logs.
+	UserAgent string
+
+	SAService *cmd.GRPCClientConfig
+}
+
+func main() {
+	configFile := flag.String("config", "", "File path to the configuration file for this service")
+	flag.Parse()
+	if *configFile == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	var c config
+	err := cmd.ReadConfigFile(*configFile, &c)
+	cmd.FailOnError(err, "Reading JSON config file into config structure")
+
+	conf := c.CTAuditor
+	stats, logger := cmd.StatsAndLogging(c.Syslog, conf.DebugAddr)
+	defer logger.AuditPanic()
+	logger.Info(cmd.VersionString())
+
+	if conf.Frequency.Duration <= 0 || conf.Timeout.Duration <= 0 {
+		cmd.Fail("Frequency and Timeout must be positive")
+	}
+
+	var issuers []*issuance.Certificate
+	for _, path := range conf.IssuerCerts {
+		issuer, err := issuance.LoadCertificate(path)
+		cmd.FailOnError(err, "Failed to load issuer certificate")
+		issuers = append(issuers, issuer)
+	}
+
+	logs, err := loglist.NewSourceFromConfig(conf.LogList, logger)
+	cmd.FailOnError(err, "Failed to load log list")
+
+	clk := cmd.Clock()
+
+	tlsConfig, err := conf.TLS.Load()
+	cmd.FailOnError(err, "TLS config")
+	clientMetrics := bgrpc.NewClientMetrics(stats)
+	saConn, err := bgrpc.ClientSetup(conf.SAService, tlsConfig, clientMetrics, clk)
+	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to SA")
+	sac := sapb.NewStorageAuthorityClient(saConn)
+
+	auditor, err := newAuditor(issuers, conf, sac, logs, stats, clk, logger)
+	cmd.FailOnError(err, "Failed to create auditor")
+
+	go cmd.CatchSignals(logger, nil)
+
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), conf.Timeout.Duration)
+		audited, err := auditor.auditBatch(ctx)
+		cancel()
+		if err != nil {
+			logger.AuditErrf("Failed to audit SCTs: %s", err)
+		}
+		if audited < conf.BatchSize {
+			clk.Sleep(conf.Frequency.Duration)
+		}
+	}
+}
@@ -0,0 +1,388 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	ct "github.com/google/certificate-transparency-go"
+	cttls "github.com/google/certificate-transparency-go/tls"
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
+	"github.com/letsencrypt/boulder/issuance"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/sa"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/sa/satest"
+	"github.com/letsencrypt/boulder/test"
+	"github.com/letsencrypt/boulder/test/ct-test-srv/fakelog"
+	"github.com/letsencrypt/boulder/test/vars"
+)
+
+type fakeSAC struct {
+	sapb.StorageAuthorityClient
+	scts     []*sapb.SCT
+	precerts map[string][]byte
+	certs    map[string][]byte
+}
+
+func (sac *fakeSAC) GetRecentSCTs(_ context.Context, req *sapb.GetRecentSCTsRequest, _ ...grpc.CallOption) (*sapb.SCTs, error) {
+	resp := &sapb.SCTs{}
+	for _, sct := range sac.scts {
+		if sct.Id > req.AfterID && sct.Timestamp >= req.Since && int64(len(resp.Scts)) < req.Limit {
+			resp.Scts = append(resp.Scts, sct)
+		}
+	}
+	return resp, nil
+}
+
+func (sac *fakeSAC) GetPrecertificate(_ context.Context, req *sapb.Serial, _ ...grpc.CallOption) (*corepb.Certificate, error) {
+	return &corepb.Certificate{Serial: req.Serial, Der: sac.precerts[req.Serial]}, nil
+}
+
+func (sac *fakeSAC) GetCertificate(_ context.Context, req *sapb.Serial, _ ...grpc.CallOption) (*corepb.Certificate, error) {
+	return &corepb.Certificate{Serial: req.Serial, Der: sac.certs[req.Serial]}, nil
+}
+
+// saClient adapts an SA to the client interface the auditor uses.
+type saClient struct {
+	sapb.StorageAuthorityClient
+	sa *sa.SQLStorageAuthority
+}
+
+func (sac *saClient) GetRecentSCTs(ctx context.Context, req *sapb.GetRecentSCTsRequest, _ ...grpc.CallOption) (*sapb.SCTs, error) {
+	return sac.sa.GetRecentSCTs(ctx, req)
+}
+
+func (sac *saClient) GetPrecertificate(ctx context.Context, req *sapb.Serial, _ ...grpc.CallOption) (*corepb.Certificate, error) {
+	return sac.sa.GetPrecertificate(ctx, req)
+}
+
+// testLog is a fake CT log, served over HTTP, and its entry in the log list.
+type testLog struct {
+	*fakelog.Log
+	srv   *httptest.Server
+	entry loglist.Log
+}
+
+func newTestLog(t *testing.T, clk clock.Clock) *testLog {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating log key")
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	test.AssertNotError(t, err, "marshalling log key")
+	id := sha256.Sum256(der)
+
+	l := &testLog{Log: fakelog.New(key, clk)}
+	m := http.NewServeMux()
+	m.HandleFunc("/ct/v1/get-sth", l.GetSTH)
+	m.HandleFunc("/ct/v1/get-proof-by-hash", l.GetProofByHash)
+	l.srv = httptest.NewServer(m)
+	l.entry = loglist.Log{
+		Operator: "Test",
+		ID:       base64.StdEncoding.EncodeToString(id[:]),
+		Key:      base64.StdEncoding.EncodeToString(der),
+		URL:      l.srv.URL + "/",
+		MMD:      time.Hour,
+		State:    loglist.Usable,
+	}
+	return l
+}
+
+// addNoise adds unrelated entries to a log, so that inclusion proofs aren't
+// trivial, and returns their leaf hashes.
+func addNoise(l *fakelog.Log, n int) [][sha256.Size]byte {
+	var hashes [][sha256.Size]byte
+	for i := 0; i < n; i++ {
+		var hash [sha256.Size]byte
+		_, _ = rand.Read(hash[:])
+		l.AddLeafHash(hash)
+		hashes = append(hashes, hash)
+	}
+	return hashes
+}
+
+// issue returns an issuer, and a precertificate and certificate it issued
+// with the given serial.
+func issue(t *testing.T, serial int64) (*issuance.Certificate, []byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating key")
+	issuerTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "auditor test issuer"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	issuerDER, err := x509.CreateCertificate(rand.Reader, issuerTemplate, issuerTemplate, &key.PublicKey, key)
+	test.AssertNotError(t, err, "creating issuer")
+	issuerCert, err := x509.ParseCertificate(issuerDER)
+	test.AssertNotError(t, err, "parsing issuer")
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		DNSNames:     []string{"example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtraExtensions: []pkix.Extension{{
+			// The CT poison extension
+			Id:       asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 3},
+			Critical: true,
+			Value:    []byte{5, 0},
+		}},
+	}
+	precert, err := x509.CreateCertificate(rand.Reader, template, issuerCert, &key.PublicKey, key)
+	test.AssertNotError(t, err, "creating precertificate")
+	template.ExtraExtensions = nil
+	cert, err := x509.CreateCertificate(rand.Reader, template, issuerCert, &key.PublicKey, key)
+	test.AssertNotError(t, err, "creating certificate")
+	return &issuance.Certificate{Certificate: issuerCert}, precert, cert
+}
+
+// marshalSCT returns an SCT from the log with the given ID for an entry with
+// the given timestamp, as stored in the SA. The auditor doesn't check SCT
+// signatures, so the signature is a placeholder.
+func marshalSCT(t *testing.T, logID []byte, timestamp time.Time) []byte {
+	t.Helper()
+	var id ct.LogID
+	copy(id.KeyID[:], logID)
+	sct, err := cttls.Marshal(ct.SignedCertificateTimestamp{
+		SCTVersion: ct.V1,
+		LogID:      id,
+		Timestamp:  uint64(timestamp.UnixNano() / int64(time.Millisecond)),
+		Signature: ct.DigitallySigned{
+			Algorithm: cttls.SignatureAndHashAlgorithm{Hash: cttls.SHA256, Signature: cttls.ECDSA},
+			Signature: []byte{1},
+		},
+	})
+	test.AssertNotError(t, err, "marshalling SCT")
+	return sct
+}
+
+func TestVerifyInclusion(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating log key")
+	for size := 1; size <= 17; size++ {
+		l := fakelog.New(key, clock.NewFake())
+		leaves := addNoise(l, size)
+		sth, err := l.STH()
+		test.AssertNotError(t, err, "getting STH")
+		for idx, hash := range leaves {
+			proofIdx, path, err := l.InclusionProof(hash, sth.TreeSize)
+			test.AssertNotError(t, err, "getting inclusion proof")
+			test.AssertEquals(t, proofIdx, idx)
+			var auditPath [][]byte
+			for _, node := range path {
+				node := node
+				auditPath = append(auditPath, node[:])
+			}
+			err = verifyInclusion(int64(idx), sth.TreeSize, hash, auditPath, sth.SHA256RootHash)
+			test.AssertNotError(t, err, "verifying valid inclusion proof")
+
+			err = verifyInclusion(int64(idx), sth.TreeSize, hash, append(auditPath, make([]byte, sha256.Size)), sth.SHA256RootHash)
+			test.AssertError(t, err, "verified overlong inclusion proof")
+			err = verifyInclusion(int64(idx)+1, sth.TreeSize, hash, auditPath, sth.SHA256RootHash)
+			test.AssertError(t, err, "verified inclusion proof with the wrong index")
+			if len(auditPath) > 0 {
+				err = verifyInclusion(int64(idx), sth.TreeSize, hash, auditPath[1:], sth.SHA256RootHash)
+				test.AssertError(t, err, "verified truncated inclusion proof")
+				auditPath[0] = make([]byte, sha256.Size)
+				err = verifyInclusion(int64(idx), sth.TreeSize, hash, auditPath, sth.SHA256RootHash)
+				test.AssertError(t, err, "verified tampered inclusion proof")
+			}
+		}
+	}
+}
+
+func TestAuditBatch(t *testing.T) {
+	fc := clock.NewFake()
+	issued := time.Date(2026, 1, 1, 0, 0, 0, 123*int(time.Millisecond), time.UTC)
+	fc.Set(issued)
+
+	good := newTestLog(t, fc)
+	defer good.srv.Close()
+	// The withholding log never merges the entries.
+	withholding := newTestLog(t, fc)
+	defer withholding.srv.Close()
+	// The stale log's clock stops just before the MMD passes, so it never
+	// signs a tree head late enough to show that it merged the entries.
+	staleClk := clock.NewFake()
+	staleClk.Set(issued.Add(59 * time.Minute))
+	stale := newTestLog(t, staleClk)
+	defer stale.srv.Close()
+
+	issuer, precert, cert := issue(t, 1234)
+	serial := core.SerialToString(big.NewInt(1234))
+	issuerCert := ct.ASN1Cert{Data: issuer.Raw}
+	timestamp := uint64(issued.UnixNano() / int64(time.Millisecond))
+	later := issued.Add(30 * time.Minute)
+	for _, l := range []*testLog{good, withholding, stale} {
+		addNoise(l.Log, 5)
+		l.Withhold(l == withholding)
+		err := l.Add([]ct.ASN1Cert{{Data: precert}, issuerCert}, true, timestamp)
+		test.AssertNotError(t, err, "adding precertificate to log")
+		err = l.Add([]ct.ASN1Cert{{Data: cert}, issuerCert}, false, timestamp)
+		test.AssertNotError(t, err, "adding certificate to log")
+		l.Withhold(false)
+		addNoise(l.Log, 6)
+	}
+	err := good.Add([]ct.ASN1Cert{{Data: precert}, issuerCert}, true, uint64(later.UnixNano()/int64(time.Millisecond)))
+	test.AssertNotError(t, err, "adding precertificate to log")
+
+	logID := func(l *testLog) []byte {
+		id, _ := base64.StdEncoding.DecodeString(l.entry.ID)
+		return id
+	}
+	sac := &fakeSAC{
+		scts: []*sapb.SCT{
+			{Id: 1, Serial: serial, LogID: logID(good), LogURL: good.entry.URL, Timestamp: issued.UnixNano(), Precertificate: true, Sct: marshalSCT(t, logID(good), issued)},
+			{Id: 2, Serial: serial, LogID: logID(good), LogURL: good.entry.URL, Timestamp: issued.UnixNano(), Sct: marshalSCT(t, logID(good), issued)},
+			{Id: 3, Serial: serial, LogID: logID(withholding), LogURL: withholding.entry.URL, Timestamp: issued.UnixNano(), Precertificate: true, Sct: marshalSCT(t, logID(withholding), issued)},
+			{Id: 4, Serial: serial, LogID: logID(stale), LogURL: stale.entry.URL, Timestamp: issued.UnixNano(), Precertificate: true, Sct: marshalSCT(t, logID(stale), issued)},
+			{Id: 5, Serial: serial, LogID: make([]byte, 32), LogURL: "https://unknown.example.com/", Timestamp: issued.UnixNano(), Precertificate: true},
+			{Id: 6, Serial: serial, LogID: logID(good), LogURL: good.entry.URL, Timestamp: later.UnixNano(), Precertificate: true, Sct: marshalSCT(t, logID(good), later)},
+		},
+		precerts: map[string][]byte{serial: precert},
+		certs:    map[string][]byte{serial: cert},
+	}
+	log := blog.NewMock()
+	auditor, err := newAuditor(
+		[]*issuance.Certificate{issuer},
+		CTAuditorConfig{BatchSize: 10, Lookback: cmd.ConfigDuration{Duration: 24 * time.Hour}},
+		sac,
+		loglist.NewStaticSource(loglist.List{good.entry, withholding.entry, stale.entry}),
+		metrics.NoopRegisterer,
+		fc,
+		log,
+	)
+	test.AssertNotError(t, err, "creating auditor")
+	count := func(l, result string) int {
+		return test.CountCounter(auditor.auditedCounter.With(prometheus.Labels{"log": l, "result": result}))
+	}
+
+	// Nothing is due until the MMD has passed.
+	fc.Set(issued.Add(30 * time.Minute))
+	audited, err := auditor.auditBatch(context.Background())
+	test.AssertNotError(t, err, "auditing batch")
+	test.AssertEquals(t, audited, 0)
+
+	// The audit of the stale log's SCT can't be completed, so the auditor
+	// stops there and retries it on the next batch.
+	fc.Set(issued.Add(80 * time.Minute))
+	audited, err = auditor.auditBatch(context.Background())
+	test.AssertNotError(t, err, "auditing batch")
+	test.AssertEquals(t, audited, 3)
+	test.AssertEquals(t, auditor.cursor, int64(3))
+	test.AssertEquals(t, count(good.entry.URL, resultIncluded), 2)
+	test.AssertEquals(t, count(withholding.entry.URL, resultNotIncluded), 1)
+	test.AssertEquals(t, count(stale.entry.URL, resultStaleSTH), 1)
+	test.AssertEquals(t, len(log.GetAllMatching("ERR: \\[AUDIT\\] CT audit of SCT")), 2)
+
+	audited, err = auditor.auditBatch(context.Background())
+	test.AssertNotError(t, err, "auditing batch")
+	test.AssertEquals(t, audited, 0)
+	test.AssertEquals(t, auditor.cursor, int64(3))
+	test.AssertEquals(t, count(stale.entry.URL, resultStaleSTH), 2)
+
+	// Once the stale log signs a new tree head the audit completes. The last
+	// SCT isn't due until its own MMD has passed.
+	staleClk.Set(issued.Add(2 * time.Hour))
+	audited, err = auditor.auditBatch(context.Background())
+	test.AssertNotError(t, err, "auditing batch")
+	test.AssertEquals(t, audited, 2)
+	test.AssertEquals(t, auditor.cursor, int64(5))
+	test.AssertEquals(t, count(stale.entry.URL, resultIncluded), 1)
+	test.AssertEquals(t, count("https://unknown.example.com/", resultUnknownLog), 1)
+
+	fc.Add(time.Hour)
+	audited, err = auditor.auditBatch(context.Background())
+	test.AssertNotError(t, err, "auditing batch")
+	test.AssertEquals(t, audited, 1)
+	test.AssertEquals(t, count(good.entry.URL, resultIncluded), 3)
+	test.AssertEquals(t, auditor.cursor, int64(6))
+}
+
+func TestAuditSCTFromSA(t *testing.T) {
+	if os.Getenv("BOULDER_CONFIG_DIR") != "test/config-next" {
+		t.Skip("the certificateSCTs table only exists in the config-next schema")
+	}
+	fc := clock.NewFake()
+	// The SCT's timestamp has a millisecond part, which must survive storage
+	// for the leaf hash to match the log's.
+	issued := time.Now().Truncate(time.Second).Add(123 * time.Millisecond)
+	fc.Set(issued)
+
+	dbMap, err := sa.NewDbMap(vars.DBConnSA, sa.DbSettings{})
+	test.AssertNotError(t, err, "creating dbMap")
+	ssa, err := sa.NewSQLStorageAuthority(dbMap, fc, blog.NewMock(), metrics.NoopRegisterer, 1)
+	test.AssertNotError(t, err, "creating SA")
+	defer test.ResetSATestDatabase(t)()
+
+	l := newTestLog(t, fc)
+	defer l.srv.Close()
+	issuer, precert, _ := issue(t, 1234)
+	serial := core.SerialToString(big.NewInt(1234))
+	addNoise(l.Log, 3)
+	err = l.Add([]ct.ASN1Cert{{Data: precert}, {Data: issuer.Raw}}, true, uint64(issued.UnixNano()/int64(time.Millisecond)))
+	test.AssertNotError(t, err, "adding precertificate to log")
+	addNoise(l.Log, 4)
+
+	reg := satest.CreateWorkingRegistration(t, ssa)
+	_, err = ssa.AddPrecertificate(context.Background(), &sapb.AddCertificateRequest{
+		Der:      precert,
+		RegID:    reg.ID,
+		Issued:   issued.UnixNano(),
+		IssuerID: int64(issuer.ID()),
+	})
+	test.AssertNotError(t, err, "adding precertificate to SA")
+	logID, err := base64.StdEncoding.DecodeString(l.entry.ID)
+	test.AssertNotError(t, err, "decoding log ID")
+	_, err = ssa.AddSCT(context.Background(), &sapb.SCT{
+		Serial:         serial,
+		LogID:          logID,
+		LogURL:         l.entry.URL,
+		Timestamp:      issued.UnixNano(),
+		Precertificate: true,
+		Sct:            marshalSCT(t, logID, issued),
+	})
+	test.AssertNotError(t, err, "adding SCT to SA")
+
+	auditor, err := newAuditor(
+		[]*issuance.Certificate{issuer},
+		CTAuditorConfig{BatchSize: 10, Lookback: cmd.ConfigDuration{Duration: 24 * time.Hour}},
+		&saClient{sa: ssa},
+		loglist.NewStaticSource(loglist.List{l.entry}),
+		metrics.NoopRegisterer,
+		fc,
+		blog.NewMock(),
+	)
+	test.AssertNotError(t, err, "creating auditor")
+
+	fc.Add(2 * time.Hour)
+	audited, err := auditor.auditBatch(context.Background())
+	test.AssertNotError(t, err, "auditing batch")
+	test.AssertEquals(t, audited, 1)
+	test.AssertEquals(t, test.CountCounter(auditor.auditedCounter.With(prometheus.Labels{"log": l.entry.URL, "result": resultIncluded})), 1)
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/letsencrypt/boulder/cmd"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/features"
+	bgrpc "github.com/letsencrypt/boulder/grpc"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+const usageString = `
+usage:
+eab-keys create --config <path> <key-id>
+eab-keys revoke --config <path> <key-id>
+
+command descriptions:
+  create      Create a new external account binding key with the given key
+              identifier and print its base64url encoded HMAC key
+  revoke      Revoke an external account binding key so that it can no longer
+              be used to create new accounts
+
+args:
+  config    File path to the configuration file for this service
+`
+
+// hmacKeyLength is the length, in bytes, of generated HMAC keys. It matches
+// the output size of SHA-256, as recommended by RFC 2104 for HS256.
+const hmacKeyLength = 32
+
+type config struct {
+	EABKeys struct {
+		// The tool needs a TLSConfig to set up its gRPC client certs, but
+		// doesn't get the TLS field from ServiceConfig, so declares its own.
+		TLS cmd.TLSConfig
+
+		SAService *cmd.GRPCClientConfig
+
+		Features map[string]bool
+	}
+
+	Syslog cmd.SyslogConfig
+}
+
+// eabKeyStorage is the subset of the SA's methods needed to manage external
+// account binding keys.
+type eabKeyStorage interface {
+	AddExternalAccountKey(ctx context.Context, req *sapb.AddExternalAccountKeyRequest) (*corepb.Empty, error)
+	RevokeExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKeyID) (*corepb.Empty, error)
+}
+
+func setupContext(c config) (blog.Logger, eabKeyStorage) {
+	logger := cmd.NewLogger(c.Syslog)
+
+	tlsConfig, err := c.EABKeys.TLS.Load()
+	cmd.FailOnError(err, "TLS config")
+
+	clientMetrics := bgrpc.NewClientMetrics(metrics.NoopRegisterer)
+	saConn, err := bgrpc.ClientSetup(c.EABKeys.SAService, tlsConfig, clientMetrics, cmd.Clock())
+	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to SA")
+	sac := bgrpc.NewStorageAuthorityClient(sapb.NewStorageAuthorityClient(saConn))
+
+	return logger, sac
+}
+
+// createKey generates a random HMAC key, stores it in the SA under the given
+// key identifier, and returns its base64url encoding for distribution to the
+// external account holder.
+func createKey(ctx context.Context, sa eabKeyStorage, randReader io.Reader, keyID string, logger blog.Logger) (string, error) {
+	if keyID == "" {
+		return "", errors.New("key identifier must not be empty")
+	}
+	hmacKey := make([]byte, hmacKeyLength)
+	_, err := io.ReadFull(randReader, hmacKey)
+	if err != nil {
+		return "", err
+	}
+	_, err = sa.AddExternalAccountKey(ctx, &sapb.AddExternalAccountKeyRequest{
+		KeyID:   keyID,
+		HmacKey: hmacKey,
+	})
+	if err != nil {
+		return "", err
+	}
+	logger.AuditInfof("Created external account binding key %q", keyID)
+	return base64.RawURLEncoding.EncodeToString(hmacKey), nil
+}
+
+// revokeKey revokes the external account binding key with the given key
+// identifier.
+func revokeKey(ctx context.Context, sa eabKeyStorage, keyID string, logger blog.Logger) error {
+	if keyID == "" {
+		return errors.New("key identifier must not be empty")
+	}
+	_, err := sa.RevokeExternalAccountKey(ctx, &sapb.ExternalAccountKeyID{KeyID: keyID})
+	if err != nil {
+		return err
+	}
+	logger.AuditInfof("Revoked external account binding key %q", keyID)
+	return nil
+}
+
+func main() {
+	usage := func() {
+		fmt.Fprint(os.Stderr, usageString)
+		os.Exit(1)
+	}
+	if len(os.Args) <= 2 {
+		usage()
+	}
+
+	command := os.Args[1]
+	flagSet := flag.NewFlagSet(command, flag.ContinueOnError)
+	configFile := flagSet.String("config", "", "File path to the configuration file for this service")
+	err := flagSet.Parse(os.Args[2:])
+	cmd.FailOnError(err, "Error parsing flagset")
+
+	if *configFile == "" {
+		usage()
+	}
+
+	var c config
+	err = cmd.ReadConfigFile(*configFile, &c)
+	cmd.FailOnError(err, "Reading JSON config file into config structure")
+	err = features.Set(c.EABKeys.Features)
+	cmd.FailOnError(err, "Failed to set feature flags")
+
+	ctx := context.Background()
+	args := flagSet.Args()
+	switch {
+	case command == "create" && len(args) == 1:
+		logger, sac := setupContext(c)
+		defer logger.AuditPanic()
+
+		hmacKey, err := createKey(ctx, sac, rand.Reader, args[0], logger)
+		cmd.FailOnError(err, "Couldn't create external account binding key")
+		fmt.Printf("Key ID: %s\nHMAC key: %s\n", args[0], hmacKey)
+
+	case command == "revoke" && len(args) == 1:
+		logger, sac := setupContext(c)
+		defer logger.AuditPanic()
+
+		err := revokeKey(ctx, sac, args[0], logger)
+		cmd.FailOnError(err, "Couldn't revoke external account binding key")
+
+	default:
+		usage()
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"testing"
+
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	blog "github.com/letsencrypt/boulder/log"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+type mockEABKeyStorage struct {
+	added   map[string][]byte
+	revoked map[string]bool
+}
+
+func (m *mockEABKeyStorage) AddExternalAccountKey(_ context.Context, req *sapb.AddExternalAccountKeyRequest) (*corepb.Empty, error) {
+	m.added[req.KeyID] = req.HmacKey
+	return &corepb.Empty{}, nil
+}
+
+func (m *mockEABKeyStorage) RevokeExternalAccountKey(_ context.Context, req *sapb.ExternalAccountKeyID) (*corepb.Empty, error) {
+	m.revoked[req.KeyID] = true
+	return &corepb.Empty{}, nil
+}
+
+func TestCreateKey(t *testing.T) {
+	sa := &mockEABKeyStorage{added: make(map[string][]byte)}
+	log := blog.NewMock()
+	randBytes := bytes.Repeat([]byte{0x42}, hmacKeyLength)
+
+	_, err := createKey(context.Background(), sa, bytes.NewReader(randBytes), "", log)
+	test.AssertError(t, err, "createKey accepted an empty key identifier")
+
+	_, err = createKey(context.Background(), sa, bytes.NewReader(randBytes[:4]), "short", log)
+	test.AssertError(t, err, "createKey accepted a short read from the random source")
+	test.AssertEquals(t, len(sa.added), 0)
+
+	encoded, err := createKey(context.Background(), sa, bytes.NewReader(randBytes), "kid-1", log)
+	test.AssertNotError(t, err, "createKey failed")
+	test.AssertEquals(t, encoded, base64.RawURLEncoding.EncodeToString(randBytes))
+	test.AssertByteEquals(t, sa.added["kid-1"], randBytes)
+	test.AssertEquals(t, len(log.GetAllMatching(`Created external account binding key "kid-1"`)), 1)
+}
+
+func TestRevokeKey(t *testing.T) {
+	sa := &mockEABKeyStorage{revoked: make(map[string]bool)}
+	log := blog.NewMock()
+
+	err := revokeKey(context.Background(), sa, "", log)
+	test.AssertError(t, err, "revokeKey accepted an empty key identifier")
+
+	err = revokeKey(context.Background(), sa, "kid-1", log)
+	test.AssertNotError(t, err, "revokeKey failed")
+	test.Assert(t, sa.revoked["kid-1"], "key wasn't revoked")
+	test.AssertEquals(t, len(log.GetAllMatching(`Revoked external account binding key "kid-1"`)), 1)
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	capb "github.com/letsencrypt/boulder/ca/proto"
+	"github.com/letsencrypt/boulder/core"
+	blog "github.com/letsencrypt/boulder/log"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// liveSigner signs OCSP responses on demand, for certificates whose stored
+// response is missing or stale, and writes them back through the SA. This
+// covers the time between a certificate's issuance and the first time the
+// ocsp-updater signs a response for it.
+//
+// Concurrent requests for the same serial share a single signing call, and
+// the number of signing calls in flight at once is bounded so that a burst of
+// requests can't overwhelm the CA. A shared signing call runs under its own
+// timeout rather than any one request's context, so a request which gives up
+// doesn't fail it for the others.
+type liveSigner struct {
+	ogc capb.OCSPGeneratorClient
+	sac sapb.StorageAuthorityClient
+
+	// staleAfter is the age past which a stored response is re-signed.
+	staleAfter time.Duration
+	// sem holds a token for each signing call in flight.
+	sem chan struct{}
+	// timeout bounds each signing call, including the wait for a token.
+	timeout time.Duration
+
+	mu       sync.Mutex
+	inflight map[string]*signCall
+
+	clk clock.Clock
+	log blog.Logger
+
+	signedCounter *prometheus.CounterVec
+}
+
+// signCall is a signing call which other requests for the same serial can
+// wait for. resp and err may only be read once done is closed.
+type signCall struct {
+	done chan struct{}
+	resp []byte
+	err  error
+}
+
+func newLiveSigner(
+	ogc capb.OCSPGeneratorClient,
+	sac sapb.StorageAuthorityClient,
+	staleAfter time.Duration,
+	maxInflight int,
+	timeout time.Duration,
+	clk clock.Clock,
+	stats prometheus.Registerer,
+	log blog.Logger,
+) (*liveSigner, error) {
+	if staleAfter <= 0 {
+		return nil, errors.New("live signing staleAfter must be positive")
+	}
+	if maxInflight <= 0 {
+		return nil, errors.New("live signing maxInflightSignings must be positive")
+	}
+	if timeout <= 0 {
+		return nil, errors.New("live signing timeout must be positive")
+	}
+	signedCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ocsp_live_signings",
+		Help: "A counter of OCSP responses signed live by the responder, labelled by result",
+	}, []string{"result"})
+	stats.MustRegister(signedCounter)
+	return &liveSigner{
+		ogc:           ogc,
+		sac:           sac,
+		staleAfter:    staleAfter,
+		sem:           make(chan struct{}, maxInflight),
+		timeout:       timeout,
+		inflight:      make(map[string]*signCall),
+		clk:           clk,
+		log:           log,
+		signedCounter: signedCounter,
+	}, nil
+}
+
+// needsSigning returns true if the certificate has no stored response yet, or
+// if its stored response is older than staleAfter.
+func (ls *liveSigner) needsSigning(status core.CertificateStatus) bool {
+	return status.OCSPLastUpdated.IsZero() || ls.clk.Since(status.OCSPLastUpdated) > ls.staleAfter
+}
+
+// sign returns a freshly signed OCSP response for the certificate. If a
+// response for the same serial is already being signed, it waits for that one
+// instead of signing another. It stops waiting when ctx is done, but the
+// signing call carries on for any other requests waiting for it.
+func (ls *liveSigner) sign(ctx context.Context, status core.CertificateStatus) ([]byte, error) {
+	ls.mu.Lock()
+	call, ok := ls.inflight[status.Serial]
+	if !ok {
+		call = &signCall{done: make(chan struct{})}
+		ls.inflight[status.Serial] = call
+		go ls.signShared(call, status)
+	}
+	ls.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.resp, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// signShared makes a signing call on behalf of every request waiting for it,
+// under a context of its own.
+func (ls *liveSigner) signShared(call *signCall, status core.CertificateStatus) {
+	ctx, cancel := context.WithTimeout(context.Background(), ls.timeout)
+	defer cancel()
+	call.resp, call.err = ls.signAndStore(ctx, status)
+
+	ls.mu.Lock()
+	delete(ls.inflight, status.Serial)
+	ls.mu.Unlock()
+	close(call.done)
+}
+
+// signAndStore has the CA sign a response for the certificate's current
+// status, once there is capacity for another signing call, and stores it
+// through the SA. A failure to store the response is logged but doesn't
+// prevent it from being served.
+func (ls *liveSigner) signAndStore(ctx context.Context, status core.CertificateStatus) ([]byte, error) {
+	if status.IssuerID == nil || *status.IssuerID == 0 {
+		ls.signedCounter.WithLabelValues("failed").Inc()
+		return nil, errors.New("cert status has nil or 0 IssuerID")
+	}
+
+	select {
+	case ls.sem <- struct{}{}:
+	case <-ctx.Done():
+		ls.signedCounter.WithLabelValues("overloaded").Inc()
+		return nil, ctx.Err()
+	}
+	defer func() { <-ls.sem }()
+
+	thisUpdate := ls.clk.Now()
+	ocspResp, err := ls.ogc.GenerateOCSP(ctx, &capb.GenerateOCSPRequest{
+		Serial:    status.Serial,
+		IssuerID:  *status.IssuerID,
+		Status:    string(status.Status),
+		Reason:    int32(status.RevokedReason),
+		RevokedAt: status.RevokedDate.UnixNano(),
+	})
+	if err != nil {
+		ls.signedCounter.WithLabelValues("failed").Inc()
+		return nil, err
+	}
+	ls.signedCounter.WithLabelValues("success").Inc()
+
+	_, err = ls.sac.UpdateOCSPResponse(ctx, &sapb.UpdateOCSPResponseRequest{
+		Serial:     status.Serial,
+		Status:     string(status.Status),
+		Response:   ocspResp.Response,
+		ThisUpdate: thisUpdate.UnixNano(),
+	})
+	if err != nil {
+		ls.log.Warningf("Failed to store live signed OCSP response for Serial=%s: %s", status.Serial, err)
+	}
+	return ocspResp.Response, nil
+}
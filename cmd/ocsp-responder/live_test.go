@@ -0,0 +1,230 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-gorp/gorp/v3"
+	"github.com/jmhodges/clock"
+	"golang.org/x/crypto/ocsp"
+	"google.golang.org/grpc"
+
+	capb "github.com/letsencrypt/boulder/ca/proto"
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	bocsp "github.com/letsencrypt/boulder/ocsp"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// fakeOGC signs a response containing the requested serial. If block is
+// non-nil, each call waits for it to be closed first, and then records the
+// state of its context in ctxErr.
+type fakeOGC struct {
+	mu     sync.Mutex
+	calls  int
+	block  chan struct{}
+	err    error
+	ctxErr error
+}
+
+func (ogc *fakeOGC) GenerateOCSP(ctx context.Context, req *capb.GenerateOCSPRequest, _ ...grpc.CallOption) (*capb.OCSPResponse, error) {
+	ogc.mu.Lock()
+	ogc.calls++
+	ogc.mu.Unlock()
+	if ogc.block != nil {
+		<-ogc.block
+		ogc.mu.Lock()
+		ogc.ctxErr = ctx.Err()
+		ogc.mu.Unlock()
+	}
+	if ogc.err != nil {
+		return nil, ogc.err
+	}
+	return &capb.OCSPResponse{Response: []byte("signed " + req.Serial)}, nil
+}
+
+type fakeSAC struct {
+	sapb.StorageAuthorityClient
+	mu      sync.Mutex
+	updates []*sapb.UpdateOCSPResponseRequest
+}
+
+func (sac *fakeSAC) UpdateOCSPResponse(_ context.Context, req *sapb.UpdateOCSPResponseRequest, _ ...grpc.CallOption) (*corepb.Empty, error) {
+	sac.mu.Lock()
+	defer sac.mu.Unlock()
+	sac.updates = append(sac.updates, req)
+	return &corepb.Empty{}, nil
+}
+
+// statusSelector always returns the given certificateStatus.
+type statusSelector struct {
+	mockSqlExecutor
+	status core.CertificateStatus
+}
+
+func (ss statusSelector) WithContext(context.Context) gorp.SqlExecutor {
+	return ss
+}
+
+func (ss statusSelector) SelectOne(output interface{}, _ string, _ ...interface{}) error {
+	*output.(*core.CertificateStatus) = ss.status
+	return nil
+}
+
+func setupLiveSigner(t *testing.T, maxInflight int) (*liveSigner, *fakeOGC, *fakeSAC, clock.FakeClock) {
+	t.Helper()
+	ogc := &fakeOGC{}
+	sac := &fakeSAC{}
+	fc := clock.NewFake()
+	fc.Set(time.Date(2021, 4, 10, 0, 0, 0, 0, time.UTC))
+	ls, err := newLiveSigner(ogc, sac, 24*time.Hour, maxInflight, 50*time.Millisecond, fc, metrics.NoopRegisterer, blog.NewMock())
+	test.AssertNotError(t, err, "newLiveSigner failed")
+	return ls, ogc, sac, fc
+}
+
+func TestNewLiveSignerValidation(t *testing.T) {
+	_, err := newLiveSigner(&fakeOGC{}, &fakeSAC{}, 0, 1, time.Second, clock.NewFake(), metrics.NoopRegisterer, blog.NewMock())
+	test.AssertError(t, err, "newLiveSigner should fail without staleAfter")
+	_, err = newLiveSigner(&fakeOGC{}, &fakeSAC{}, time.Hour, 0, time.Second, clock.NewFake(), metrics.NoopRegisterer, blog.NewMock())
+	test.AssertError(t, err, "newLiveSigner should fail without maxInflight")
+	_, err = newLiveSigner(&fakeOGC{}, &fakeSAC{}, time.Hour, 1, 0, clock.NewFake(), metrics.NoopRegisterer, blog.NewMock())
+	test.AssertError(t, err, "newLiveSigner should fail without timeout")
+}
+
+func TestNeedsSigning(t *testing.T) {
+	ls, _, _, fc := setupLiveSigner(t, 1)
+	test.Assert(t, ls.needsSigning(core.CertificateStatus{}), "Missing response should need signing")
+	test.Assert(t, !ls.needsSigning(core.CertificateStatus{OCSPLastUpdated: fc.Now().Add(-time.Hour)}),
+		"Fresh response shouldn't need signing")
+	test.Assert(t, ls.needsSigning(core.CertificateStatus{OCSPLastUpdated: fc.Now().Add(-25 * time.Hour)}),
+		"Stale response should need signing")
+}
+
+func TestLiveSigningResponse(t *testing.T) {
+	f, err := newFilter([]string{"./testdata/test-ca.der.pem"}, nil)
+	test.AssertNotError(t, err, "newFilter failed")
+	ocspReq, err := ocsp.ParseRequest(req)
+	test.AssertNotError(t, err, "Failed to parse OCSP request")
+
+	ls, ogc, sac, fc := setupLiveSigner(t, 1)
+	id := issuerID
+	status := core.CertificateStatus{
+		Serial:   core.SerialToString(ocspReq.SerialNumber),
+		Status:   core.OCSPStatusGood,
+		IssuerID: &id,
+	}
+	src := &dbSource{statusSelector{status: status}, f, time.Second, blog.NewMock(), ls}
+
+	resp, _, err := src.Response(ocspReq)
+	test.AssertNotError(t, err, "Response failed")
+	test.AssertEquals(t, string(resp), "signed "+status.Serial)
+	test.AssertEquals(t, ogc.calls, 1)
+	test.AssertEquals(t, len(sac.updates), 1)
+	test.AssertEquals(t, sac.updates[0].Serial, status.Serial)
+	test.AssertEquals(t, sac.updates[0].Status, string(core.OCSPStatusGood))
+	test.AssertEquals(t, sac.updates[0].ThisUpdate, fc.Now().UnixNano())
+
+	// A fresh stored response is served as is.
+	status.OCSPLastUpdated = fc.Now()
+	status.OCSPResponse = []byte("stored")
+	src.dbMap = statusSelector{status: status}
+	resp, _, err = src.Response(ocspReq)
+	test.AssertNotError(t, err, "Response failed")
+	test.AssertEquals(t, string(resp), "stored")
+	test.AssertEquals(t, ogc.calls, 1)
+
+	// If signing fails a stale stored response is served, but a missing one
+	// is still not found.
+	ogc.err = errors.New("signing failed")
+	fc.Add(48 * time.Hour)
+	resp, _, err = src.Response(ocspReq)
+	test.AssertNotError(t, err, "Response failed")
+	test.AssertEquals(t, string(resp), "stored")
+	status.OCSPLastUpdated = time.Time{}
+	src.dbMap = statusSelector{status: status}
+	_, _, err = src.Response(ocspReq)
+	test.AssertErrorIs(t, err, bocsp.ErrNotFound)
+}
+
+func TestLiveSigningDeduplication(t *testing.T) {
+	ls, ogc, sac, _ := setupLiveSigner(t, 1)
+	ogc.block = make(chan struct{})
+	id := issuerID
+	status := core.CertificateStatus{Serial: "00", Status: core.OCSPStatusGood, IssuerID: &id}
+
+	first := make(chan error)
+	go func() {
+		_, err := ls.sign(context.Background(), status)
+		first <- err
+	}()
+	// Wait for the first call to reach the CA before making the second.
+	for {
+		ogc.mu.Lock()
+		calls := ogc.calls
+		ogc.mu.Unlock()
+		if calls == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	second := make(chan []byte)
+	go func() {
+		resp, _ := ls.sign(context.Background(), status)
+		second <- resp
+	}()
+	// A different serial has to wait for the only signing slot.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	other := status
+	other.Serial = "01"
+	_, err := ls.sign(ctx, other)
+	test.AssertErrorIs(t, err, context.DeadlineExceeded)
+	// Its signing call gives up waiting for the slot by itself.
+	waitForInflight(ls, 1)
+
+	close(ogc.block)
+	test.AssertNotError(t, <-first, "sign failed")
+	test.AssertEquals(t, string(<-second), "signed 00")
+	test.AssertEquals(t, ogc.calls, 1)
+	test.AssertEquals(t, len(sac.updates), 1)
+}
+
+// waitForInflight waits until the given number of signing calls are in
+// flight.
+func waitForInflight(ls *liveSigner, n int) {
+	for {
+		ls.mu.Lock()
+		inflight := len(ls.inflight)
+		ls.mu.Unlock()
+		if inflight == n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestLiveSigningOutlivesRequest(t *testing.T) {
+	ls, ogc, sac, _ := setupLiveSigner(t, 1)
+	ogc.block = make(chan struct{})
+	id := issuerID
+	status := core.CertificateStatus{Serial: "00", Status: core.OCSPStatusGood, IssuerID: &id}
+
+	// The request which started the signing call gives up on it, but the call
+	// goes on under its own context, for any other requests for the serial.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := ls.sign(ctx, status)
+	test.AssertErrorIs(t, err, context.Canceled)
+
+	close(ogc.block)
+	waitForInflight(ls, 0)
+	test.AssertNotError(t, ogc.ctxErr, "signing call's context was done")
+	test.AssertEquals(t, len(sac.updates), 1)
+}
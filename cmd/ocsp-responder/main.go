@@ -22,15 +22,18 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/crypto/ocsp"
 
+	capb "github.com/letsencrypt/boulder/ca/proto"
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/db"
 	"github.com/letsencrypt/boulder/features"
+	bgrpc "github.com/letsencrypt/boulder/grpc"
 	"github.com/letsencrypt/boulder/issuance"
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/metrics/measured_http"
 	bocsp "github.com/letsencrypt/boulder/ocsp"
 	"github.com/letsencrypt/boulder/sa"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
 )
 
 // ocspFilter stores information needed to filter OCSP requests (to ensure we
@@ -134,11 +137,15 @@ func (f *ocspFilter) responseMatchesIssuer(req *ocsp.Request, status core.Certif
 // so you can be more efficient than TEXT if you like. We use it to store the
 // serial number in hex. You must have an index on the serialNumber field,
 // since we will always query on it.
+//
+// If signer is non-nil, responses which are missing or stale in the database
+// are signed live instead of being refused.
 type dbSource struct {
 	dbMap   dbSelector
 	filter  *ocspFilter
 	timeout time.Duration
 	log     blog.Logger
+	signer  *liveSigner
 }
 
 // Define an interface with the needed methods from gorp.
@@ -179,6 +186,16 @@ func (src *dbSource) Response(req *ocsp.Request) ([]byte, http.Header, error) {
 		src.log.AuditErrf("Looking up OCSP response: %s", err)
 		return nil, nil, err
 	}
+	if src.signer != nil && !certStatus.IsExpired && src.filter.responseMatchesIssuer(req, certStatus) &&
+		src.signer.needsSigning(certStatus) {
+		resp, err := src.signer.sign(ctx, certStatus)
+		if err == nil {
+			return resp, nil, nil
+		}
+		// Fall back to the stored response, if there is one, since a stale
+		// response may well still be valid.
+		src.log.Warningf("Live signing OCSP response failed for CA=%s, Serial=%s: %s", hex.EncodeToString(req.IssuerKeyHash), serialString, err)
+	}
 	if certStatus.OCSPLastUpdated.IsZero() {
 		src.log.Warningf("OCSP Response not sent (ocspLastUpdated is zero) for CA=%s, Serial=%s", hex.EncodeToString(req.IssuerKeyHash), serialString)
 		return nil, nil, bocsp.ErrNotFound
@@ -226,6 +243,17 @@ type config struct {
 
 		RequiredSerialPrefixes []string
 
+		// LiveSigning, if present, makes the responder have the CA sign a
+		// response whenever the one in the database is missing or stale,
+		// rather than refusing to respond. It is only used with a database
+		// source.
+		LiveSigning *LiveSigningConfig
+
+		// Cache, if present, keeps recently served responses in memory so
+		// that frequently requested serials don't have to be looked up in the
+		// database every time. It is only used with a database source.
+		Cache *CacheConfig
+
 		Features map[string]bool
 	}
 
@@ -237,6 +265,45 @@ type config struct {
 	}
 }
 
+// LiveSigningConfig configures the signing of OCSP responses on demand.
+type LiveSigningConfig struct {
+	// StaleAfter is the age past which a stored response is re-signed. It
+	// should be comfortably longer than the age at which the ocsp-updater
+	// re-signs responses, so that stored responses are only re-signed live
+	// when the ocsp-updater has fallen behind.
+	StaleAfter cmd.ConfigDuration
+
+	// MaxInflightSignings is the number of signing requests which may be
+	// outstanding to the CA at once. Further requests wait for one of them to
+	// finish, until they time out.
+	MaxInflightSignings int
+
+	// Timeout bounds each signing call, including any wait for one of the
+	// MaxInflightSignings. It applies independently of the timeout of the
+	// requests waiting for the call.
+	Timeout cmd.ConfigDuration
+
+	OCSPGeneratorService *cmd.GRPCClientConfig
+	SAService            *cmd.GRPCClientConfig
+}
+
+// CacheConfig configures the in-memory cache of OCSP responses.
+type CacheConfig struct {
+	// MaxEntries is the number of responses the cache holds before evicting
+	// the least recently used one.
+	MaxEntries int
+
+	// LifetimeFraction is the fraction of each response's validity interval,
+	// starting from its thisUpdate, for which it is cached.
+	LifetimeFraction float64
+
+	// RevocationPollPeriod is how often the database is checked for newly
+	// revoked certificates, whose cached responses are then invalidated. A
+	// cached "good" response may be served for up to this long after its
+	// certificate is revoked.
+	RevocationPollPeriod cmd.ConfigDuration
+}
+
 func main() {
 	configFile := flag.String("config", "", "File path to the configuration file for this service")
 	flag.Parse()
@@ -304,7 +371,43 @@ as generated by Boulder's ceremony command.
 		filter, err := newFilter(issuerCerts, c.OCSPResponder.RequiredSerialPrefixes)
 		cmd.FailOnError(err, "Couldn't create OCSP filter")
 
-		source = &dbSource{dbMap, filter, c.OCSPResponder.Timeout.Duration, logger}
+		var signer *liveSigner
+		if live := c.OCSPResponder.LiveSigning; live != nil {
+			clk := cmd.Clock()
+			tlsConfig, err := c.OCSPResponder.TLS.Load()
+			cmd.FailOnError(err, "TLS config")
+			clientMetrics := bgrpc.NewClientMetrics(stats)
+
+			caConn, err := bgrpc.ClientSetup(live.OCSPGeneratorService, tlsConfig, clientMetrics, clk)
+			cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to CA")
+			saConn, err := bgrpc.ClientSetup(live.SAService, tlsConfig, clientMetrics, clk)
+			cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to SA")
+
+			signer, err = newLiveSigner(
+				capb.NewOCSPGeneratorClient(caConn),
+				sapb.NewStorageAuthorityClient(saConn),
+				live.StaleAfter.Duration,
+				live.MaxInflightSignings,
+				live.Timeout.Duration,
+				clk,
+				stats,
+				logger,
+			)
+			cmd.FailOnError(err, "Failed to configure live signing")
+		}
+
+		source = &dbSource{dbMap, filter, c.OCSPResponder.Timeout.Duration, logger, signer}
+
+		if cacheConfig := c.OCSPResponder.Cache; cacheConfig != nil {
+			if cacheConfig.RevocationPollPeriod.Duration <= 0 {
+				cmd.Fail("OCSP cache requires a positive revocationPollPeriod")
+			}
+			clk := cmd.Clock()
+			cache, err := bocsp.NewCachingSource(source, cacheConfig.MaxEntries, cacheConfig.LifetimeFraction, clk, stats)
+			cmd.FailOnError(err, "Failed to configure OCSP cache")
+			source = cache
+			go newRevocationWatcher(dbMap, cache, cacheConfig.RevocationPollPeriod.Duration, clk, logger).run()
+		}
 
 		// Export the value for dbSettings.MaxOpenConns
 		dbConnStat := prometheus.NewGauge(prometheus.GaugeOpts{
@@ -161,7 +161,7 @@ func TestDBHandler(t *testing.T) {
 	if err != nil {
 		t.Fatalf("newFilter: %s", err)
 	}
-	src := &dbSource{mockSelector{}, f, time.Second, blog.NewMock()}
+	src := &dbSource{mockSelector{}, f, time.Second, blog.NewMock(), nil}
 
 	h := bocsp.NewResponder(src, stats, blog.NewMock())
 	w := httptest.NewRecorder()
@@ -284,7 +284,7 @@ func TestErrorLog(t *testing.T) {
 	if err != nil {
 		t.Fatalf("newFilter: %s", err)
 	}
-	src := &dbSource{brokenSelector{}, f, time.Second, mockLog}
+	src := &dbSource{brokenSelector{}, f, time.Second, mockLog, nil}
 
 	ocspReq, err := ocsp.ParseRequest(req)
 	test.AssertNotError(t, err, "Failed to parse OCSP request")
@@ -300,7 +300,7 @@ func TestRequiredSerialPrefix(t *testing.T) {
 	if err != nil {
 		t.Fatalf("newFilter: %s", err)
 	}
-	src := &dbSource{mockSelector{}, f, time.Second, blog.NewMock()}
+	src := &dbSource{mockSelector{}, f, time.Second, blog.NewMock(), nil}
 
 	ocspReq, err := ocsp.ParseRequest(req)
 	test.AssertNotError(t, err, "Failed to parse OCSP request")
@@ -314,7 +314,7 @@ func TestRequiredSerialPrefix(t *testing.T) {
 	if err != nil {
 		t.Fatalf("newFilter: %s", err)
 	}
-	src = &dbSource{mockSelector{}, f, time.Second, blog.NewMock()}
+	src = &dbSource{mockSelector{}, f, time.Second, blog.NewMock(), nil}
 	_, _, err = src.Response(ocspReq)
 	test.AssertNotError(t, err, "src.Response failed with acceptable prefix")
 }
@@ -341,7 +341,7 @@ func TestExpiredUnauthorized(t *testing.T) {
 	if err != nil {
 		t.Fatalf("newFilter: %s", err)
 	}
-	src := &dbSource{expiredSelector{}, f, time.Second, blog.NewMock()}
+	src := &dbSource{expiredSelector{}, f, time.Second, blog.NewMock(), nil}
 
 	ocspReq, err := ocsp.ParseRequest(req)
 	test.AssertNotError(t, err, "Failed to parse OCSP request")
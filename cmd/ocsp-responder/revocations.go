@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/jmhodges/clock"
+
+	"github.com/letsencrypt/boulder/core"
+	blog "github.com/letsencrypt/boulder/log"
+)
+
+// invalidator is the part of bocsp.CachingSource used by revocationWatcher.
+type invalidator interface {
+	Invalidate(serial *big.Int)
+}
+
+// revocationWatcher periodically looks in the database for certificates which
+// have been revoked since its last check, and invalidates their cached
+// responses so that their revoked status is served.
+type revocationWatcher struct {
+	dbMap  dbSelector
+	cache  invalidator
+	period time.Duration
+	clk    clock.Clock
+	log    blog.Logger
+
+	lastChecked time.Time
+}
+
+func newRevocationWatcher(dbMap dbSelector, cache invalidator, period time.Duration, clk clock.Clock, log blog.Logger) *revocationWatcher {
+	return &revocationWatcher{
+		dbMap:       dbMap,
+		cache:       cache,
+		period:      period,
+		clk:         clk,
+		log:         log,
+		lastChecked: clk.Now(),
+	}
+}
+
+// check invalidates the cached responses of every certificate whose revoked
+// response was stored since the previous check. The window overlaps the
+// previous one by a whole period, to allow for clock skew between the
+// responder and the SA which writes ocspLastUpdated.
+func (rw *revocationWatcher) check(ctx context.Context) error {
+	since := rw.lastChecked.Add(-rw.period)
+	now := rw.clk.Now()
+	var serials []string
+	_, err := rw.dbMap.WithContext(ctx).Select(
+		&serials,
+		`SELECT serial FROM certificateStatus
+		WHERE isExpired = false
+		AND ocspLastUpdated >= ?
+		AND status = ?`,
+		since,
+		string(core.OCSPStatusRevoked),
+	)
+	if err != nil {
+		return err
+	}
+	for _, s := range serials {
+		serial, err := core.StringToSerial(s)
+		if err != nil {
+			rw.log.Warningf("Ignoring revoked certificate with invalid serial %q: %s", s, err)
+			continue
+		}
+		rw.cache.Invalidate(serial)
+	}
+	rw.lastChecked = now
+	return nil
+}
+
+// run calls check every period, forever.
+func (rw *revocationWatcher) run() {
+	for {
+		rw.clk.Sleep(rw.period)
+		ctx, cancel := context.WithTimeout(context.Background(), rw.period)
+		err := rw.check(ctx)
+		cancel()
+		if err != nil {
+			rw.log.Errf("Checking for revoked certificates: %s", err)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/go-gorp/gorp/v3"
+	"github.com/jmhodges/clock"
+
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// revokedSelector returns the given serials from Select, and records the
+// arguments it was called with.
+type revokedSelector struct {
+	mockSqlExecutor
+	serials []string
+	args    *[]interface{}
+}
+
+func (rs revokedSelector) WithContext(context.Context) gorp.SqlExecutor {
+	return rs
+}
+
+func (rs revokedSelector) Select(holder interface{}, _ string, args ...interface{}) ([]interface{}, error) {
+	*holder.(*[]string) = rs.serials
+	*rs.args = args
+	return nil, nil
+}
+
+type fakeInvalidator struct {
+	serials []*big.Int
+}
+
+func (fi *fakeInvalidator) Invalidate(serial *big.Int) {
+	fi.serials = append(fi.serials, serial)
+}
+
+func TestRevocationWatcherCheck(t *testing.T) {
+	fc := clock.NewFake()
+	fc.Set(time.Date(2021, 4, 10, 0, 0, 0, 0, time.UTC))
+	start := fc.Now()
+	var args []interface{}
+	selector := revokedSelector{
+		serials: []string{"00000000000000000000000000000000000a", "not a serial"},
+		args:    &args,
+	}
+	cache := &fakeInvalidator{}
+	rw := newRevocationWatcher(selector, cache, time.Minute, fc, blog.NewMock())
+
+	fc.Add(time.Minute)
+	err := rw.check(context.Background())
+	test.AssertNotError(t, err, "check failed")
+	test.AssertEquals(t, len(cache.serials), 1)
+	test.AssertEquals(t, cache.serials[0].Cmp(big.NewInt(10)), 0)
+	test.AssertEquals(t, args[0].(time.Time), start.Add(-time.Minute))
+
+	// The next check starts from the time of this one, less the overlap.
+	fc.Add(time.Minute)
+	err = rw.check(context.Background())
+	test.AssertNotError(t, err, "check failed")
+	test.AssertEquals(t, args[0].(time.Time), start)
+}
@@ -6,17 +6,21 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"net/http"
 	"os"
 	"time"
 
 	"github.com/jmhodges/clock"
+	akamaipb "github.com/letsencrypt/boulder/akamai/proto"
 	capb "github.com/letsencrypt/boulder/ca/proto"
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/db"
 	"github.com/letsencrypt/boulder/features"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
+	"github.com/letsencrypt/boulder/issuance"
 	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/ocsp/export"
 	"github.com/letsencrypt/boulder/sa"
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -28,6 +32,7 @@ import (
  */
 type ocspDB interface {
 	Select(i interface{}, query string, args ...interface{}) ([]interface{}, error)
+	SelectOne(holder interface{}, query string, args ...interface{}) error
 	Exec(query string, args ...interface{}) (sql.Result, error)
 }
 
@@ -40,6 +45,23 @@ type OCSPUpdater struct {
 
 	ogc capb.OCSPGeneratorClient
 
+	// purger and issuers are used to purge the responses of certificates
+	// from the revocation event queue. purger may be nil.
+	purger  akamaipb.AkamaiPurgerClient
+	issuers map[issuance.IssuerNameID]*issuance.Certificate
+	// Maximum number of revocation events to process per tick
+	revocationEventBatchSize int
+	// Number of times to try processing a revocation event before giving up
+	revocationEventMaxAttempts int
+
+	// exporter, if set, also writes each stored response to a blob store.
+	exporter *export.Exporter
+	// revokedExportedSince and revokedExportedSerial are the
+	// (ocspLastUpdated, serial) of the last revoked response exported;
+	// responses after it have yet to be exported.
+	revokedExportedSince  time.Time
+	revokedExportedSerial string
+
 	tickWindow    time.Duration
 	batchSize     int
 	tickHistogram *prometheus.HistogramVec
@@ -58,6 +80,9 @@ type OCSPUpdater struct {
 	genStoreHistogram  prometheus.Histogram
 	generatedCounter   *prometheus.CounterVec
 	storedCounter      *prometheus.CounterVec
+
+	revocationEventsCounter *prometheus.CounterVec
+	exportedCounter         *prometheus.CounterVec
 }
 
 func newUpdater(
@@ -65,6 +90,9 @@ func newUpdater(
 	clk clock.Clock,
 	dbMap ocspDB,
 	ogc capb.OCSPGeneratorClient,
+	purger akamaipb.AkamaiPurgerClient,
+	issuers []*issuance.Certificate,
+	exporter *export.Exporter,
 	config OCSPUpdaterConfig,
 	log blog.Logger,
 ) (*OCSPUpdater, error) {
@@ -78,6 +106,17 @@ func newUpdater(
 		// Default to 1
 		config.ParallelGenerateOCSPRequests = 1
 	}
+	if config.RevocationEventBatchSize == 0 {
+		config.RevocationEventBatchSize = config.OldOCSPBatchSize
+	}
+	if config.RevocationEventMaxAttempts == 0 {
+		config.RevocationEventMaxAttempts = 10
+	}
+
+	issuersByNameID := make(map[issuance.IssuerNameID]*issuance.Certificate)
+	for _, issuer := range issuers {
+		issuersByNameID[issuer.NameID()] = issuer
+	}
 
 	genStoreHistogram := prometheus.NewHistogram(prometheus.HistogramOpts{
 		Name: "ocsp_updater_generate_and_store",
@@ -105,11 +144,27 @@ func newUpdater(
 		Buckets: []float64{10, 100, 1000, 10000, 43200},
 	})
 	stats.MustRegister(stalenessHistogram)
+	revocationEventsCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ocsp_updater_revocation_events",
+		Help: "A counter of revocation events processed, labelled by result",
+	}, []string{"result"})
+	stats.MustRegister(revocationEventsCounter)
+	exportedCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ocsp_updater_exported",
+		Help: "A counter of OCSP responses exported to a blob store, labelled by result",
+	}, []string{"result"})
+	stats.MustRegister(exportedCounter)
 
 	updater := OCSPUpdater{
 		clk:                          clk,
 		dbMap:                        dbMap,
 		ogc:                          ogc,
+		purger:                       purger,
+		issuers:                      issuersByNameID,
+		revocationEventBatchSize:     config.RevocationEventBatchSize,
+		revocationEventMaxAttempts:   config.RevocationEventMaxAttempts,
+		exporter:                     exporter,
+		revokedExportedSince:         clk.Now().Add(-config.OCSPMinTimeToExpiry.Duration),
 		log:                          log,
 		ocspMinTimeToExpiry:          config.OCSPMinTimeToExpiry.Duration,
 		parallelGenerateOCSPRequests: config.ParallelGenerateOCSPRequests,
@@ -122,6 +177,8 @@ func newUpdater(
 		batchSize:                    config.OldOCSPBatchSize,
 		maxBackoff:                   config.SignFailureBackoffMax.Duration,
 		backoffFactor:                config.SignFailureBackoffFactor,
+		revocationEventsCounter:      revocationEventsCounter,
+		exportedCounter:              exportedCounter,
 	}
 
 	return &updater, nil
@@ -191,6 +248,70 @@ func (updater *OCSPUpdater) storeResponse(status *core.CertificateStatus) error
 	return err
 }
 
+// exportResponse writes the response to the blob store, if exporting is
+// configured.
+func (updater *OCSPUpdater) exportResponse(ctx context.Context, status *core.CertificateStatus) error {
+	if updater.exporter == nil {
+		return nil
+	}
+	err := updater.exporter.Export(ctx, *status.IssuerID, status.Serial, status.OCSPResponse)
+	if err != nil {
+		updater.exportedCounter.WithLabelValues("failed").Inc()
+		return err
+	}
+	updater.exportedCounter.WithLabelValues("success").Inc()
+	return nil
+}
+
+// exportRevokedResponses exports up to batchSize of the revoked responses
+// stored since the last call, which are usually signed by the RA when it
+// revokes a certificate, so that the blob store stops serving a good response
+// for a revoked certificate without waiting for it to become stale. Responses
+// last updated before revokedExportedSince was first set are exported by the
+// stale scan when they're regenerated.
+func (updater *OCSPUpdater) exportRevokedResponses(ctx context.Context, batchSize int) error {
+	queryStart := updater.clk.Now()
+	statuses, err := sa.SelectCertificateStatuses(
+		updater.dbMap,
+		`WHERE (ocspLastUpdated > :since OR (ocspLastUpdated = :since AND serial > :serial))
+		 AND NOT isExpired
+		 AND status = :status
+		 ORDER BY ocspLastUpdated ASC, serial ASC
+		 LIMIT :limit`,
+		map[string]interface{}{
+			"since":  updater.revokedExportedSince,
+			"serial": updater.revokedExportedSerial,
+			"status": string(core.OCSPStatusRevoked),
+			"limit":  batchSize,
+		},
+	)
+	if err != nil && !db.IsNoRows(err) {
+		return err
+	}
+	for _, status := range statuses {
+		if status.IssuerID != nil && len(status.OCSPResponse) != 0 {
+			err := updater.exportResponse(ctx, &status)
+			if err != nil {
+				return err
+			}
+		}
+		updater.revokedExportedSince = status.OCSPLastUpdated
+		updater.revokedExportedSerial = status.Serial
+	}
+	// A short batch means everything updated before the query started has
+	// been exported, so the next call can start from there rather than
+	// rescanning. ocspLastUpdated is stored to the second, so start from the
+	// beginning of that second to pick up rows written later within it.
+	if len(statuses) < batchSize {
+		caughtUp := queryStart.Truncate(time.Second)
+		if caughtUp.After(updater.revokedExportedSince) {
+			updater.revokedExportedSince = caughtUp
+			updater.revokedExportedSerial = ""
+		}
+	}
+	return nil
+}
+
 // markExpired updates a given CertificateStatus to have `isExpired` set.
 func (updater *OCSPUpdater) markExpired(status core.CertificateStatus) error {
 	_, err := updater.dbMap.Exec(
@@ -233,6 +354,10 @@ func (updater *OCSPUpdater) generateOCSPResponses(ctx context.Context, statuses
 			return
 		}
 		updater.storedCounter.WithLabelValues("success").Inc()
+		err = updater.exportResponse(ctx, meta)
+		if err != nil {
+			updater.log.AuditErrf("Failed to export OCSP response: %s", err)
+		}
 	}
 
 	for _, status := range statuses {
@@ -248,8 +373,24 @@ func (updater *OCSPUpdater) generateOCSPResponses(ctx context.Context, statuses
 }
 
 // updateOCSPResponses looks for certificates with stale OCSP responses and
-// generates/stores new ones
+// generates/stores new ones. If the RevocationEventQueue feature is enabled,
+// it first handles any queued revocation events. If exporting is configured,
+// it then exports newly revoked responses. A failure of either is logged but
+// doesn't prevent stale responses from being updated.
 func (updater *OCSPUpdater) updateOCSPResponses(ctx context.Context, batchSize int) error {
+	if features.Enabled(features.RevocationEventQueue) {
+		err := updater.processRevocationEvents(ctx)
+		if err != nil {
+			updater.log.AuditErrf("Failed to process revocation events: %s", err)
+		}
+	}
+	if updater.exporter != nil {
+		err := updater.exportRevokedResponses(ctx, batchSize)
+		if err != nil {
+			updater.log.AuditErrf("Failed to export revoked OCSP responses: %s", err)
+		}
+	}
+
 	tickStart := updater.clk.Now()
 	statuses, err := updater.findStaleOCSPResponses(tickStart.Add(-updater.ocspMinTimeToExpiry), batchSize)
 	if err != nil {
@@ -296,9 +437,69 @@ type OCSPUpdaterConfig struct {
 
 	OCSPGeneratorService *cmd.GRPCClientConfig
 
+	// RevocationEventBatchSize is the maximum number of revocation events
+	// processed per tick, when the RevocationEventQueue feature is enabled.
+	// Defaults to OldOCSPBatchSize.
+	RevocationEventBatchSize int
+	// RevocationEventMaxAttempts is the number of times a revocation event is
+	// tried, with backoff, before it's abandoned. Defaults to 10.
+	RevocationEventMaxAttempts int
+	// AkamaiPurgerService and IssuerCerts are used to purge the responses of
+	// certificates from the revocation event queue. If AkamaiPurgerService is
+	// unset, nothing is purged.
+	AkamaiPurgerService *cmd.GRPCClientConfig
+	IssuerCerts         []string
+
+	// Export, if set, makes the updater also write each response it stores to
+	// a blob store, at the path RFC 5019 GET requests for it use, so that
+	// responses can be served from a static origin. Responses stored by the
+	// RA when it revokes a certificate are exported too. IssuerCerts must
+	// contain the certificates of all issuers whose responses are exported.
+	Export *ExportConfig
+
 	Features map[string]bool
 }
 
+// ExportConfig configures the blob store which responses are exported to.
+// Exactly one of Directory and S3 must be set.
+type ExportConfig struct {
+	// Directory is a local directory to write responses under.
+	Directory string
+	S3        *S3ExportConfig
+}
+
+// S3ExportConfig configures a bucket of an S3-compatible object store to
+// upload responses to.
+type S3ExportConfig struct {
+	// Endpoint is the base URL of the object store, e.g.
+	// "https://s3.us-east-1.amazonaws.com". Buckets are addressed path-style.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey cmd.PasswordConfig
+	Timeout         cmd.ConfigDuration
+}
+
+// newStore returns the blob store described by the config.
+func (c ExportConfig) newStore(clk clock.Clock) (export.Store, error) {
+	switch {
+	case c.Directory != "" && c.S3 != nil:
+		return nil, errors.New("only one of export directory and s3 may be set")
+	case c.Directory != "":
+		return export.NewFilesystemStore(c.Directory)
+	case c.S3 != nil:
+		secret, err := c.S3.SecretAccessKey.Pass()
+		if err != nil {
+			return nil, fmt.Errorf("loading S3 secret access key: %w", err)
+		}
+		client := &http.Client{Timeout: c.S3.Timeout.Duration}
+		return export.NewS3Store(c.S3.Endpoint, c.S3.Region, c.S3.Bucket, c.S3.AccessKeyID, secret, client, clk)
+	default:
+		return nil, errors.New("one of export directory and s3 must be set")
+	}
+}
+
 func (updater *OCSPUpdater) tick() {
 	start := updater.clk.Now()
 	err := updater.updateOCSPResponses(context.Background(), updater.batchSize)
@@ -372,11 +573,38 @@ func main() {
 	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to CA")
 	ogc := capb.NewOCSPGeneratorClient(caConn)
 
+	var purger akamaipb.AkamaiPurgerClient
+	if conf.AkamaiPurgerService != nil {
+		apConn, err := bgrpc.ClientSetup(conf.AkamaiPurgerService, tlsConfig, clientMetrics, clk)
+		cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to Akamai purger service")
+		purger = akamaipb.NewAkamaiPurgerClient(apConn)
+	}
+
+	var issuers []*issuance.Certificate
+	for _, path := range conf.IssuerCerts {
+		issuer, err := issuance.LoadCertificate(path)
+		cmd.FailOnError(err, "Failed to load issuer certificate")
+		issuers = append(issuers, issuer)
+	}
+
+	var exporter *export.Exporter
+	if conf.Export != nil {
+		if len(issuers) == 0 {
+			cmd.Fail("issuerCerts must be set to export OCSP responses")
+		}
+		store, err := conf.Export.newStore(clk)
+		cmd.FailOnError(err, "Failed to create OCSP export store")
+		exporter = export.New(store, issuers)
+	}
+
 	updater, err := newUpdater(
 		stats,
 		clk,
 		dbMap,
 		ogc,
+		purger,
+		issuers,
+		exporter,
 		// Necessary evil for now
 		conf,
 		logger,
@@ -8,23 +8,31 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/jmhodges/clock"
+	akamaipb "github.com/letsencrypt/boulder/akamai/proto"
 	capb "github.com/letsencrypt/boulder/ca/proto"
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
 	"github.com/letsencrypt/boulder/db"
 	"github.com/letsencrypt/boulder/features"
+	"github.com/letsencrypt/boulder/issuance"
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/ocsp/export"
 	"github.com/letsencrypt/boulder/sa"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 	"github.com/letsencrypt/boulder/sa/satest"
 	"github.com/letsencrypt/boulder/test"
 	"github.com/letsencrypt/boulder/test/vars"
+	"golang.org/x/crypto/ocsp"
 	"google.golang.org/grpc"
 )
 
@@ -59,6 +67,9 @@ func setup(t *testing.T) (*OCSPUpdater, core.StorageAuthority, *db.WrappedMap, c
 		fc,
 		dbMap,
 		&mockOCSP{},
+		nil,
+		nil,
+		nil,
 		OCSPUpdaterConfig{
 			OldOCSPBatchSize:         1,
 			OldOCSPWindow:            cmd.ConfigDuration{Duration: time.Second},
@@ -537,3 +548,200 @@ func TestTickSleep(t *testing.T) {
 	test.AssertEquals(t, took, updater.tickWindow)
 
 }
+
+type mockPurger struct {
+	urls []string
+}
+
+func (mp *mockPurger) Purge(_ context.Context, req *akamaipb.PurgeRequest, _ ...grpc.CallOption) (*corepb.Empty, error) {
+	mp.urls = append(mp.urls, req.Urls...)
+	return &corepb.Empty{}, nil
+}
+
+func TestProcessRevocationEvents(t *testing.T) {
+	if os.Getenv("BOULDER_CONFIG_DIR") != "test/config-next" {
+		t.Skip("the revocationEvents table only exists in the config-next schema")
+	}
+	updater, sa, dbMap, fc, cleanUp := setup(t)
+	defer cleanUp()
+
+	err := features.Set(map[string]bool{"RevocationEventQueue": true})
+	test.AssertNotError(t, err, "Failed to set features")
+	defer features.Reset()
+
+	issuer, err := issuance.LoadCertificate("../../test/test-ca2.pem")
+	test.AssertNotError(t, err, "Couldn't load issuer certificate")
+	purger := &mockPurger{}
+	updater.purger = purger
+	updater.issuers = map[issuance.IssuerNameID]*issuance.Certificate{issuer.NameID(): issuer}
+
+	reg := satest.CreateWorkingRegistration(t, sa)
+	parsedCert, err := core.LoadCert("test-cert.pem")
+	test.AssertNotError(t, err, "Couldn't read test certificate")
+	_, err = sa.AddPrecertificate(ctx, &sapb.AddCertificateRequest{
+		Der:      parsedCert.Raw,
+		RegID:    reg.ID,
+		Ocsp:     nil,
+		Issued:   nowNano(fc),
+		IssuerID: 1,
+	})
+	test.AssertNotError(t, err, "Couldn't add test-cert.pem")
+
+	// Revoke without a response, as the RA does when the CA is unavailable.
+	serial := core.SerialToString(parsedCert.SerialNumber)
+	err = sa.RevokeCertificate(ctx, &sapb.RevokeCertificateRequest{
+		Serial: serial,
+		Reason: 1,
+		Date:   nowNano(fc),
+	})
+	test.AssertNotError(t, err, "Failed to revoke certificate")
+
+	err = updater.processRevocationEvents(ctx)
+	test.AssertNotError(t, err, "Failed to process revocation events")
+	test.AssertEquals(t, test.CountCounterVec("result", "success", updater.revocationEventsCounter), 1)
+
+	status, err := sa.GetCertificateStatus(ctx, serial)
+	test.AssertNotError(t, err, "Failed to get certificate status")
+	test.AssertEquals(t, status.Status, core.OCSPStatusRevoked)
+	test.AssertByteEquals(t, status.OCSPResponse, []byte{1, 2, 3})
+	test.AssertEquals(t, status.OCSPLastUpdated, fc.Now())
+	test.Assert(t, len(purger.urls) > 0, "Expected the revoked certificate's responses to be purged")
+
+	count, err := dbMap.SelectInt("SELECT COUNT(*) FROM revocationEvents")
+	test.AssertNotError(t, err, "Failed to count revocation events")
+	test.AssertEquals(t, count, int64(0))
+
+	// Events which fail to process, here because there's no such certificate,
+	// are rescheduled with backoff rather than retried on every tick.
+	_, err = dbMap.Exec(
+		"INSERT INTO revocationEvents (serial, created, nextAttempt) VALUES (?, ?, ?)",
+		"00000000000000000000000000000000ffff",
+		fc.Now(),
+		fc.Now(),
+	)
+	test.AssertNotError(t, err, "Failed to insert revocation event")
+	err = updater.processRevocationEvents(ctx)
+	test.AssertNotError(t, err, "Failed to process revocation events")
+	test.AssertEquals(t, test.CountCounterVec("result", "failed", updater.revocationEventsCounter), 1)
+	var event struct {
+		Attempts    int       `db:"attempts"`
+		NextAttempt time.Time `db:"nextAttempt"`
+	}
+	err = dbMap.SelectOne(&event, "SELECT attempts, nextAttempt FROM revocationEvents")
+	test.AssertNotError(t, err, "Failed to select revocation event")
+	test.AssertEquals(t, event.Attempts, 1)
+	test.Assert(t, event.NextAttempt.After(fc.Now()), "Expected the failed event to be rescheduled")
+
+	// It isn't retried until its next attempt is due.
+	err = updater.processRevocationEvents(ctx)
+	test.AssertNotError(t, err, "Failed to process revocation events")
+	test.AssertEquals(t, test.CountCounterVec("result", "failed", updater.revocationEventsCounter), 1)
+
+	// Once it's run out of attempts it's abandoned, but kept for inspection.
+	for i := 1; i < updater.revocationEventMaxAttempts; i++ {
+		fc.Add(updater.maxBackoff)
+		err = updater.processRevocationEvents(ctx)
+		test.AssertNotError(t, err, "Failed to process revocation events")
+	}
+	test.AssertEquals(t, test.CountCounterVec("result", "failed", updater.revocationEventsCounter), updater.revocationEventMaxAttempts)
+	test.AssertEquals(t, test.CountCounterVec("result", "abandoned", updater.revocationEventsCounter), 1)
+	fc.Add(updater.maxBackoff)
+	err = updater.processRevocationEvents(ctx)
+	test.AssertNotError(t, err, "Failed to process revocation events")
+	test.AssertEquals(t, test.CountCounterVec("result", "failed", updater.revocationEventsCounter), updater.revocationEventMaxAttempts)
+	count, err = dbMap.SelectInt("SELECT COUNT(*) FROM revocationEvents")
+	test.AssertNotError(t, err, "Failed to count revocation events")
+	test.AssertEquals(t, count, int64(1))
+}
+
+func TestExportRevokedResponses(t *testing.T) {
+	updater, sa, _, fc, cleanUp := setup(t)
+	defer cleanUp()
+
+	dir, err := ioutil.TempDir("", "ocsp-export")
+	test.AssertNotError(t, err, "Failed to create temp dir")
+	defer os.RemoveAll(dir)
+	store, err := export.NewFilesystemStore(dir)
+	test.AssertNotError(t, err, "Failed to create filesystem store")
+	issuer, err := issuance.LoadCertificate("../../test/test-ca2.pem")
+	test.AssertNotError(t, err, "Couldn't load issuer certificate")
+	updater.exporter = export.New(store, []*issuance.Certificate{issuer})
+
+	reg := satest.CreateWorkingRegistration(t, sa)
+	parsedCert, err := core.LoadCert("test-cert.pem")
+	test.AssertNotError(t, err, "Couldn't read test certificate")
+	_, err = sa.AddPrecertificate(ctx, &sapb.AddCertificateRequest{
+		Der:      parsedCert.Raw,
+		RegID:    reg.ID,
+		Ocsp:     nil,
+		Issued:   nowNano(fc),
+		IssuerID: int64(issuer.ID()),
+	})
+	test.AssertNotError(t, err, "Couldn't add test-cert.pem")
+
+	// Revoke with a response, as the RA does, which is signed by an unrelated
+	// key since the exporter doesn't check signatures.
+	k, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.AssertNotError(t, err, "Failed to generate key")
+	response, err := ocsp.CreateResponse(issuer.Certificate, issuer.Certificate, ocsp.Response{
+		SerialNumber: parsedCert.SerialNumber,
+		Status:       ocsp.Revoked,
+		RevokedAt:    fc.Now(),
+		ThisUpdate:   fc.Now(),
+		NextUpdate:   fc.Now().Add(96 * time.Hour),
+	}, k)
+	test.AssertNotError(t, err, "Failed to create response")
+	err = sa.RevokeCertificate(ctx, &sapb.RevokeCertificateRequest{
+		Serial:   core.SerialToString(parsedCert.SerialNumber),
+		Reason:   1,
+		Date:     nowNano(fc),
+		Response: response,
+	})
+	test.AssertNotError(t, err, "Failed to revoke certificate")
+
+	err = updater.exportRevokedResponses(ctx, 10)
+	test.AssertNotError(t, err, "Failed to export revoked responses")
+	test.AssertEquals(t, test.CountCounterVec("result", "success", updater.exportedCounter), 1)
+	key, err := export.Key(issuer.Certificate, parsedCert.SerialNumber)
+	test.AssertNotError(t, err, "Failed to compute export key")
+	exported, err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(key)))
+	test.AssertNotError(t, err, "Failed to read exported response")
+	test.AssertByteEquals(t, exported, response)
+
+	// Once it has caught up, it doesn't export the response again, and an
+	// empty batch moves the cursor up to when the query was made.
+	fc.Add(time.Hour)
+	err = updater.exportRevokedResponses(ctx, 10)
+	test.AssertNotError(t, err, "Failed to export revoked responses")
+	test.AssertEquals(t, test.CountCounterVec("result", "success", updater.exportedCounter), 1)
+	test.AssertEquals(t, updater.revokedExportedSince, fc.Now().Truncate(time.Second))
+	test.AssertEquals(t, updater.revokedExportedSerial, "")
+}
+
+func TestExportConfigNewStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ocsp-export")
+	test.AssertNotError(t, err, "Failed to create temp dir")
+	defer os.RemoveAll(dir)
+	s3Config := &S3ExportConfig{
+		Endpoint:        "https://s3.example.com",
+		Region:          "us-east-1",
+		Bucket:          "ocsp",
+		AccessKeyID:     "id",
+		SecretAccessKey: cmd.PasswordConfig{Password: "secret"},
+	}
+
+	store, err := ExportConfig{Directory: dir}.newStore(clock.NewFake())
+	test.AssertNotError(t, err, "Failed to create filesystem store")
+	_, ok := store.(*export.FilesystemStore)
+	test.Assert(t, ok, "Expected a filesystem store")
+
+	store, err = ExportConfig{S3: s3Config}.newStore(clock.NewFake())
+	test.AssertNotError(t, err, "Failed to create S3 store")
+	_, ok = store.(*export.S3Store)
+	test.Assert(t, ok, "Expected an S3 store")
+
+	_, err = ExportConfig{}.newStore(clock.NewFake())
+	test.AssertError(t, err, "Created a store without a directory or S3 config")
+	_, err = ExportConfig{Directory: dir, S3: s3Config}.newStore(clock.NewFake())
+	test.AssertError(t, err, "Created a store with both a directory and S3 config")
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/letsencrypt/boulder/akamai"
+	akamaipb "github.com/letsencrypt/boulder/akamai/proto"
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/db"
+	"github.com/letsencrypt/boulder/issuance"
+	"github.com/letsencrypt/boulder/sa"
+)
+
+// revocationEvent is a row of the revocationEvents table, which the SA writes
+// in the same transaction as each revocation.
+type revocationEvent struct {
+	ID       int64  `db:"id"`
+	Serial   string `db:"serial"`
+	Attempts int    `db:"attempts"`
+}
+
+// processRevocationEvents consumes a batch of revocation events, signing,
+// storing and purging a fresh OCSP response for each revoked certificate so
+// that its revoked status is served without waiting for it to become stale.
+// Events which can't be processed are retried with backoff, so that they
+// don't hold up the events behind them. Once an event has failed
+// revocationEventMaxAttempts times it's left in the table, but no longer
+// selected, for an operator to investigate.
+func (updater *OCSPUpdater) processRevocationEvents(ctx context.Context) error {
+	var events []revocationEvent
+	_, err := updater.dbMap.Select(
+		&events,
+		`SELECT id, serial, attempts FROM revocationEvents
+		 WHERE nextAttempt <= ?
+		 AND attempts < ?
+		 ORDER BY nextAttempt ASC
+		 LIMIT ?`,
+		updater.clk.Now(),
+		updater.revocationEventMaxAttempts,
+		updater.revocationEventBatchSize,
+	)
+	if err != nil {
+		if db.IsNoRows(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, event := range events {
+		err := updater.processRevocationEvent(ctx, event)
+		if err != nil {
+			updater.log.AuditErrf("Failed to process revocation event for serial %s: %s", event.Serial, err)
+			updater.revocationEventsCounter.WithLabelValues("failed").Inc()
+			err = updater.retryRevocationEvent(event)
+			if err != nil {
+				updater.log.AuditErrf("Failed to reschedule revocation event for serial %s: %s", event.Serial, err)
+			}
+			continue
+		}
+		updater.revocationEventsCounter.WithLabelValues("success").Inc()
+	}
+	return nil
+}
+
+// retryRevocationEvent records a failed attempt to process the event and
+// schedules the next one. If the event has run out of attempts it's left
+// where it is and counted as abandoned.
+func (updater *OCSPUpdater) retryRevocationEvent(event revocationEvent) error {
+	attempts := event.Attempts + 1
+	nextAttempt := updater.clk.Now().Add(core.RetryBackoff(
+		attempts,
+		updater.tickWindow,
+		updater.maxBackoff,
+		updater.backoffFactor,
+	))
+	_, err := updater.dbMap.Exec(
+		"UPDATE revocationEvents SET attempts = ?, nextAttempt = ? WHERE id = ?",
+		attempts,
+		nextAttempt,
+		event.ID,
+	)
+	if err != nil {
+		return err
+	}
+	if attempts >= updater.revocationEventMaxAttempts {
+		updater.log.AuditErrf("Giving up on revocation event for serial %s after %d attempts", event.Serial, attempts)
+		updater.revocationEventsCounter.WithLabelValues("abandoned").Inc()
+	}
+	return nil
+}
+
+// processRevocationEvent signs, stores and exports a response for the
+// certificate's current status, purges the old one from the CDN, and then
+// removes the event from the queue.
+func (updater *OCSPUpdater) processRevocationEvent(ctx context.Context, event revocationEvent) error {
+	status, err := sa.SelectCertificateStatus(updater.dbMap, event.Serial)
+	if err != nil {
+		return fmt.Errorf("selecting certificate status: %w", err)
+	}
+	meta, err := updater.generateResponse(ctx, status)
+	if err != nil {
+		return fmt.Errorf("generating OCSP response: %w", err)
+	}
+	err = updater.storeResponse(meta)
+	if err != nil {
+		return fmt.Errorf("storing OCSP response: %w", err)
+	}
+	err = updater.exportResponse(ctx, meta)
+	if err != nil {
+		return fmt.Errorf("exporting OCSP response: %w", err)
+	}
+	err = updater.purge(ctx, event.Serial)
+	if err != nil {
+		return fmt.Errorf("purging OCSP response: %w", err)
+	}
+	_, err = updater.dbMap.Exec(
+		"DELETE FROM revocationEvents WHERE id = ?",
+		event.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("deleting revocation event: %w", err)
+	}
+	return nil
+}
+
+// purge asks the Akamai purger to drop any cached responses for the
+// certificate with the given serial. It does nothing if no purger is
+// configured.
+func (updater *OCSPUpdater) purge(ctx context.Context, serial string) error {
+	if updater.purger == nil {
+		return nil
+	}
+	// Revocation events may be for precertificates whose final certificate
+	// was never issued, so look for a precertificate first.
+	certObj, err := sa.SelectPrecertificate(updater.dbMap, serial)
+	if db.IsNoRows(err) {
+		certObj, err = sa.SelectCertificate(updater.dbMap, serial)
+	}
+	if err != nil {
+		return err
+	}
+	cert, err := x509.ParseCertificate(certObj.DER)
+	if err != nil {
+		return err
+	}
+	issuer, ok := updater.issuers[issuance.GetIssuerNameID(cert)]
+	if !ok {
+		return fmt.Errorf("unable to identify issuer of certificate %s", serial)
+	}
+	purgeURLs, err := akamai.GeneratePurgeURLs(cert, issuer.Certificate)
+	if err != nil {
+		return err
+	}
+	_, err = updater.purger.Purge(ctx, &akamaipb.PurgeRequest{Urls: purgeURLs})
+	return err
+}
@@ -85,6 +85,9 @@ type RegistrationAuthority interface {
 	// [WebFrontEnd]
 	FinalizeOrder(ctx context.Context, req *rapb.FinalizeOrderRequest) (*corepb.Order, error)
 
+	// [WebFrontEnd]
+	NewPreAuthorization(ctx context.Context, req *rapb.NewPreAuthorizationRequest) (*corepb.Authorization, error)
+
 	// [AdminRevoker]
 	AdministrativelyRevokeCertificate(ctx context.Context, cert x509.Certificate, code revocation.Reason, adminName string) error
 }
@@ -104,15 +107,17 @@ type StorageGetter interface {
 	GetCertificate(ctx context.Context, serial string) (Certificate, error)
 	GetPrecertificate(ctx context.Context, req *sapb.Serial) (*corepb.Certificate, error)
 	GetCertificateStatus(ctx context.Context, serial string) (CertificateStatus, error)
+	GetCertificateAndStatus(ctx context.Context, req *sapb.Serial) (*sapb.CertificateAndStatus, error)
 	CountCertificatesByNames(ctx context.Context, domains []string, earliest, latest time.Time) (countByDomain []*sapb.CountByNames_MapElement, err error)
 	CountRegistrationsByIP(ctx context.Context, ip net.IP, earliest, latest time.Time) (int, error)
 	CountRegistrationsByIPRange(ctx context.Context, ip net.IP, earliest, latest time.Time) (int, error)
-	CountOrders(ctx context.Context, acctID int64, earliest, latest time.Time) (int, error)
+	CountOrders(ctx context.Context, acctID int64, earliest, latest time.Time) (count int, oldest time.Time, err error)
 	CountFQDNSets(ctx context.Context, window time.Duration, domains []string) (count int64, err error)
 	FQDNSetExists(ctx context.Context, domains []string) (exists bool, err error)
 	PreviousCertificateExists(ctx context.Context, req *sapb.PreviousCertificateExistsRequest) (exists *sapb.Exists, err error)
 	GetOrder(ctx context.Context, req *sapb.OrderRequest) (*corepb.Order, error)
 	GetOrderForNames(ctx context.Context, req *sapb.GetOrderForNamesRequest) (*corepb.Order, error)
+	GetOrdersForAccount(ctx context.Context, req *sapb.GetOrdersForAccountRequest) (*sapb.OrderIDs, error)
 	// New authz2 methods
 	GetAuthorization2(ctx context.Context, req *sapb.AuthorizationID2) (*corepb.Authorization, error)
 	GetAuthorizations2(ctx context.Context, req *sapb.GetAuthorizationsRequest) (*sapb.Authorizations, error)
@@ -122,6 +127,10 @@ type StorageGetter interface {
 	CountInvalidAuthorizations2(ctx context.Context, req *sapb.CountInvalidAuthorizationsRequest) (*sapb.Count, error)
 	GetValidAuthorizations2(ctx context.Context, req *sapb.GetValidAuthorizationsRequest) (*sapb.Authorizations, error)
 	KeyBlocked(ctx context.Context, req *sapb.KeyBlockedRequest) (*sapb.Exists, error)
+	GetExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKeyID) (*sapb.ExternalAccountKey, error)
+	GetSCTs(ctx context.Context, req *sapb.Serial) (*sapb.SCTs, error)
+	GetRecentSCTs(ctx context.Context, req *sapb.GetRecentSCTsRequest) (*sapb.SCTs, error)
+	GetFinalCertSubmissions(ctx context.Context, req *sapb.GetFinalCertSubmissionsRequest) (*sapb.FinalCertSubmissions, error)
 }
 
 // StorageAdder are the Boulder SA's write/update methods
@@ -142,6 +151,13 @@ type StorageAdder interface {
 	FinalizeAuthorization2(ctx context.Context, req *sapb.FinalizeAuthorizationRequest) error
 	DeactivateAuthorization2(ctx context.Context, req *sapb.AuthorizationID2) (*corepb.Empty, error)
 	AddBlockedKey(ctx context.Context, req *sapb.AddBlockedKeyRequest) (*corepb.Empty, error)
+	AddExternalAccountKey(ctx context.Context, req *sapb.AddExternalAccountKeyRequest) (*corepb.Empty, error)
+	RevokeExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKeyID) (*corepb.Empty, error)
+	UpdateOCSPResponse(ctx context.Context, req *sapb.UpdateOCSPResponseRequest) (*corepb.Empty, error)
+	AddSCT(ctx context.Context, req *sapb.SCT) (*corepb.Empty, error)
+	AddFinalCertSubmissions(ctx context.Context, req *sapb.AddFinalCertSubmissionsRequest) (*corepb.Empty, error)
+	RescheduleFinalCertSubmission(ctx context.Context, req *sapb.FinalCertSubmission) (*corepb.Empty, error)
+	RemoveFinalCertSubmission(ctx context.Context, req *sapb.FinalCertSubmissionID) (*corepb.Empty, error)
 }
 
 // StorageAuthority interface represents a simple key/value
@@ -140,6 +140,19 @@ type Registration struct {
 	CreatedAt time.Time `json:"createdAt"`
 
 	Status AcmeStatus `json:"status"`
+
+	// Orders is the URL of the list of the registration's orders. It is only
+	// populated by the WFE when presenting a registration to an ACMEv2 client.
+	Orders string `json:"orders,omitempty"`
+
+	// ExternalAccountID is the key identifier of the external account binding
+	// (RFC 8555 Section 7.3.4) that was used to create the registration, if
+	// any. It ties the registration to a tenant known outside of ACME and is
+	// never shown to clients. The binding is persisted as the registrationID
+	// of the key's externalAccountKeys row, not on the registrations row, and
+	// the SA only looks it up when the ExternalAccountBindings feature is
+	// enabled.
+	ExternalAccountID string `json:"-"`
 }
 
 // ValidationRecord represents a validation attempt against a specific URL/hostname
@@ -168,6 +181,9 @@ type ValidationRecord struct {
 	//   ...
 	// }
 	AddressesTried []net.IP `json:"addressesTried,omitempty"`
+	// DNSSEC is the DNSSEC status of the lookup the VA made for the hostname
+	// ("secure" or "insecure"), or empty if the VA didn't validate DNSSEC.
+	DNSSEC string `json:"dnssec,omitempty"`
 }
 
 func looksLikeKeyAuthorization(str string) error {
@@ -509,3 +525,45 @@ type SCTDERs [][]byte
 // CertDER is a convenience type that helps differentiate what the
 // underlying byte slice contains
 type CertDER []byte
+
+// SuggestedWindow is the window of time, exposed inside a RenewalInfo
+// resource, during which the CA would like a certificate to be renewed.
+type SuggestedWindow struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// RenewalInfo is the ACME Renewal Information (ARI) resource served to
+// clients which query the WFE's renewalInfo endpoint.
+type RenewalInfo struct {
+	SuggestedWindow SuggestedWindow `json:"suggestedWindow"`
+}
+
+// RenewalInfoSimple constructs a RenewalInfo whose suggested window is
+// centered on the point two-thirds of the way through the certificate's
+// validity period, and extends a day to either side of it.
+func RenewalInfoSimple(issued time.Time, expires time.Time) RenewalInfo {
+	// The notAfter timestamp is inclusive, so the validity period is one second
+	// longer than the difference between the two timestamps.
+	validity := expires.Add(time.Second).Sub(issued)
+	idealRenewal := expires.Add(-validity / 3)
+	return RenewalInfo{
+		SuggestedWindow: SuggestedWindow{
+			Start: idealRenewal.Add(-24 * time.Hour),
+			End:   idealRenewal.Add(24 * time.Hour),
+		},
+	}
+}
+
+// RenewalInfoImmediate constructs a RenewalInfo whose suggested window lies
+// entirely in the past, indicating that the client should renew right away.
+// This is used for certificates which have been revoked.
+func RenewalInfoImmediate(now time.Time) RenewalInfo {
+	oneHourAgo := now.Add(-time.Hour)
+	return RenewalInfo{
+		SuggestedWindow: SuggestedWindow{
+			Start: oneHourAgo,
+			End:   oneHourAgo.Add(30 * time.Minute),
+		},
+	}
+}
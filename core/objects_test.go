@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"net"
 	"testing"
+	"time"
 
 	"gopkg.in/square/go-jose.v2"
 
@@ -173,3 +174,22 @@ func TestFindChallengeByType(t *testing.T) {
 	test.AssertEquals(t, 1, authz.FindChallengeByStringID(authz.Challenges[1].StringID()))
 	test.AssertEquals(t, -1, authz.FindChallengeByStringID("hello"))
 }
+
+func TestRenewalInfoSimple(t *testing.T) {
+	issued := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	expires := issued.Add(90 * 24 * time.Hour).Add(-time.Second)
+	ri := RenewalInfoSimple(issued, expires)
+	// Two thirds of the way through a 90 day certificate is the last second of
+	// day 60.
+	idealRenewal := issued.Add(60 * 24 * time.Hour).Add(-time.Second)
+	test.AssertEquals(t, ri.SuggestedWindow.Start, idealRenewal.Add(-24*time.Hour))
+	test.AssertEquals(t, ri.SuggestedWindow.End, idealRenewal.Add(24*time.Hour))
+}
+
+func TestRenewalInfoImmediate(t *testing.T) {
+	now := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	ri := RenewalInfoImmediate(now)
+	test.Assert(t, ri.SuggestedWindow.Start.Before(now), "window should start in the past")
+	test.Assert(t, ri.SuggestedWindow.End.Before(now), "window should end in the past")
+	test.Assert(t, ri.SuggestedWindow.Start.Before(ri.SuggestedWindow.End), "window should be well formed")
+}
@@ -16,6 +16,7 @@ import (
 	"io/ioutil"
 	"math/big"
 	mrand "math/rand"
+	"net"
 	"reflect"
 	"regexp"
 	"sort"
@@ -247,6 +248,18 @@ func UniqueLowerNames(names []string) (unique []string) {
 	return
 }
 
+// SANNames returns the given subject alternative name DNS names together with
+// the textual form of the given IP addresses. Orders, FQDN sets and rate limits
+// track both kinds of identifier as plain strings.
+func SANNames(dnsNames []string, ipAddresses []net.IP) []string {
+	names := make([]string, 0, len(dnsNames)+len(ipAddresses))
+	names = append(names, dnsNames...)
+	for _, ip := range ipAddresses {
+		names = append(names, ip.String())
+	}
+	return names
+}
+
 // LoadCertBundle loads a PEM bundle of certificates from disk
 func LoadCertBundle(filename string) ([]*x509.Certificate, error) {
 	bundleBytes, err := ioutil.ReadFile(filename)
@@ -321,6 +334,18 @@ func RetryBackoff(retries int, base, max time.Duration, factor float64) time.Dur
 	return time.Duration(backoff)
 }
 
+// UnixNanoToTime returns a pointer to the UTC time for the given Unix
+// nanoseconds, or nil if they are zero. Protobuf messages use zero for unset
+// timestamps, which maps to NULL in nullable datetime columns and to omitted
+// fields in JSON.
+func UnixNanoToTime(nanos int64) *time.Time {
+	if nanos == 0 {
+		return nil
+	}
+	t := time.Unix(0, nanos).UTC()
+	return &t
+}
+
 // IsASCII determines if every character in a string is encoded in
 // the ASCII character set.
 func IsASCII(str string) bool {
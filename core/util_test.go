@@ -92,6 +92,19 @@ func TestKeyDigest(t *testing.T) {
 	test.Assert(t, err != nil, "Should have rejected unknown key type")
 }
 
+func TestKeyDigestEd25519(t *testing.T) {
+	// Ed25519 public key from RFC 8037 Appendix A.2
+	const jwkJSON = `{"kty":"OKP","crv":"Ed25519","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"}`
+	var jwk jose.JSONWebKey
+	err := json.Unmarshal([]byte(jwkJSON), &jwk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest, err := KeyDigestB64(jwk)
+	test.AssertNotError(t, err, "Failed to digest Ed25519 JWK")
+	test.AssertEquals(t, digest, "BuP9j9opu2CrWVV95h7bCuzbIxE0vjDnW0Vfjht5L6k=")
+}
+
 func TestKeyDigestEquals(t *testing.T) {
 	var jwk1, jwk2 jose.JSONWebKey
 	err := json.Unmarshal([]byte(JWK1JSON), &jwk1)
@@ -203,3 +216,10 @@ func TestRetryBackoff(t *testing.T) {
 	assertBetween(float64(backoff), float64(expected)*0.8, float64(expected)*1.2)
 
 }
+
+func TestUnixNanoToTime(t *testing.T) {
+	test.Assert(t, UnixNanoToTime(0) == nil, "zero timestamp wasn't converted to nil")
+	converted := UnixNanoToTime(1617278400000000000)
+	test.Assert(t, converted != nil, "non-zero timestamp was converted to nil")
+	test.AssertEquals(t, *converted, time.Date(2021, 4, 1, 12, 0, 0, 0, time.UTC))
+}
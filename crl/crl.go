@@ -0,0 +1,102 @@
+// Package crl contains the parts of CRL generation which must agree between
+// the crl-updater, which produces the CRLs, and anything which needs to know
+// which CRL a certificate's revocation will be published in.
+package crl
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/letsencrypt/boulder/cmd"
+)
+
+const (
+	// ShardBySerial assigns certificates to shards by their serial number.
+	ShardBySerial = "serial"
+	// ShardByExpiry assigns certificates to shards by their notAfter date, so
+	// that each shard only contains certificates expiring within a set of
+	// ShardWidth sized chunks of time.
+	ShardByExpiry = "expiry"
+)
+
+// ShardingConfig describes how an issuer's certificates are partitioned into
+// the shards which each get their own CRL. Changing it moves certificates
+// between shards, so it must stay the same for as long as any certificate
+// which was issued under it is unexpired.
+type ShardingConfig struct {
+	// Scheme is either ShardBySerial or ShardByExpiry.
+	Scheme string
+	// NumShards is the number of CRLs each issuer's certificates are
+	// partitioned into.
+	NumShards int
+	// ShardWidth is the length of the chunks of time which ShardByExpiry
+	// assigns to shards in turn. It is unused by ShardBySerial.
+	ShardWidth cmd.ConfigDuration
+}
+
+// Validate returns an error if the ShardingConfig can't be used to assign
+// certificates to shards.
+func (c ShardingConfig) Validate() error {
+	if c.NumShards <= 0 {
+		return errors.New("number of CRL shards must be positive")
+	}
+	switch c.Scheme {
+	case ShardBySerial:
+	case ShardByExpiry:
+		if c.ShardWidth.Duration < time.Second {
+			return errors.New("CRL shard width must be at least one second")
+		}
+	default:
+		return fmt.Errorf("unknown CRL sharding scheme %q", c.Scheme)
+	}
+	return nil
+}
+
+// Shard returns the index of the shard the certificate with the given serial
+// and notAfter date belongs to. The ShardingConfig must be valid.
+func (c ShardingConfig) Shard(serial *big.Int, notAfter time.Time) int {
+	numShards := big.NewInt(int64(c.NumShards))
+	if c.Scheme == ShardByExpiry {
+		chunk := notAfter.Unix() / int64(c.ShardWidth.Duration/time.Second)
+		return int(new(big.Int).Mod(big.NewInt(chunk), numShards).Int64())
+	}
+	return int(new(big.Int).Mod(serial, numShards).Int64())
+}
+
+// Path returns the location of the CRL for the given shard of an issuer's
+// certificates, relative to the directory it is stored in.
+func Path(issuerNameID int64, shard int) string {
+	return fmt.Sprintf("%d/%d.crl", issuerNameID, shard)
+}
+
+// URL expands a CRL URL template for the given shard of an issuer's
+// certificates. "{issuer}" in the template is replaced with the issuer's
+// NameID and "{shard}" with the index of the shard.
+func URL(template string, issuerNameID int64, shard int) string {
+	return strings.NewReplacer(
+		"{issuer}", strconv.FormatInt(issuerNameID, 10),
+		"{shard}", strconv.Itoa(shard),
+	).Replace(template)
+}
+
+// ValidateURLTemplate returns an error if the CRL URL template doesn't expand
+// to a distinct http URL for each shard. The Baseline Requirements (Section
+// 7.1.2.3) require CRL distribution points to be http URLs.
+func ValidateURLTemplate(template string) error {
+	if !strings.Contains(template, "{shard}") {
+		return errors.New("CRL URL template must contain {shard}")
+	}
+	u, err := url.Parse(URL(template, 0, 0))
+	if err != nil {
+		return fmt.Errorf("CRL URL template is not a valid URL: %w", err)
+	}
+	if u.Scheme != "http" || u.Host == "" {
+		return errors.New("CRL URL template must be an http URL")
+	}
+	return nil
+}
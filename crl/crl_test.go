@@ -0,0 +1,88 @@
+package crl
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      ShardingConfig
+		expectedErr string
+	}{
+		{
+			name:   "serial",
+			config: ShardingConfig{Scheme: ShardBySerial, NumShards: 4},
+		},
+		{
+			name:   "expiry",
+			config: ShardingConfig{Scheme: ShardByExpiry, NumShards: 4, ShardWidth: cmd.ConfigDuration{Duration: 24 * time.Hour}},
+		},
+		{
+			name:        "no shards",
+			config:      ShardingConfig{Scheme: ShardBySerial},
+			expectedErr: "number of CRL shards must be positive",
+		},
+		{
+			name:        "expiry without width",
+			config:      ShardingConfig{Scheme: ShardByExpiry, NumShards: 4},
+			expectedErr: "CRL shard width must be at least one second",
+		},
+		{
+			name:        "unknown scheme",
+			config:      ShardingConfig{Scheme: "random", NumShards: 4},
+			expectedErr: `unknown CRL sharding scheme "random"`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if tc.expectedErr == "" {
+				test.AssertNotError(t, err, "Validate failed")
+			} else {
+				test.AssertError(t, err, "Validate didn't fail")
+				test.AssertEquals(t, err.Error(), tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestShard(t *testing.T) {
+	notAfter := time.Date(2021, 4, 10, 12, 0, 0, 0, time.UTC)
+
+	bySerial := ShardingConfig{Scheme: ShardBySerial, NumShards: 4}
+	test.AssertEquals(t, bySerial.Shard(big.NewInt(8), notAfter), 0)
+	test.AssertEquals(t, bySerial.Shard(big.NewInt(11), notAfter), 3)
+	test.AssertEquals(t, bySerial.Shard(big.NewInt(11), notAfter.Add(time.Hour)), 3)
+
+	byExpiry := ShardingConfig{Scheme: ShardByExpiry, NumShards: 4, ShardWidth: cmd.ConfigDuration{Duration: 24 * time.Hour}}
+	shard := byExpiry.Shard(big.NewInt(8), notAfter)
+	// The serial makes no difference, nor does the time within the same day.
+	test.AssertEquals(t, byExpiry.Shard(big.NewInt(11), notAfter.Add(time.Hour)), shard)
+	// Each following day is assigned to the next shard, wrapping around.
+	test.AssertEquals(t, byExpiry.Shard(big.NewInt(8), notAfter.Add(24*time.Hour)), (shard+1)%4)
+	test.AssertEquals(t, byExpiry.Shard(big.NewInt(8), notAfter.Add(4*24*time.Hour)), shard)
+}
+
+func TestURL(t *testing.T) {
+	test.AssertEquals(t, Path(1234, 5), "1234/5.crl")
+	test.AssertEquals(t, URL("http://c.example.com/{issuer}/{shard}.crl", 1234, 5), "http://c.example.com/1234/5.crl")
+	test.AssertEquals(t, URL("http://c{shard}.example.com/crl", 1234, 5), "http://c5.example.com/crl")
+}
+
+func TestValidateURLTemplate(t *testing.T) {
+	test.AssertNotError(t, ValidateURLTemplate("http://c.example.com/{issuer}/{shard}.crl"), "valid template rejected")
+	test.AssertNotError(t, ValidateURLTemplate("http://c.example.com/{shard}.crl"), "valid template rejected")
+
+	err := ValidateURLTemplate("http://c.example.com/{issuer}.crl")
+	test.AssertError(t, err, "template without shard accepted")
+	err = ValidateURLTemplate("https://c.example.com/{shard}.crl")
+	test.AssertError(t, err, "https template accepted")
+	err = ValidateURLTemplate("{shard}.crl")
+	test.AssertError(t, err, "relative template accepted")
+}
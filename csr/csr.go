@@ -1,14 +1,18 @@
 package csr
 
 import (
+	"bytes"
 	"context"
 	"crypto"
 	"crypto/x509"
 	"errors"
+	"net"
+	"sort"
 	"strings"
 
 	"github.com/letsencrypt/boulder/core"
 	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/features"
 	"github.com/letsencrypt/boulder/goodkey"
 	"github.com/letsencrypt/boulder/identifier"
 )
@@ -67,10 +71,10 @@ func VerifyCSR(ctx context.Context, csr *x509.CertificateRequest, maxNames int,
 	if len(csr.EmailAddresses) > 0 {
 		return invalidEmailPresent
 	}
-	if len(csr.IPAddresses) > 0 {
+	if len(csr.IPAddresses) > 0 && !features.Enabled(features.IPIdentifiers) {
 		return invalidIPPresent
 	}
-	if len(csr.DNSNames) == 0 && csr.Subject.CommonName == "" {
+	if len(csr.DNSNames) == 0 && len(csr.IPAddresses) == 0 && csr.Subject.CommonName == "" {
 		return invalidNoDNS
 	}
 	if csr.Subject.CommonName == "" {
@@ -79,12 +83,19 @@ func VerifyCSR(ctx context.Context, csr *x509.CertificateRequest, maxNames int,
 	if len(csr.Subject.CommonName) > maxCNLength {
 		return berrors.BadCSRError("CN was longer than %d bytes", maxCNLength)
 	}
-	if len(csr.DNSNames) > maxNames {
+	if len(csr.IPAddresses) > 0 {
+		if len(csr.DNSNames)+len(csr.IPAddresses) > maxNames {
+			return berrors.BadCSRError("CSR contains more than %d DNS names and IP addresses", maxNames)
+		}
+	} else if len(csr.DNSNames) > maxNames {
 		return berrors.BadCSRError("CSR contains more than %d DNS names", maxNames)
 	}
-	idents := make([]identifier.ACMEIdentifier, len(csr.DNSNames))
-	for i, dnsName := range csr.DNSNames {
-		idents[i] = identifier.DNSIdentifier(dnsName)
+	idents := make([]identifier.ACMEIdentifier, 0, len(csr.DNSNames)+len(csr.IPAddresses))
+	for _, dnsName := range csr.DNSNames {
+		idents = append(idents, identifier.DNSIdentifier(dnsName))
+	}
+	for _, ip := range csr.IPAddresses {
+		idents = append(idents, identifier.IPIdentifier(ip))
 	}
 	if err := pa.WillingToIssueWildcards(idents); err != nil {
 		return err
@@ -92,8 +103,10 @@ func VerifyCSR(ctx context.Context, csr *x509.CertificateRequest, maxNames int,
 	return nil
 }
 
-// normalizeCSR deduplicates and lowers the case of dNSNames and the subject CN.
-// It will also hoist a dNSName into the CN if it is empty.
+// normalizeCSR deduplicates and lowers the case of dNSNames and the subject CN,
+// and deduplicates iPAddresses. It will also hoist a dNSName, or failing that
+// an iPAddress, into the CN if it is empty. A CN holding an IP address is
+// rewritten to the address's canonical form.
 func normalizeCSR(csr *x509.CertificateRequest) {
 	if csr.Subject.CommonName == "" {
 		var forcedCN string
@@ -104,10 +117,46 @@ func normalizeCSR(csr *x509.CertificateRequest) {
 				break
 			}
 		}
+		if forcedCN == "" && len(csr.IPAddresses) > 0 {
+			forcedCN = csr.IPAddresses[0].String()
+		}
 		csr.Subject.CommonName = forcedCN
-	} else if csr.Subject.CommonName != "" {
+	} else if ip := net.ParseIP(csr.Subject.CommonName); ip != nil {
+		csr.Subject.CommonName = ip.String()
+		csr.IPAddresses = append(csr.IPAddresses, ip)
+	} else {
 		csr.DNSNames = append(csr.DNSNames, csr.Subject.CommonName)
 	}
 	csr.Subject.CommonName = strings.ToLower(csr.Subject.CommonName)
 	csr.DNSNames = core.UniqueLowerNames(csr.DNSNames)
+	csr.IPAddresses = uniqueIPs(csr.IPAddresses)
+}
+
+// uniqueIPs returns the set of unique IP addresses in the input, sorted by
+// their byte representation. IPv4 addresses are returned in their 4-byte form
+// so that they compare equal to addresses parsed from certificates.
+func uniqueIPs(ips []net.IP) []net.IP {
+	if len(ips) == 0 {
+		return ips
+	}
+	var unique []net.IP
+	for _, ip := range ips {
+		if v4 := ip.To4(); v4 != nil {
+			ip = v4
+		}
+		var seen bool
+		for _, u := range unique {
+			if u.Equal(ip) {
+				seen = true
+				break
+			}
+		}
+		if !seen {
+			unique = append(unique, ip)
+		}
+	}
+	sort.Slice(unique, func(i, j int) bool {
+		return bytes.Compare(unique[i], unique[j]) < 0
+	})
+	return unique
 }
@@ -13,6 +13,7 @@ import (
 
 	"github.com/letsencrypt/boulder/core"
 	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/features"
 	"github.com/letsencrypt/boulder/goodkey"
 	"github.com/letsencrypt/boulder/identifier"
 	"github.com/letsencrypt/boulder/test"
@@ -226,3 +227,70 @@ func TestNormalizeCSR(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyCSRIPAddresses(t *testing.T) {
+	_ = features.Set(map[string]bool{"IPIdentifiers": true})
+	defer features.Reset()
+
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.AssertNotError(t, err, "error generating test key")
+	signedReqBytes, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{PublicKey: private.PublicKey, SignatureAlgorithm: x509.SHA256WithRSA}, private)
+	test.AssertNotError(t, err, "error generating test CSR")
+	signedReq, err := x509.ParseCertificateRequest(signedReqBytes)
+	test.AssertNotError(t, err, "error parsing test CSR")
+
+	signedReqWithIPAddress := new(x509.CertificateRequest)
+	*signedReqWithIPAddress = *signedReq
+	signedReqWithIPAddress.IPAddresses = []net.IP{net.IPv4(1, 2, 3, 4)}
+	err = VerifyCSR(context.Background(), signedReqWithIPAddress, 100, testingPolicy, &mockPA{}, 0)
+	test.AssertNotError(t, err, "VerifyCSR rejected a CSR with an IP address")
+	test.AssertEquals(t, signedReqWithIPAddress.Subject.CommonName, "1.2.3.4")
+
+	signedReqWithTooManyNames := new(x509.CertificateRequest)
+	*signedReqWithTooManyNames = *signedReq
+	signedReqWithTooManyNames.DNSNames = []string{"a.com"}
+	signedReqWithTooManyNames.IPAddresses = []net.IP{net.IPv4(1, 2, 3, 4)}
+	err = VerifyCSR(context.Background(), signedReqWithTooManyNames, 1, testingPolicy, &mockPA{}, 0)
+	test.AssertDeepEquals(t, err, berrors.BadCSRError("CSR contains more than 1 DNS names and IP addresses"))
+}
+
+func TestNormalizeCSRIPAddresses(t *testing.T) {
+	cases := []struct {
+		name        string
+		csr         *x509.CertificateRequest
+		expectedCN  string
+		expectedIPs []net.IP
+	}{
+		{
+			"no explicit CN, only IP addresses",
+			&x509.CertificateRequest{IPAddresses: []net.IP{net.ParseIP("2001:db8::1"), net.IPv4(1, 2, 3, 4)}},
+			"2001:db8::1",
+			[]net.IP{net.IPv4(1, 2, 3, 4).To4(), net.ParseIP("2001:db8::1")},
+		},
+		{
+			"no explicit CN, DNS names preferred",
+			&x509.CertificateRequest{DNSNames: []string{"a.com"}, IPAddresses: []net.IP{net.IPv4(1, 2, 3, 4)}},
+			"a.com",
+			[]net.IP{net.IPv4(1, 2, 3, 4).To4()},
+		},
+		{
+			"explicit non-canonical IP CN",
+			&x509.CertificateRequest{Subject: pkix.Name{CommonName: "2001:DB8:0::1"}, IPAddresses: []net.IP{net.ParseIP("2001:db8::1")}},
+			"2001:db8::1",
+			[]net.IP{net.ParseIP("2001:db8::1")},
+		},
+		{
+			"explicit IP CN not in SANs",
+			&x509.CertificateRequest{Subject: pkix.Name{CommonName: "1.2.3.4"}, DNSNames: []string{"a.com"}},
+			"1.2.3.4",
+			[]net.IP{net.IPv4(1, 2, 3, 4).To4()},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			normalizeCSR(c.csr)
+			test.AssertEquals(t, c.expectedCN, c.csr.Subject.CommonName)
+			test.AssertDeepEquals(t, c.expectedIPs, c.csr.IPAddresses)
+		})
+	}
+}
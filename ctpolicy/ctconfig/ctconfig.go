@@ -88,3 +88,17 @@ type CTGroup struct {
 	// the next.
 	Stagger cmd.ConfigDuration
 }
+
+// LogListConfig describes a log list in the v3 JSON format published by
+// Chrome and Apple, from which CT groups are built automatically: one group
+// per log operator, containing that operator's logs in the given states.
+type LogListConfig struct {
+	// File is the path to the log list. It is reloaded when it changes.
+	File string
+	// States are the log states to use, e.g. "usable" and "qualified".
+	// Defaults to "usable".
+	States []string
+	// How long to wait for one log in a group to accept a certificate before
+	// moving on to the next.
+	Stagger cmd.ConfigDuration
+}
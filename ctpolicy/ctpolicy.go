@@ -9,6 +9,7 @@ import (
 	"github.com/letsencrypt/boulder/canceled"
 	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/ctpolicy/ctconfig"
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
 	berrors "github.com/letsencrypt/boulder/errors"
 	blog "github.com/letsencrypt/boulder/log"
 	pubpb "github.com/letsencrypt/boulder/publisher/proto"
@@ -18,13 +19,18 @@ import (
 // CTPolicy is used to hold information about SCTs required from various
 // groupings
 type CTPolicy struct {
-	pub           pubpb.PublisherClient
-	groups        []ctconfig.CTGroup
+	pub    pubpb.PublisherClient
+	groups []ctconfig.CTGroup
+	// logList, if set, is used in place of groups: each certificate is
+	// submitted to the logs which accept it until it has logListRule's SCTs.
+	logList       *loglist.Source
+	stagger       time.Duration
 	informational []ctconfig.LogDescription
 	finalLogs     []ctconfig.LogDescription
 	log           blog.Logger
 
 	winnerCounter *prometheus.CounterVec
+	policyCounter *prometheus.CounterVec
 }
 
 // New creates a new CTPolicy struct
@@ -57,6 +63,15 @@ func New(pub pubpb.PublisherClient,
 	)
 	stats.MustRegister(winnerCounter)
 
+	policyCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sct_policy_rules",
+			Help: "Counter of browser CT policy rules satisfied by the SCTs collected for a certificate, or of failures to satisfy them.",
+		},
+		[]string{"rule"},
+	)
+	stats.MustRegister(policyCounter)
+
 	return &CTPolicy{
 		pub:           pub,
 		groups:        groups,
@@ -64,7 +79,43 @@ func New(pub pubpb.PublisherClient,
 		finalLogs:     finalLogs,
 		log:           log,
 		winnerCounter: winnerCounter,
+		policyCounter: policyCounter,
+	}
+}
+
+// NewFromLogList creates a CTPolicy which gets SCTs from the logs in a log
+// list which accept a certificate's expiration date, requiring only as many
+// SCTs from distinct operators as logListRule does rather than one from every
+// operator.
+func NewFromLogList(pub pubpb.PublisherClient,
+	logList *loglist.Source,
+	stagger time.Duration,
+	informational []ctconfig.LogDescription,
+	log blog.Logger,
+	stats prometheus.Registerer,
+) *CTPolicy {
+	ctp := New(pub, nil, informational, log, stats)
+	ctp.logList = logList
+	ctp.stagger = stagger
+	return ctp
+}
+
+// logListRule is the requirement GetSCTs meets when a log list is in use: it
+// is the rule shared by the browser CT policies for certificates of the
+// lifetimes we issue, so one operator's outage doesn't block issuance.
+var logListRule = Rule{Policy: "log list", SCTs: 2, Operators: 2}
+
+// groupsFor returns the groups to get SCTs from for a certificate with the
+// given expiration date.
+func (ctp *CTPolicy) groupsFor(expiration time.Time) ([]ctconfig.CTGroup, error) {
+	if ctp.logList == nil {
+		return ctp.groups, nil
 	}
+	groups := ctp.logList.List().ForTime(expiration).Groups(ctp.stagger)
+	if len(groups) == 0 {
+		return nil, berrors.MissingSCTsError("no CT logs in the log list accept certificates expiring at %s", expiration)
+	}
+	return groups, nil
 }
 
 type result struct {
@@ -138,12 +189,20 @@ func (ctp *CTPolicy) race(ctx context.Context, cert core.CertDER, group ctconfig
 }
 
 // GetSCTs attempts to retrieve a SCT from each configured grouping of logs and returns
-// the set of SCTs to the caller.
+// the set of SCTs to the caller. When a log list is in use it instead races
+// across all of the list's logs for SCTs meeting logListRule.
 func (ctp *CTPolicy) GetSCTs(ctx context.Context, cert core.CertDER, expiration time.Time) (core.SCTDERs, error) {
-	results := make(chan result, len(ctp.groups))
+	if ctp.logList != nil {
+		return ctp.collectSCTs(ctx, cert, expiration, []Rule{logListRule})
+	}
+	groups, err := ctp.groupsFor(expiration)
+	if err != nil {
+		return nil, err
+	}
+	results := make(chan result, len(groups))
 	subCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
-	for i, g := range ctp.groups {
+	for i, g := range groups {
 		go func(i int, g ctconfig.CTGroup) {
 			sct, err := ctp.race(subCtx, cert, g, expiration)
 			// Only one of these will be non-nil
@@ -153,6 +212,25 @@ func (ctp *CTPolicy) GetSCTs(ctx context.Context, cert core.CertDER, expiration
 			results <- result{sct: sct}
 		}(i, g)
 	}
+	ctp.submitInformational(cert, expiration)
+
+	var ret core.SCTDERs
+	for i := 0; i < len(groups); i++ {
+		res := <-results
+		// If any one group fails to get a SCT then we fail out immediately
+		// cancel any other in progress work as we can't continue
+		if res.err != nil {
+			// Returning triggers the defer'd context cancellation method
+			return nil, res.err
+		}
+		ret = append(ret, res.sct)
+	}
+	return ret, nil
+}
+
+// submitInformational asynchronously submits a precertificate to each of the
+// informational logs, whose SCTs are never used.
+func (ctp *CTPolicy) submitInformational(cert core.CertDER, expiration time.Time) {
 	isPrecert := true
 	for _, log := range ctp.informational {
 		go func(l ctconfig.LogDescription) {
@@ -176,41 +254,39 @@ func (ctp *CTPolicy) GetSCTs(ctx context.Context, cert core.CertDER, expiration
 			}
 		}(log)
 	}
-
-	var ret core.SCTDERs
-	for i := 0; i < len(ctp.groups); i++ {
-		res := <-results
-		// If any one group fails to get a SCT then we fail out immediately
-		// cancel any other in progress work as we can't continue
-		if res.err != nil {
-			// Returning triggers the defer'd context cancellation method
-			return nil, res.err
-		}
-		ret = append(ret, res.sct)
-	}
-	return ret, nil
 }
 
 // SubmitFinalCert submits finalized certificates created from precertificates
 // to any configured logs
 func (ctp *CTPolicy) SubmitFinalCert(cert []byte, expiration time.Time) {
-	for _, log := range ctp.finalLogs {
+	for _, log := range ctp.FinalLogs(expiration) {
 		go func(l ctconfig.LogDescription) {
-			uri, key, err := l.Info(expiration)
-			if err != nil {
-				ctp.log.Errf("unable to get log info: %s", err)
-				return
-			}
-			_, err = ctp.pub.SubmitToSingleCTWithResult(context.Background(), &pubpb.Request{
-				LogURL:       uri,
-				LogPublicKey: key,
+			_, err := ctp.pub.SubmitToSingleCTWithResult(context.Background(), &pubpb.Request{
+				LogURL:       l.URI,
+				LogPublicKey: l.Key,
 				Der:          cert,
 				Precert:      false,
 				StoreSCT:     false,
 			})
 			if err != nil {
-				ctp.log.Warningf("ct submission of final cert to log %q failed: %s", uri, err)
+				ctp.log.Warningf("ct submission of final cert to log %q failed: %s", l.URI, err)
 			}
 		}(log)
 	}
 }
+
+// FinalLogs returns the URI and key of each log which final certificates
+// expiring at the given time should be submitted to, choosing the right
+// shard of any temporal log sets.
+func (ctp *CTPolicy) FinalLogs(expiration time.Time) []ctconfig.LogDescription {
+	var logs []ctconfig.LogDescription
+	for _, l := range ctp.finalLogs {
+		uri, key, err := l.Info(expiration)
+		if err != nil {
+			ctp.log.Errf("unable to get log info: %s", err)
+			continue
+		}
+		logs = append(logs, ctconfig.LogDescription{URI: uri, Key: key})
+	}
+	return logs
+}
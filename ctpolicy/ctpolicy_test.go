@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"regexp"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/ctpolicy/ctconfig"
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
 	berrors "github.com/letsencrypt/boulder/errors"
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/metrics"
@@ -243,3 +245,64 @@ func TestStagger(t *testing.T) {
 		t.Errorf("wrong number of requests to publisher. got %d, expected 1", countingPub.count)
 	}
 }
+
+// A mock publisher that records the logs it's asked to submit to, and fails
+// submissions to any of the logs in fail
+type recordLogs struct {
+	sync.Mutex
+	urls map[string]bool
+	fail map[string]bool
+}
+
+func (rl *recordLogs) SubmitToSingleCTWithResult(_ context.Context, req *pubpb.Request, _ ...grpc.CallOption) (*pubpb.Result, error) {
+	rl.Lock()
+	defer rl.Unlock()
+	rl.urls[req.LogURL] = true
+	if rl.fail[req.LogURL] {
+		return nil, errors.New("BAD")
+	}
+	return &pubpb.Result{Sct: []byte{0}}, nil
+}
+
+func TestGetSCTsFromLogList(t *testing.T) {
+	shardStart := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	shardEnd := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	source := loglist.NewStaticSource(loglist.List{
+		{Operator: "A", URL: "a1", Key: "ka1", State: loglist.Usable},
+		{Operator: "A", URL: "a2", Key: "ka2", State: loglist.Retired},
+		{Operator: "B", URL: "b1", Key: "kb1", State: loglist.Usable, StartInclusive: shardStart, EndExclusive: shardEnd},
+		{Operator: "C", URL: "c1", Key: "kc1", State: loglist.Usable},
+	}, loglist.Usable)
+
+	pub := &recordLogs{urls: make(map[string]bool)}
+	ctp := NewFromLogList(pub, source, 0, nil, blog.NewMock(), metrics.NoopRegisterer)
+
+	// A certificate gets SCTs from two operators, and never from a retired
+	// log or one whose shard doesn't cover its expiration.
+	scts, err := ctp.GetSCTs(context.Background(), []byte{0}, shardEnd)
+	test.AssertNotError(t, err, "GetSCTs failed")
+	test.AssertEquals(t, len(scts), 2)
+	test.AssertDeepEquals(t, pub.urls, map[string]bool{"a1": true, "c1": true})
+
+	// An outage at one operator doesn't stop it getting SCTs from the others.
+	pub.urls = make(map[string]bool)
+	pub.fail = map[string]bool{"a1": true}
+	scts, err = ctp.GetSCTs(context.Background(), []byte{0}, shardStart.Add(time.Hour))
+	test.AssertNotError(t, err, "GetSCTs failed with one operator down")
+	test.AssertEquals(t, len(scts), 2)
+	test.Assert(t, pub.urls["b1"] && pub.urls["c1"], "GetSCTs didn't submit to both remaining operators")
+
+	// But it fails if only one operator is left.
+	_, err = ctp.GetSCTs(context.Background(), []byte{0}, shardEnd)
+	test.AssertError(t, err, "GetSCTs succeeded with one operator")
+	test.AssertErrorIs(t, err, berrors.MissingSCTs)
+
+	// If no logs accept the certificate, GetSCTs fails without submitting it.
+	source = loglist.NewStaticSource(loglist.List{
+		{Operator: "B", URL: "b1", Key: "kb1", State: loglist.Usable, StartInclusive: shardStart, EndExclusive: shardEnd},
+	}, loglist.Usable)
+	ctp = NewFromLogList(pub, source, 0, nil, blog.NewMock(), metrics.NoopRegisterer)
+	_, err = ctp.GetSCTs(context.Background(), []byte{0}, shardEnd)
+	test.AssertError(t, err, "GetSCTs succeeded with no logs")
+	test.AssertErrorIs(t, err, berrors.MissingSCTs)
+}
@@ -0,0 +1,253 @@
+// Package loglist loads CT logs from a log list in the v3 JSON format which
+// Chrome and Apple publish, so that Boulder's CT configuration can follow
+// browser policy rather than being maintained by hand.
+package loglist
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/ctpolicy/ctconfig"
+)
+
+// State is the state of a log in a log list. Only qualified and usable logs
+// are trusted by browsers for new SCTs.
+type State string
+
+const (
+	Pending   = State("pending")
+	Qualified = State("qualified")
+	Usable    = State("usable")
+	ReadOnly  = State("readonly")
+	Retired   = State("retired")
+	Rejected  = State("rejected")
+)
+
+var knownStates = map[State]bool{
+	Pending:   true,
+	Qualified: true,
+	Usable:    true,
+	ReadOnly:  true,
+	Retired:   true,
+	Rejected:  true,
+}
+
+// ParseState returns the State with the given name.
+func ParseState(name string) (State, error) {
+	state := State(name)
+	if !knownStates[state] {
+		return "", fmt.Errorf("unknown log state %q", name)
+	}
+	return state, nil
+}
+
+// Log is a single CT log from a log list.
+type Log struct {
+	Operator    string
+	Description string
+	// ID is the base64 encoding of the log's ID, the SHA-256 hash of its key.
+	ID string
+	// Key is the base64 encoding of the log's DER SubjectPublicKeyInfo, as
+	// used by ctconfig.LogDescription.
+	Key   string
+	URL   string
+	MMD   time.Duration
+	State State
+	// StartInclusive and EndExclusive bound the expiration dates of the
+	// certificates a temporally sharded log accepts. Both are zero for logs
+	// which aren't sharded.
+	StartInclusive time.Time
+	EndExclusive   time.Time
+}
+
+// Accepts returns true if the log accepts certificates which expire at the
+// given time.
+func (l Log) Accepts(expiration time.Time) bool {
+	if l.StartInclusive.IsZero() && l.EndExclusive.IsZero() {
+		return true
+	}
+	return !expiration.Before(l.StartInclusive) && expiration.Before(l.EndExclusive)
+}
+
+// List is the set of logs in a log list.
+type List []Log
+
+// logListJSON, operatorJSON and logJSON are the parts of the v3 log list
+// schema which Boulder uses.
+type logListJSON struct {
+	Operators []operatorJSON `json:"operators"`
+}
+
+type operatorJSON struct {
+	Name string    `json:"name"`
+	Logs []logJSON `json:"logs"`
+}
+
+type logJSON struct {
+	Description      string                     `json:"description"`
+	LogID            string                     `json:"log_id"`
+	Key              string                     `json:"key"`
+	URL              string                     `json:"url"`
+	MMD              int64                      `json:"mmd"`
+	State            map[string]json.RawMessage `json:"state"`
+	TemporalInterval *struct {
+		StartInclusive time.Time `json:"start_inclusive"`
+		EndExclusive   time.Time `json:"end_exclusive"`
+	} `json:"temporal_interval"`
+}
+
+// Parse parses a log list in the v3 JSON format, checking that each log's ID
+// matches its key.
+func Parse(contents []byte) (List, error) {
+	var parsed logListJSON
+	err := json.Unmarshal(contents, &parsed)
+	if err != nil {
+		return nil, fmt.Errorf("parsing log list: %w", err)
+	}
+	var list List
+	for _, operator := range parsed.Operators {
+		for _, l := range operator.Logs {
+			log, err := parseLog(operator.Name, l)
+			if err != nil {
+				return nil, fmt.Errorf("log %q: %w", l.Description, err)
+			}
+			list = append(list, log)
+		}
+	}
+	if len(list) == 0 {
+		return nil, errors.New("log list contains no logs")
+	}
+	return list, nil
+}
+
+func parseLog(operator string, l logJSON) (Log, error) {
+	if operator == "" {
+		return Log{}, errors.New("operator has no name")
+	}
+	if l.URL == "" {
+		return Log{}, errors.New("log has no URL")
+	}
+	der, err := base64.StdEncoding.DecodeString(l.Key)
+	if err != nil {
+		return Log{}, fmt.Errorf("decoding key: %w", err)
+	}
+	id := sha256.Sum256(der)
+	if base64.StdEncoding.EncodeToString(id[:]) != l.LogID {
+		return Log{}, errors.New("log_id doesn't match key")
+	}
+	if len(l.State) != 1 {
+		return Log{}, errors.New("log must have exactly one state")
+	}
+	var state State
+	for name := range l.State {
+		state, err = ParseState(name)
+		if err != nil {
+			return Log{}, err
+		}
+	}
+	log := Log{
+		Operator:    operator,
+		Description: l.Description,
+		ID:          l.LogID,
+		Key:         l.Key,
+		URL:         l.URL,
+		MMD:         time.Duration(l.MMD) * time.Second,
+		State:       state,
+	}
+	if l.TemporalInterval != nil {
+		if !l.TemporalInterval.StartInclusive.Before(l.TemporalInterval.EndExclusive) {
+			return Log{}, errors.New("temporal interval must start before it ends")
+		}
+		log.StartInclusive = l.TemporalInterval.StartInclusive
+		log.EndExclusive = l.TemporalInterval.EndExclusive
+	}
+	return log, nil
+}
+
+// InStates returns the logs in the list which are in any of the given states.
+func (list List) InStates(states ...State) List {
+	var filtered List
+	for _, l := range list {
+		for _, state := range states {
+			if l.State == state {
+				filtered = append(filtered, l)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// ForTime returns the logs in the list which accept certificates expiring at
+// the given time.
+func (list List) ForTime(expiration time.Time) List {
+	var filtered List
+	for _, l := range list {
+		if l.Accepts(expiration) {
+			filtered = append(filtered, l)
+		}
+	}
+	return filtered
+}
+
+// Find returns the log in the list with the given base64 key.
+func (list List) Find(key string) (Log, bool) {
+	for _, l := range list {
+		if l.Key == key {
+			return l, true
+		}
+	}
+	return Log{}, false
+}
+
+// FindByID returns the log in the list with the given base64 log ID.
+func (list List) FindByID(id string) (Log, bool) {
+	for _, l := range list {
+		if l.ID == id {
+			return l, true
+		}
+	}
+	return Log{}, false
+}
+
+// Groups returns one CT group per operator, named after the operator and
+// containing all of its logs in the list, sorted by operator name.
+func (list List) Groups(stagger time.Duration) []ctconfig.CTGroup {
+	byOperator := make(map[string][]ctconfig.LogDescription)
+	for _, l := range list {
+		byOperator[l.Operator] = append(byOperator[l.Operator], ctconfig.LogDescription{
+			URI: l.URL,
+			Key: l.Key,
+		})
+	}
+	operators := make([]string, 0, len(byOperator))
+	for operator := range byOperator {
+		operators = append(operators, operator)
+	}
+	sort.Strings(operators)
+	groups := make([]ctconfig.CTGroup, len(operators))
+	for i, operator := range operators {
+		groups[i] = ctconfig.CTGroup{
+			Name:    operator,
+			Logs:    byOperator[operator],
+			Stagger: cmd.ConfigDuration{Duration: stagger},
+		}
+	}
+	return groups
+}
+
+// String returns a short description of the list for logging.
+func (list List) String() string {
+	descriptions := make([]string, len(list))
+	for i, l := range list {
+		descriptions[i] = fmt.Sprintf("%s (%s)", l.Description, l.State)
+	}
+	return strings.Join(descriptions, ", ")
+}
@@ -0,0 +1,190 @@
+package loglist
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/ctpolicy/ctconfig"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// testLog returns the JSON for a log with a freshly generated key, and that
+// key in base64.
+func testLog(t *testing.T, url string, state string) (map[string]interface{}, string) {
+	t.Helper()
+	k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating key")
+	der, err := x509.MarshalPKIXPublicKey(&k.PublicKey)
+	test.AssertNotError(t, err, "marshalling key")
+	id := sha256.Sum256(der)
+	key := base64.StdEncoding.EncodeToString(der)
+	return map[string]interface{}{
+		"description": url + " log",
+		"log_id":      base64.StdEncoding.EncodeToString(id[:]),
+		"key":         key,
+		"url":         url,
+		"mmd":         86400,
+		"state":       map[string]interface{}{state: map[string]string{"timestamp": "2020-01-01T00:00:00Z"}},
+	}, key
+}
+
+func marshalList(t *testing.T, operators map[string][]map[string]interface{}) []byte {
+	t.Helper()
+	var list struct {
+		Operators []map[string]interface{} `json:"operators"`
+	}
+	for name, logs := range operators {
+		list.Operators = append(list.Operators, map[string]interface{}{
+			"name": name,
+			"logs": logs,
+		})
+	}
+	contents, err := json.Marshal(list)
+	test.AssertNotError(t, err, "marshalling log list")
+	return contents
+}
+
+func TestParse(t *testing.T) {
+	a1, a1Key := testLog(t, "https://a1.example.com/", "usable")
+	a2, _ := testLog(t, "https://a2.example.com/", "retired")
+	b1, b1Key := testLog(t, "https://b1.example.com/", "qualified")
+	b1["temporal_interval"] = map[string]string{
+		"start_inclusive": "2026-01-01T00:00:00Z",
+		"end_exclusive":   "2027-01-01T00:00:00Z",
+	}
+
+	list, err := Parse(marshalList(t, map[string][]map[string]interface{}{
+		"A": {a1, a2},
+		"B": {b1},
+	}))
+	test.AssertNotError(t, err, "parsing valid log list")
+	test.AssertEquals(t, len(list), 3)
+
+	l, ok := list.Find(a1Key)
+	test.Assert(t, ok, "log a1 not found")
+	test.AssertEquals(t, l.Operator, "A")
+	test.AssertEquals(t, l.URL, "https://a1.example.com/")
+	test.AssertEquals(t, l.State, Usable)
+	test.AssertEquals(t, l.MMD, 24*time.Hour)
+	test.Assert(t, l.Accepts(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)), "unsharded log should accept any expiration")
+
+	l, ok = list.Find(b1Key)
+	test.Assert(t, ok, "log b1 not found")
+	test.AssertEquals(t, l.State, Qualified)
+	test.Assert(t, l.Accepts(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)), "shard should include its start")
+	test.Assert(t, !l.Accepts(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)), "shard should exclude its end")
+
+	_, ok = list.Find("nope")
+	test.Assert(t, !ok, "found a log which isn't in the list")
+
+	l, ok = list.FindByID(b1["log_id"].(string))
+	test.Assert(t, ok, "log b1 not found by ID")
+	test.AssertEquals(t, l.Key, b1Key)
+}
+
+func TestParseErrors(t *testing.T) {
+	_, err := Parse([]byte("{"))
+	test.AssertError(t, err, "parsed invalid JSON")
+
+	_, err = Parse(marshalList(t, nil))
+	test.AssertError(t, err, "parsed list with no logs")
+
+	l, _ := testLog(t, "https://a.example.com/", "usable")
+	l["log_id"] = base64.StdEncoding.EncodeToString(make([]byte, 32))
+	_, err = Parse(marshalList(t, map[string][]map[string]interface{}{"A": {l}}))
+	test.AssertError(t, err, "parsed log with mismatched ID")
+
+	l, _ = testLog(t, "https://a.example.com/", "bogus")
+	_, err = Parse(marshalList(t, map[string][]map[string]interface{}{"A": {l}}))
+	test.AssertError(t, err, "parsed log with unknown state")
+
+	l, _ = testLog(t, "https://a.example.com/", "usable")
+	l["state"] = map[string]interface{}{}
+	_, err = Parse(marshalList(t, map[string][]map[string]interface{}{"A": {l}}))
+	test.AssertError(t, err, "parsed log with no state")
+
+	l, _ = testLog(t, "https://a.example.com/", "usable")
+	l["temporal_interval"] = map[string]string{
+		"start_inclusive": "2027-01-01T00:00:00Z",
+		"end_exclusive":   "2026-01-01T00:00:00Z",
+	}
+	_, err = Parse(marshalList(t, map[string][]map[string]interface{}{"A": {l}}))
+	test.AssertError(t, err, "parsed log with backwards temporal interval")
+}
+
+func TestFiltersAndGroups(t *testing.T) {
+	shardStart := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	shardEnd := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	list := List{
+		{Operator: "B", URL: "b1", Key: "kb1", State: Usable},
+		{Operator: "A", URL: "a1", Key: "ka1", State: Qualified, StartInclusive: shardStart, EndExclusive: shardEnd},
+		{Operator: "A", URL: "a2", Key: "ka2", State: Usable},
+		{Operator: "C", URL: "c1", Key: "kc1", State: ReadOnly},
+	}
+
+	usable := list.InStates(Usable, Qualified)
+	test.AssertEquals(t, len(usable), 3)
+
+	test.AssertDeepEquals(t, usable.ForTime(shardEnd).Groups(time.Second), []ctconfig.CTGroup{
+		{
+			Name:    "A",
+			Stagger: cmd.ConfigDuration{Duration: time.Second},
+			Logs:    []ctconfig.LogDescription{{URI: "a2", Key: "ka2"}},
+		},
+		{
+			Name:    "B",
+			Stagger: cmd.ConfigDuration{Duration: time.Second},
+			Logs:    []ctconfig.LogDescription{{URI: "b1", Key: "kb1"}},
+		},
+	})
+
+	groups := usable.ForTime(shardStart).Groups(0)
+	test.AssertEquals(t, len(groups), 2)
+	test.AssertEquals(t, groups[0].Name, "A")
+	test.AssertDeepEquals(t, groups[0].Logs, []ctconfig.LogDescription{
+		{URI: "a1", Key: "ka1"},
+		{URI: "a2", Key: "ka2"},
+	})
+}
+
+func TestSource(t *testing.T) {
+	a1, a1Key := testLog(t, "https://a1.example.com/", "usable")
+	a2, a2Key := testLog(t, "https://a2.example.com/", "retired")
+	f, err := ioutil.TempFile("", "log-list.json")
+	test.AssertNotError(t, err, "creating temp file")
+	defer os.Remove(f.Name())
+	_, err = f.Write(marshalList(t, map[string][]map[string]interface{}{"A": {a1, a2}}))
+	test.AssertNotError(t, err, "writing log list")
+	f.Close()
+
+	_, err = NewSourceFromConfig(ctconfig.LogListConfig{File: f.Name(), States: []string{"bogus"}}, blog.NewMock())
+	test.AssertError(t, err, "loaded log list with unknown state")
+
+	_, err = NewSourceFromConfig(ctconfig.LogListConfig{File: f.Name(), States: []string{"qualified"}}, blog.NewMock())
+	test.AssertError(t, err, "loaded log list with no logs in the configured states")
+
+	source, err := NewSourceFromConfig(ctconfig.LogListConfig{File: f.Name()}, blog.NewMock())
+	test.AssertNotError(t, err, "loading log list")
+	test.AssertEquals(t, len(source.All()), 2)
+	test.AssertEquals(t, len(source.List()), 1)
+	_, ok := source.List().Find(a1Key)
+	test.Assert(t, ok, "usable log missing from list")
+	_, ok = source.All().Find(a2Key)
+	test.Assert(t, ok, "retired log missing from all logs")
+
+	// A bad reload keeps the previous list.
+	err = source.load([]byte("{"))
+	test.AssertError(t, err, "loaded invalid log list")
+	test.AssertEquals(t, len(source.List()), 1)
+}
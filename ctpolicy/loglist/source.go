@@ -0,0 +1,113 @@
+package loglist
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/letsencrypt/boulder/ctpolicy/ctconfig"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/reloader"
+)
+
+// Source holds the logs from a log list file which are in a chosen set of
+// states, and reloads them whenever the file changes.
+type Source struct {
+	sync.RWMutex
+	all    List
+	list   List
+	states []State
+	log    blog.Logger
+}
+
+// NewSource loads the logs in the given states from the log list at
+// filename, and watches it for changes. It returns an error if the file
+// can't be loaded, or if it has no logs in those states. Later failures to
+// reload the file are logged, and the previous list is kept.
+func NewSource(filename string, states []State, logger blog.Logger) (*Source, error) {
+	if len(states) == 0 {
+		return nil, errors.New("no log states specified")
+	}
+	s := &Source{
+		states: states,
+		log:    logger,
+	}
+	_, err := reloader.New(filename, s.load, s.loadError)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// NewSourceFromConfig calls NewSource with the file and states from a
+// LogListConfig, using only usable logs if no states are configured.
+func NewSourceFromConfig(c ctconfig.LogListConfig, logger blog.Logger) (*Source, error) {
+	if c.File == "" {
+		return nil, errors.New("no log list file specified")
+	}
+	states := []State{Usable}
+	if len(c.States) > 0 {
+		states = make([]State, len(c.States))
+		for i, name := range c.States {
+			state, err := ParseState(name)
+			if err != nil {
+				return nil, err
+			}
+			states[i] = state
+		}
+	}
+	return NewSource(c.File, states, logger)
+}
+
+// load is a callback suitable for use with reloader.New() that parses a log
+// list and replaces the current one with it.
+func (s *Source) load(contents []byte) error {
+	hash := sha256.Sum256(contents)
+	s.log.Infof("loading CT log list, sha256: %s", hex.EncodeToString(hash[:]))
+	all, err := Parse(contents)
+	if err != nil {
+		return err
+	}
+	list := all.InStates(s.states...)
+	if len(list) == 0 {
+		return fmt.Errorf("log list has no logs in states %v", s.states)
+	}
+	s.Lock()
+	s.all = all
+	s.list = list
+	s.Unlock()
+	s.log.Infof("loaded CT logs: %s", list)
+	return nil
+}
+
+func (s *Source) loadError(err error) {
+	s.log.AuditErrf("error reloading CT log list: %s", err)
+}
+
+// List returns the logs currently loaded which are in the Source's states.
+// The caller must not modify it.
+func (s *Source) List() List {
+	s.RLock()
+	defer s.RUnlock()
+	return s.list
+}
+
+// All returns every log currently loaded, whatever its state. The caller
+// must not modify it.
+func (s *Source) All() List {
+	s.RLock()
+	defer s.RUnlock()
+	return s.all
+}
+
+// NewStaticSource returns a Source holding the given logs which never
+// reloads. It's intended for tests.
+func NewStaticSource(all List, states ...State) *Source {
+	return &Source{
+		all:    all,
+		list:   all.InStates(states...),
+		states: states,
+	}
+}
@@ -0,0 +1,297 @@
+package ctpolicy
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/letsencrypt/boulder/canceled"
+	"github.com/letsencrypt/boulder/core"
+	berrors "github.com/letsencrypt/boulder/errors"
+	pubpb "github.com/letsencrypt/boulder/publisher/proto"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Rule is one of the requirements of a browser's CT policy: certificates
+// whose lifetime is no longer than MaxLifetime must have at least SCTs SCTs,
+// from logs run by at least Operators distinct operators.
+type Rule struct {
+	Policy string
+	// MaxLifetime is zero for a rule which applies to certificates of any
+	// lifetime.
+	MaxLifetime time.Duration
+	SCTs        int
+	Operators   int
+}
+
+func (r Rule) String() string {
+	lifetime := "any lifetime"
+	if r.MaxLifetime != 0 {
+		lifetime = fmt.Sprintf("lifetime <= %d days", r.MaxLifetime/(24*time.Hour))
+	}
+	return fmt.Sprintf("%s: %s: %d SCTs from %d operators", r.Policy, lifetime, r.SCTs, r.Operators)
+}
+
+// browserPolicies are the rules of the Chrome and Apple CT policies for
+// embedded SCTs. Each policy's rules are in order of increasing MaxLifetime,
+// with a final rule covering any lifetime.
+//
+// https://googlechrome.github.io/CertificateTransparency/ct_policy.html
+// https://support.apple.com/en-us/HT205280
+var browserPolicies = [][]Rule{
+	{
+		{Policy: "Chrome", MaxLifetime: 180 * 24 * time.Hour, SCTs: 2, Operators: 2},
+		{Policy: "Chrome", SCTs: 3, Operators: 2},
+	},
+	{
+		{Policy: "Apple", MaxLifetime: 180 * 24 * time.Hour, SCTs: 2, Operators: 2},
+		{Policy: "Apple", SCTs: 3, Operators: 2},
+	},
+}
+
+// Requirements returns the rule of each browser policy which applies to a
+// certificate with the given validity period.
+func Requirements(notBefore, notAfter time.Time) []Rule {
+	// The validity period is inclusive of both NotBefore and NotAfter.
+	lifetime := notAfter.Sub(notBefore) + time.Second
+	var rules []Rule
+	for _, policy := range browserPolicies {
+		for _, rule := range policy {
+			if rule.MaxLifetime == 0 || lifetime <= rule.MaxLifetime {
+				rules = append(rules, rule)
+				break
+			}
+		}
+	}
+	return rules
+}
+
+// operatorSCT is an SCT along with the operator of the log which issued it.
+type operatorSCT struct {
+	sct      []byte
+	log      string
+	operator string
+}
+
+// policyResult is the outcome of a single submission by GetCompliantSCTs.
+type policyResult struct {
+	operatorSCT
+	err error
+}
+
+// satisfies returns true if the SCTs meet the rule.
+func (r Rule) satisfies(scts []operatorSCT) bool {
+	operators := make(map[string]bool)
+	for _, s := range scts {
+		operators[s.operator] = true
+	}
+	return len(scts) >= r.SCTs && len(operators) >= r.Operators
+}
+
+// satisfiesAll returns true if the SCTs meet every one of the rules.
+func satisfiesAll(scts []operatorSCT, rules []Rule) bool {
+	for _, rule := range rules {
+		if !rule.satisfies(scts) {
+			return false
+		}
+	}
+	return true
+}
+
+// candidate is a log which may be submitted to, along with its operator.
+type candidate struct {
+	uri      string
+	key      string
+	operator string
+}
+
+// candidates returns the logs which accept certificates expiring at the given
+// time, and how long to wait between submissions to them. When a log list is
+// in use, each log's operator is taken from the list; otherwise each
+// configured group is treated as a separate operator.
+func (ctp *CTPolicy) candidates(expiration time.Time) ([]candidate, time.Duration, error) {
+	groups, err := ctp.groupsFor(expiration)
+	if err != nil {
+		return nil, 0, err
+	}
+	var logs []candidate
+	var stagger time.Duration
+	for _, g := range groups {
+		if g.Stagger.Duration > stagger {
+			stagger = g.Stagger.Duration
+		}
+		for _, ld := range g.Logs {
+			uri, key, err := ld.Info(expiration)
+			if err != nil {
+				ctp.log.Errf("unable to get log info: %s", err)
+				continue
+			}
+			logs = append(logs, candidate{uri: uri, key: key, operator: g.Name})
+		}
+	}
+	return logs, stagger, nil
+}
+
+// submissionOrder shuffles the logs, and then interleaves them by operator so
+// that the first submissions go to as many distinct operators as possible.
+func submissionOrder(logs []candidate) []candidate {
+	byOperator := make(map[string][]candidate)
+	var operators []string
+	for _, i := range rand.Perm(len(logs)) {
+		l := logs[i]
+		if _, ok := byOperator[l.operator]; !ok {
+			operators = append(operators, l.operator)
+		}
+		byOperator[l.operator] = append(byOperator[l.operator], l)
+	}
+	order := make([]candidate, 0, len(logs))
+	for len(order) < len(logs) {
+		for _, operator := range operators {
+			if len(byOperator[operator]) > 0 {
+				order = append(order, byOperator[operator][0])
+				byOperator[operator] = byOperator[operator][1:]
+			}
+		}
+	}
+	return order
+}
+
+// selectSCTs returns the smallest prefix of a selection of the SCTs, taking
+// one from each operator first, which meets all of the rules.
+func selectSCTs(scts []operatorSCT, rules []Rule) []operatorSCT {
+	var firsts, rest []operatorSCT
+	seen := make(map[string]bool)
+	for _, s := range scts {
+		if seen[s.operator] {
+			rest = append(rest, s)
+			continue
+		}
+		seen[s.operator] = true
+		firsts = append(firsts, s)
+	}
+	ordered := append(firsts, rest...)
+	for i := range ordered {
+		if satisfiesAll(ordered[:i+1], rules) {
+			return ordered[:i+1]
+		}
+	}
+	return ordered
+}
+
+// GetCompliantSCTs submits a precertificate to logs across all operators
+// which accept it, and keeps collecting SCTs until it has enough to satisfy
+// the rule of each browser CT policy which applies to the certificate's
+// validity period. It returns the SCTs along with the rules they satisfy.
+//
+// Submissions are made to as many distinct operators as there are SCTs
+// required, and one more log is tried whenever a submission fails, whenever
+// a response leaves the policies unsatisfied with nothing else outstanding,
+// or, if a stagger is set, each time it elapses.
+func (ctp *CTPolicy) GetCompliantSCTs(ctx context.Context, cert core.CertDER, notBefore, expiration time.Time) (core.SCTDERs, []Rule, error) {
+	rules := Requirements(notBefore, expiration)
+	scts, err := ctp.collectSCTs(ctx, cert, expiration, rules)
+	if err != nil {
+		return nil, nil, err
+	}
+	return scts, rules, nil
+}
+
+// collectSCTs submits a precertificate to the logs which accept it until the
+// SCTs it gets back satisfy all of the rules.
+func (ctp *CTPolicy) collectSCTs(ctx context.Context, cert core.CertDER, expiration time.Time, rules []Rule) (core.SCTDERs, error) {
+	needed := 0
+	for _, rule := range rules {
+		if rule.SCTs > needed {
+			needed = rule.SCTs
+		}
+	}
+	logs, stagger, err := ctp.candidates(expiration)
+	if err != nil {
+		return nil, err
+	}
+	order := submissionOrder(logs)
+
+	ctp.submitInformational(cert, expiration)
+
+	subCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan policyResult, len(order))
+	next := 0
+	outstanding := 0
+	launch := func() {
+		if next >= len(order) {
+			return
+		}
+		l := order[next]
+		next++
+		outstanding++
+		go func() {
+			sct, err := ctp.pub.SubmitToSingleCTWithResult(subCtx, &pubpb.Request{
+				LogURL:       l.uri,
+				LogPublicKey: l.key,
+				Der:          cert,
+				Precert:      true,
+			})
+			res := policyResult{operatorSCT: operatorSCT{log: l.uri, operator: l.operator}, err: err}
+			if err == nil {
+				res.sct = sct.Sct
+			}
+			results <- res
+		}()
+	}
+	for i := 0; i < needed; i++ {
+		launch()
+	}
+	if stagger == 0 {
+		for next < len(order) {
+			launch()
+		}
+	}
+	var tick <-chan time.Time
+	if stagger > 0 {
+		ticker := time.NewTicker(stagger)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	var scts []operatorSCT
+	for outstanding > 0 {
+		select {
+		case <-ctx.Done():
+			ctp.policyCounter.With(prometheus.Labels{"rule": "timeout"}).Inc()
+			return nil, ctx.Err()
+		case <-tick:
+			launch()
+		case res := <-results:
+			outstanding--
+			if res.err != nil {
+				if !canceled.Is(res.err) {
+					ctp.log.Warningf("ct submission to %q failed: %s", res.log, res.err)
+				}
+				launch()
+				continue
+			}
+			ctp.winnerCounter.With(prometheus.Labels{"log": res.log, "group": res.operator}).Inc()
+			scts = append(scts, res.operatorSCT)
+			selected := selectSCTs(scts, rules)
+			if satisfiesAll(selected, rules) {
+				ret := make(core.SCTDERs, len(selected))
+				for i, s := range selected {
+					ret[i] = s.sct
+				}
+				for _, rule := range rules {
+					ctp.policyCounter.With(prometheus.Labels{"rule": rule.String()}).Inc()
+				}
+				// Returning triggers the defer'd context cancellation method.
+				return ret, nil
+			}
+			if outstanding == 0 {
+				launch()
+			}
+		}
+	}
+	ctp.policyCounter.With(prometheus.Labels{"rule": "unsatisfied"}).Inc()
+	return nil, berrors.MissingSCTsError(
+		"got %d SCTs from %d logs, which doesn't satisfy the CT policy requirements: %v", len(scts), len(order), rules)
+}
@@ -0,0 +1,162 @@
+package ctpolicy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/letsencrypt/boulder/ctpolicy/ctconfig"
+	berrors "github.com/letsencrypt/boulder/errors"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	pubpb "github.com/letsencrypt/boulder/publisher/proto"
+	"github.com/letsencrypt/boulder/test"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+)
+
+// A mock publisher which returns the log URL as the SCT, and fails for the
+// given logs
+type urlSCTs struct {
+	bad map[string]bool
+}
+
+func (us *urlSCTs) SubmitToSingleCTWithResult(_ context.Context, req *pubpb.Request, _ ...grpc.CallOption) (*pubpb.Result, error) {
+	if us.bad[req.LogURL] {
+		return nil, errors.New("BAD")
+	}
+	return &pubpb.Result{Sct: []byte(req.LogURL)}, nil
+}
+
+func TestRequirements(t *testing.T) {
+	notBefore := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	rules := Requirements(notBefore, notBefore.Add(90*24*time.Hour-time.Second))
+	test.AssertEquals(t, len(rules), 2)
+	test.AssertEquals(t, rules[0].Policy, "Chrome")
+	test.AssertEquals(t, rules[1].Policy, "Apple")
+	for _, rule := range rules {
+		test.AssertEquals(t, rule.SCTs, 2)
+		test.AssertEquals(t, rule.Operators, 2)
+	}
+
+	// Exactly 180 days, inclusive of both ends, is still a short lifetime.
+	rules = Requirements(notBefore, notBefore.Add(180*24*time.Hour-time.Second))
+	test.AssertEquals(t, rules[0].SCTs, 2)
+	rules = Requirements(notBefore, notBefore.Add(180*24*time.Hour))
+	test.AssertEquals(t, rules[0].SCTs, 3)
+	test.AssertEquals(t, rules[1].SCTs, 3)
+
+	test.AssertEquals(t, rules[0].String(), "Chrome: any lifetime: 3 SCTs from 2 operators")
+	test.AssertEquals(t, Requirements(notBefore, notBefore)[1].String(), "Apple: lifetime <= 180 days: 2 SCTs from 2 operators")
+}
+
+func TestSelectSCTs(t *testing.T) {
+	rules := []Rule{{Policy: "Test", SCTs: 2, Operators: 2}}
+	selected := selectSCTs([]operatorSCT{
+		{log: "a1", operator: "A"},
+		{log: "a2", operator: "A"},
+		{log: "b1", operator: "B"},
+	}, rules)
+	test.AssertDeepEquals(t, selected, []operatorSCT{
+		{log: "a1", operator: "A"},
+		{log: "b1", operator: "B"},
+	})
+}
+
+func TestGetCompliantSCTs(t *testing.T) {
+	groups := []ctconfig.CTGroup{
+		{
+			Name: "A",
+			Logs: []ctconfig.LogDescription{
+				{URI: "a1", Key: "ka1"},
+				{URI: "a2", Key: "ka2"},
+			},
+		},
+		{
+			Name: "B",
+			Logs: []ctconfig.LogDescription{
+				{URI: "b1", Key: "kb1"},
+			},
+		},
+	}
+	operators := map[string]string{"a1": "A", "a2": "A", "b1": "B"}
+	notBefore := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	short := notBefore.Add(90 * 24 * time.Hour)
+	long := notBefore.Add(365 * 24 * time.Hour)
+
+	testCases := []struct {
+		name       string
+		bad        map[string]bool
+		notAfter   time.Time
+		scts       int
+		errMessage string
+	}{
+		{
+			name:     "short lifetime",
+			notAfter: short,
+			scts:     2,
+		},
+		{
+			name:     "long lifetime",
+			notAfter: long,
+			scts:     3,
+		},
+		{
+			name:     "short lifetime with one failure",
+			bad:      map[string]bool{"a1": true},
+			notAfter: short,
+			scts:     2,
+		},
+		{
+			name:       "long lifetime with one failure",
+			bad:        map[string]bool{"a1": true},
+			notAfter:   long,
+			errMessage: "got 2 SCTs from 3 logs",
+		},
+		{
+			name:       "only one operator",
+			bad:        map[string]bool{"b1": true},
+			notAfter:   short,
+			errMessage: "got 2 SCTs from 3 logs",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctp := New(&urlSCTs{bad: tc.bad}, groups, nil, blog.NewMock(), metrics.NoopRegisterer)
+			scts, rules, err := ctp.GetCompliantSCTs(context.Background(), []byte{0}, notBefore, tc.notAfter)
+			if tc.errMessage != "" {
+				test.AssertError(t, err, "GetCompliantSCTs succeeded")
+				test.AssertErrorIs(t, err, berrors.MissingSCTs)
+				test.AssertContains(t, err.Error(), tc.errMessage)
+				test.AssertEquals(t, test.CountCounter(ctp.policyCounter.With(prometheus.Labels{"rule": "unsatisfied"})), 1)
+				return
+			}
+			test.AssertNotError(t, err, "GetCompliantSCTs failed")
+			test.AssertEquals(t, len(scts), tc.scts)
+			test.AssertDeepEquals(t, rules, Requirements(notBefore, tc.notAfter))
+			seen := make(map[string]bool)
+			for _, sct := range scts {
+				seen[operators[string(sct)]] = true
+			}
+			test.AssertEquals(t, len(seen), 2)
+			for _, rule := range rules {
+				test.AssertEquals(t, test.CountCounter(ctp.policyCounter.With(prometheus.Labels{"rule": rule.String()})), 1)
+			}
+		})
+	}
+}
+
+func TestGetCompliantSCTsTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	ctp := New(&slowPublisher{}, []ctconfig.CTGroup{
+		{Name: "A", Logs: []ctconfig.LogDescription{{URI: "a1", Key: "ka1"}}},
+		{Name: "B", Logs: []ctconfig.LogDescription{{URI: "b1", Key: "kb1"}}},
+	}, nil, blog.NewMock(), metrics.NoopRegisterer)
+	notBefore := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, _, err := ctp.GetCompliantSCTs(ctx, []byte{0}, notBefore, notBefore.Add(90*24*time.Hour))
+	test.AssertError(t, err, "GetCompliantSCTs succeeded")
+	test.AssertEquals(t, test.CountCounter(ctp.policyCounter.With(prometheus.Labels{"rule": "timeout"})), 1)
+}
@@ -113,6 +113,10 @@ func (m *WrappedMap) Exec(query string, args ...interface{}) (sql.Result, error)
 	return WrappedExecutor{SqlExecutor: m.DbMap}.Exec(query, args...)
 }
 
+func (m *WrappedMap) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return WrappedExecutor{SqlExecutor: m.DbMap}.Query(query, args...)
+}
+
 func (m *WrappedMap) WithContext(ctx context.Context) gorp.SqlExecutor {
 	return WrappedExecutor{SqlExecutor: m.DbMap.WithContext(ctx)}
 }
@@ -177,6 +181,10 @@ func (tx WrappedTransaction) Exec(query string, args ...interface{}) (sql.Result
 	return (WrappedExecutor{SqlExecutor: tx.Transaction}).Exec(query, args...)
 }
 
+func (tx WrappedTransaction) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return (WrappedExecutor{SqlExecutor: tx.Transaction}).Query(query, args...)
+}
+
 // WrappedExecutor wraps a gorp.SqlExecutor such that its major functions
 // wrap error results in ErrDatabaseOp instances before returning them to the
 // caller.
@@ -306,3 +314,14 @@ func (we WrappedExecutor) Exec(query string, args ...interface{}) (sql.Result, e
 	}
 	return res, nil
 }
+
+// Query runs a query and returns its rows for the caller to iterate over, for
+// results which are too large to Select into memory at once. Errors which
+// occur while iterating are returned unwrapped by the rows themselves.
+func (we WrappedExecutor) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	rows, err := we.SqlExecutor.Query(query, args...)
+	if err != nil {
+		return rows, errForQuery(query, "query", err, nil)
+	}
+	return rows, nil
+}
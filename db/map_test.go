@@ -324,6 +324,18 @@ func TestWrappedMap(t *testing.T) {
 		test.AssertEquals(t, dbOpErr.Op, "exec")
 		test.AssertEquals(t, dbOpErr.Table, "whatever")
 		test.AssertError(t, dbOpErr.Err, "expected non-nil underlying err")
+
+		// Test wrapped Query
+		querier, ok := dbMap.(interface {
+			Query(string, ...interface{}) (*sql.Rows, error)
+		})
+		test.Assert(t, ok, "wrapper doesn't implement Query")
+		_, err = querier.Query("SELECT contact FROM doesNotExist WHERE id=1;")
+		test.AssertError(t, err, "expected err Querying bad query")
+		dbOpErr = mustDbErr(err)
+		test.AssertEquals(t, dbOpErr.Op, "query")
+		test.AssertEquals(t, dbOpErr.Table, "doesNotExist")
+		test.AssertError(t, dbOpErr.Err, "expected non-nil underlying err")
 	}
 
 	// Create a test wrapped map. It won't have a type converted registered.
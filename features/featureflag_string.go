@@ -24,11 +24,21 @@ func _() {
 	_ = x[FasterNewOrdersRateLimit-13]
 	_ = x[NonCFSSLSigner-14]
 	_ = x[ECDSAForAll-15]
+	_ = x[ServeRenewalInfo-16]
+	_ = x[PreAuthorization-17]
+	_ = x[IPIdentifiers-18]
+	_ = x[StoreCertificateProfileName-19]
+	_ = x[StoreOrderValidity-20]
+	_ = x[StoreCRLShard-21]
+	_ = x[RevocationEventQueue-22]
+	_ = x[OperatorDiverseSCTs-23]
+	_ = x[FinalCertCTQueue-24]
+	_ = x[ExternalAccountBindings-25]
 }
 
-const _FeatureFlag_name = "unusedPrecertificateRevocationStripDefaultSchemePortCAAValidationMethodsCAAAccountURIEnforceMultiVAMultiVAFullResultsMandatoryPOSTAsGETAllowV1RegistrationV1DisableNewValidationsStoreIssuerInfoStoreRevokerInfoRestrictRSAKeySizesFasterNewOrdersRateLimitNonCFSSLSignerECDSAForAll"
+const _FeatureFlag_name = "unusedPrecertificateRevocationStripDefaultSchemePortCAAValidationMethodsCAAAccountURIEnforceMultiVAMultiVAFullResultsMandatoryPOSTAsGETAllowV1RegistrationV1DisableNewValidationsStoreIssuerInfoStoreRevokerInfoRestrictRSAKeySizesFasterNewOrdersRateLimitNonCFSSLSignerECDSAForAllServeRenewalInfoPreAuthorizationIPIdentifiersStoreCertificateProfileNameStoreOrderValidityStoreCRLShardRevocationEventQueueOperatorDiverseSCTsFinalCertCTQueueExternalAccountBindings"
 
-var _FeatureFlag_index = [...]uint16{0, 6, 30, 52, 72, 85, 99, 117, 135, 154, 177, 192, 208, 227, 251, 265, 276}
+var _FeatureFlag_index = [...]uint16{0, 6, 30, 52, 72, 85, 99, 117, 135, 154, 177, 192, 208, 227, 251, 265, 276, 292, 308, 321, 348, 366, 379, 399, 418, 434, 457}
 
 func (i FeatureFlag) String() string {
 	if i < 0 || i >= FeatureFlag(len(_FeatureFlag_index)-1) {
@@ -52,26 +52,71 @@ const (
 	// ECDSAForAll enables all accounts, regardless of their presence in the CA's
 	// ecdsaAllowedAccounts config value, to get issuance from ECDSA issuers.
 	ECDSAForAll
+	// ServeRenewalInfo exposes the renewalInfo endpoint in the directory and for
+	// GET requests. WARNING: This feature is a draft and highly unstable.
+	ServeRenewalInfo
+	// PreAuthorization exposes the newAuthz endpoint in the directory, allowing
+	// ACMEv2 clients to create authorizations before placing an order.
+	PreAuthorization
+	// IPIdentifiers allows issuance for RFC 8738 IP address identifiers.
+	IPIdentifiers
+	// StoreCertificateProfileName enables storage of the certificate profile
+	// name requested by an order in the orders table. The RA refuses orders
+	// which request a profile unless it is enabled.
+	StoreCertificateProfileName
+	// StoreOrderValidity enables storage of the certificate validity period
+	// requested by an order in the orders table. The RA refuses orders which
+	// request a validity period unless it is enabled.
+	StoreOrderValidity
+	// StoreCRLShard enables storage of the CRL shard of each certificate in the
+	// certificateStatus table.
+	StoreCRLShard
+	// RevocationEventQueue enables the queue of revocation events which the
+	// SA writes when a certificate is revoked, and the ocsp-updater consumes
+	// to regenerate and purge the certificate's OCSP response.
+	RevocationEventQueue
+	// OperatorDiverseSCTs causes the RA to keep collecting SCTs from logs
+	// across operators until the Chrome and Apple CT policies are satisfied,
+	// rather than getting one SCT from each configured CT log group.
+	OperatorDiverseSCTs
+	// FinalCertCTQueue causes the RA to queue the submission of final
+	// certificates to CT logs in the SA, for the publisher to retry until they
+	// succeed, instead of submitting them once on a best-effort basis.
+	FinalCertCTQueue
+	// ExternalAccountBindings causes the SA to look up the external account
+	// binding key of each registration it returns in the externalAccountKeys
+	// table.
+	ExternalAccountBindings
 )
 
 // List of features and their default value, protected by fMu
 var features = map[FeatureFlag]bool{
-	unused:                   false,
-	CAAValidationMethods:     false,
-	CAAAccountURI:            false,
-	EnforceMultiVA:           false,
-	MultiVAFullResults:       false,
-	MandatoryPOSTAsGET:       false,
-	AllowV1Registration:      true,
-	V1DisableNewValidations:  false,
-	PrecertificateRevocation: false,
-	StripDefaultSchemePort:   false,
-	StoreIssuerInfo:          false,
-	StoreRevokerInfo:         false,
-	RestrictRSAKeySizes:      false,
-	FasterNewOrdersRateLimit: false,
-	NonCFSSLSigner:           false,
-	ECDSAForAll:              false,
+	unused:                      false,
+	CAAValidationMethods:        false,
+	CAAAccountURI:               false,
+	EnforceMultiVA:              false,
+	MultiVAFullResults:          false,
+	MandatoryPOSTAsGET:          false,
+	AllowV1Registration:         true,
+	V1DisableNewValidations:     false,
+	PrecertificateRevocation:    false,
+	StripDefaultSchemePort:      false,
+	StoreIssuerInfo:             false,
+	StoreRevokerInfo:            false,
+	RestrictRSAKeySizes:         false,
+	FasterNewOrdersRateLimit:    false,
+	NonCFSSLSigner:              false,
+	ECDSAForAll:                 false,
+	ServeRenewalInfo:            false,
+	PreAuthorization:            false,
+	IPIdentifiers:               false,
+	StoreCertificateProfileName: false,
+	StoreOrderValidity:          false,
+	StoreCRLShard:               false,
+	RevocationEventQueue:        false,
+	OperatorDiverseSCTs:         false,
+	FinalCertCTQueue:            false,
+	ExternalAccountBindings:     false,
 }
 
 var fMu = new(sync.RWMutex)
@@ -3,6 +3,7 @@ package goodkey
 import (
 	"context"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
@@ -164,6 +165,23 @@ func TestECDSAGoodKey(t *testing.T) {
 	}
 }
 
+func TestEd25519(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	test.AssertNotError(t, err, "Error generating key")
+
+	err = testingPolicy.GoodKey(context.Background(), pub)
+	test.AssertError(t, err, "Should have rejected Ed25519 key when not allowed")
+	test.AssertEquals(t, err.Error(), "Ed25519 keys are not allowed")
+
+	policy := *testingPolicy
+	policy.AllowEd25519 = true
+	test.AssertNotError(t, policy.GoodKey(context.Background(), pub), "Should have accepted good key")
+
+	err = policy.GoodKey(context.Background(), pub[:16])
+	test.AssertError(t, err, "Should have rejected truncated Ed25519 key")
+	test.AssertEquals(t, err.Error(), "Ed25519 key has wrong size 16, expected 32")
+}
+
 func TestECDSANotOnCurveX(t *testing.T) {
 	for _, curve := range validCurves {
 		// Change a public key so that it is no longer on the curve.
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -49,6 +50,12 @@ func wrapError(ctx context.Context, err error) error {
 			pairs = append(pairs, string(jsonSubErrs))
 		}
 
+		// If there is a retry-after duration then include it so that the WFE can
+		// tell the client when to try again.
+		if berr.RetryAfter > 0 {
+			pairs = append(pairs, "retryafter", berr.RetryAfter.String())
+		}
+
 		// Ignoring the error return here is safe because if setting the metadata
 		// fails, we'll still return an error, but it will be interpreted on the
 		// other side as an InternalServerError instead of a more specific one.
@@ -109,6 +116,26 @@ func unwrapError(err error, md metadata.MD) error {
 				)
 			}
 		}
+		if retryAfterStrs, ok := md["retryafter"]; ok {
+			if len(retryAfterStrs) != 1 {
+				return berrors.InternalServerError(
+					"multiple retryafter metadata, wrapped error %q",
+					unwrappedErr,
+				)
+			}
+			retryAfter, err := time.ParseDuration(retryAfterStrs[0])
+			if err != nil {
+				return berrors.InternalServerError(
+					"error parsing retryafter %q, wrapped error %q",
+					retryAfterStrs[0],
+					unwrappedErr,
+				)
+			}
+			var berr *berrors.BoulderError
+			if errors.As(outErr, &berr) {
+				berr.RetryAfter = retryAfter
+			}
+		}
 		return outErr
 	}
 	return err
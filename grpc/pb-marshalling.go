@@ -144,6 +144,7 @@ func ValidationRecordToPB(record core.ValidationRecord) (*corepb.ValidationRecor
 		AddressUsed:       addrUsed,
 		Url:               record.URL,
 		AddressesTried:    addrsTried,
+		Dnssec:            record.DNSSEC,
 	}, nil
 }
 
@@ -171,6 +172,7 @@ func PBToValidationRecord(in *corepb.ValidationRecord) (record core.ValidationRe
 		AddressUsed:       addrUsed,
 		URL:               in.Url,
 		AddressesTried:    addrsTried,
+		DNSSEC:            in.Dnssec,
 	}, nil
 }
 
@@ -230,14 +232,15 @@ func registrationToPB(reg core.Registration) (*corepb.Registration, error) {
 		contacts = *reg.Contact
 	}
 	return &corepb.Registration{
-		Id:              reg.ID,
-		Key:             keyBytes,
-		Contact:         contacts,
-		ContactsPresent: contactsPresent,
-		Agreement:       reg.Agreement,
-		InitialIP:       ipBytes,
-		CreatedAt:       reg.CreatedAt.UnixNano(),
-		Status:          string(reg.Status),
+		Id:                reg.ID,
+		Key:               keyBytes,
+		Contact:           contacts,
+		ContactsPresent:   contactsPresent,
+		Agreement:         reg.Agreement,
+		InitialIP:         ipBytes,
+		CreatedAt:         reg.CreatedAt.UnixNano(),
+		Status:            string(reg.Status),
+		ExternalAccountID: reg.ExternalAccountID,
 	}, nil
 }
 
@@ -267,13 +270,14 @@ func pbToRegistration(pb *corepb.Registration) (core.Registration, error) {
 		}
 	}
 	return core.Registration{
-		ID:        pb.Id,
-		Key:       &key,
-		Contact:   contacts,
-		Agreement: pb.Agreement,
-		InitialIP: initialIP,
-		CreatedAt: time.Unix(0, pb.CreatedAt),
-		Status:    core.AcmeStatus(pb.Status),
+		ID:                pb.Id,
+		Key:               &key,
+		Contact:           contacts,
+		Agreement:         pb.Agreement,
+		InitialIP:         initialIP,
+		CreatedAt:         time.Unix(0, pb.CreatedAt),
+		Status:            core.AcmeStatus(pb.Status),
+		ExternalAccountID: pb.ExternalAccountID,
 	}, nil
 }
 
@@ -312,7 +316,7 @@ func PBToAuthz(pb *corepb.Authorization) (core.Authorization, error) {
 	expires := time.Unix(0, pb.Expires).UTC()
 	authz := core.Authorization{
 		ID:             pb.Id,
-		Identifier:     identifier.ACMEIdentifier{Type: identifier.DNS, Value: pb.Identifier},
+		Identifier:     identifier.FromString(pb.Identifier),
 		RegistrationID: pb.RegistrationID,
 		Status:         core.AcmeStatus(pb.Status),
 		Expires:        &expires,
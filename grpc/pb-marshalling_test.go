@@ -106,6 +106,7 @@ func TestValidationRecord(t *testing.T) {
 		AddressUsed:       ip,
 		URL:               "url",
 		AddressesTried:    []net.IP{ip},
+		DNSSEC:            "secure",
 	}
 
 	pb, err := ValidationRecordToPB(vr)
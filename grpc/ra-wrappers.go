@@ -184,6 +184,17 @@ func (ras *RegistrationAuthorityClientWrapper) FinalizeOrder(ctx context.Context
 	return resp, nil
 }
 
+func (ras *RegistrationAuthorityClientWrapper) NewPreAuthorization(ctx context.Context, request *rapb.NewPreAuthorizationRequest) (*corepb.Authorization, error) {
+	resp, err := ras.inner.NewPreAuthorization(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil || !authorizationValid(resp) {
+		return nil, errIncompleteResponse
+	}
+	return resp, nil
+}
+
 // RegistrationAuthorityServerWrapper is the gRPC version of a core.RegistrationAuthority server
 type RegistrationAuthorityServerWrapper struct {
 	inner core.RegistrationAuthority
@@ -343,3 +354,10 @@ func (ras *RegistrationAuthorityServerWrapper) FinalizeOrder(ctx context.Context
 
 	return ras.inner.FinalizeOrder(ctx, request)
 }
+
+func (ras *RegistrationAuthorityServerWrapper) NewPreAuthorization(ctx context.Context, request *rapb.NewPreAuthorizationRequest) (*corepb.Authorization, error) {
+	if request == nil || core.IsAnyNilOrZero(request.RegistrationID, request.IdentifierType, request.IdentifierValue) {
+		return nil, errIncompleteRequest
+	}
+	return ras.inner.NewPreAuthorization(ctx, request)
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/letsencrypt/boulder/core"
 	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/features"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 )
 
@@ -91,6 +92,17 @@ func (sac StorageAuthorityClientWrapper) GetCertificateStatus(ctx context.Contex
 	return PBToCertStatus(response)
 }
 
+func (sac StorageAuthorityClientWrapper) GetCertificateAndStatus(ctx context.Context, req *sapb.Serial) (*sapb.CertificateAndStatus, error) {
+	resp, err := sac.inner.GetCertificateAndStatus(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil || resp.Certificate == nil || resp.Status == nil {
+		return nil, errIncompleteResponse
+	}
+	return resp, nil
+}
+
 func (sac StorageAuthorityClientWrapper) CountCertificatesByNames(ctx context.Context, domains []string, earliest, latest time.Time) ([]*sapb.CountByNames_MapElement, error) {
 	earliestNano := earliest.UnixNano()
 	latestNano := latest.UnixNano()
@@ -157,7 +169,7 @@ func (sac StorageAuthorityClientWrapper) CountRegistrationsByIPRange(ctx context
 	return int(response.Count), nil
 }
 
-func (sac StorageAuthorityClientWrapper) CountOrders(ctx context.Context, acctID int64, earliest, latest time.Time) (int, error) {
+func (sac StorageAuthorityClientWrapper) CountOrders(ctx context.Context, acctID int64, earliest, latest time.Time) (int, time.Time, error) {
 	earliestNano := earliest.UnixNano()
 	latestNano := latest.UnixNano()
 
@@ -169,14 +181,18 @@ func (sac StorageAuthorityClientWrapper) CountOrders(ctx context.Context, acctID
 		},
 	})
 	if err != nil {
-		return 0, err
+		return 0, time.Time{}, err
 	}
 
 	if response == nil {
-		return 0, errIncompleteResponse
+		return 0, time.Time{}, errIncompleteResponse
 	}
 
-	return int(response.Count), nil
+	var oldest time.Time
+	if response.Oldest != 0 {
+		oldest = time.Unix(0, response.Oldest)
+	}
+	return int(response.Count), oldest, nil
 }
 
 func (sac StorageAuthorityClientWrapper) CountFQDNSets(ctx context.Context, window time.Duration, domains []string) (int64, error) {
@@ -375,6 +391,19 @@ func (sas StorageAuthorityClientWrapper) GetOrderForNames(
 	return resp, nil
 }
 
+func (sas StorageAuthorityClientWrapper) GetOrdersForAccount(
+	ctx context.Context,
+	request *sapb.GetOrdersForAccountRequest) (*sapb.OrderIDs, error) {
+	resp, err := sas.inner.GetOrdersForAccount(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errIncompleteResponse
+	}
+	return resp, nil
+}
+
 func (sas StorageAuthorityClientWrapper) GetAuthorization2(ctx context.Context, req *sapb.AuthorizationID2) (*corepb.Authorization, error) {
 	resp, err := sas.inner.GetAuthorization2(ctx, req)
 	if err != nil {
@@ -488,14 +517,106 @@ func (sac StorageAuthorityClientWrapper) KeyBlocked(ctx context.Context, req *sa
 	return sac.inner.KeyBlocked(ctx, req)
 }
 
+func (sac StorageAuthorityClientWrapper) GetExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKeyID) (*sapb.ExternalAccountKey, error) {
+	resp, err := sac.inner.GetExternalAccountKey(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil || resp.KeyID == "" || len(resp.HmacKey) == 0 || resp.CreatedAt == 0 {
+		return nil, errIncompleteResponse
+	}
+	return resp, nil
+}
+
+func (sac StorageAuthorityClientWrapper) AddExternalAccountKey(ctx context.Context, req *sapb.AddExternalAccountKeyRequest) (*corepb.Empty, error) {
+	// All return checking is done at the call site
+	return sac.inner.AddExternalAccountKey(ctx, req)
+}
+
+func (sac StorageAuthorityClientWrapper) RevokeExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKeyID) (*corepb.Empty, error) {
+	// All return checking is done at the call site
+	return sac.inner.RevokeExternalAccountKey(ctx, req)
+}
+
+func (sac StorageAuthorityClientWrapper) UpdateOCSPResponse(ctx context.Context, req *sapb.UpdateOCSPResponseRequest) (*corepb.Empty, error) {
+	// All return checking is done at the call site
+	return sac.inner.UpdateOCSPResponse(ctx, req)
+}
+
+func (sac StorageAuthorityClientWrapper) AddSCT(ctx context.Context, req *sapb.SCT) (*corepb.Empty, error) {
+	// All return checking is done at the call site
+	return sac.inner.AddSCT(ctx, req)
+}
+
+func (sac StorageAuthorityClientWrapper) GetSCTs(ctx context.Context, req *sapb.Serial) (*sapb.SCTs, error) {
+	resp, err := sac.inner.GetSCTs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errIncompleteResponse
+	}
+	return resp, nil
+}
+
+func (sac StorageAuthorityClientWrapper) GetRecentSCTs(ctx context.Context, req *sapb.GetRecentSCTsRequest) (*sapb.SCTs, error) {
+	resp, err := sac.inner.GetRecentSCTs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errIncompleteResponse
+	}
+	return resp, nil
+}
+
+func (sac StorageAuthorityClientWrapper) AddFinalCertSubmissions(ctx context.Context, req *sapb.AddFinalCertSubmissionsRequest) (*corepb.Empty, error) {
+	// All return checking is done at the call site
+	return sac.inner.AddFinalCertSubmissions(ctx, req)
+}
+
+func (sac StorageAuthorityClientWrapper) GetFinalCertSubmissions(ctx context.Context, req *sapb.GetFinalCertSubmissionsRequest) (*sapb.FinalCertSubmissions, error) {
+	resp, err := sac.inner.GetFinalCertSubmissions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errIncompleteResponse
+	}
+	for _, sub := range resp.Submissions {
+		if sub == nil || sub.Log == nil {
+			return nil, errIncompleteResponse
+		}
+	}
+	return resp, nil
+}
+
+func (sac StorageAuthorityClientWrapper) RescheduleFinalCertSubmission(ctx context.Context, req *sapb.FinalCertSubmission) (*corepb.Empty, error) {
+	// All return checking is done at the call site
+	return sac.inner.RescheduleFinalCertSubmission(ctx, req)
+}
+
+func (sac StorageAuthorityClientWrapper) RemoveFinalCertSubmission(ctx context.Context, req *sapb.FinalCertSubmissionID) (*corepb.Empty, error) {
+	// All return checking is done at the call site
+	return sac.inner.RemoveFinalCertSubmission(ctx, req)
+}
+
+// storageAuthorityWithStreams is a core.StorageAuthority which also implements
+// the SA's streaming RPCs. Those have no equivalent in core.StorageAuthority,
+// so they are passed through to the inner implementation unchanged.
+type storageAuthorityWithStreams interface {
+	core.StorageAuthority
+	GetRevokedCerts(*sapb.GetRevokedCertsRequest, sapb.StorageAuthority_GetRevokedCertsServer) error
+}
+
 // StorageAuthorityServerWrapper is the gRPC version of a core.ServerAuthority server
 type StorageAuthorityServerWrapper struct {
 	// TODO(#3119): Don't use core.StorageAuthority
-	inner core.StorageAuthority
+	inner storageAuthorityWithStreams
 	core.StorageAuthority
 }
 
-func NewStorageAuthorityServer(inner core.StorageAuthority) *StorageAuthorityServerWrapper {
+func NewStorageAuthorityServer(inner storageAuthorityWithStreams) *StorageAuthorityServerWrapper {
 	return &StorageAuthorityServerWrapper{inner, inner}
 }
 
@@ -564,6 +685,13 @@ func (sas StorageAuthorityServerWrapper) GetCertificateStatus(ctx context.Contex
 	return CertStatusToPB(certStatus), nil
 }
 
+func (sas StorageAuthorityServerWrapper) GetCertificateAndStatus(ctx context.Context, request *sapb.Serial) (*sapb.CertificateAndStatus, error) {
+	if core.IsAnyNilOrZero(request, request.Serial) {
+		return nil, errIncompleteRequest
+	}
+	return sas.inner.GetCertificateAndStatus(ctx, request)
+}
+
 func (sas StorageAuthorityServerWrapper) CountCertificatesByNames(ctx context.Context, request *sapb.CountCertificatesByNamesRequest) (*sapb.CountByNames, error) {
 	if core.IsAnyNilOrZero(request, request.Range, request.Range.Earliest, request.Range.Latest, request.Names) {
 		return nil, errIncompleteRequest
@@ -616,7 +744,7 @@ func (sas StorageAuthorityServerWrapper) CountOrders(ctx context.Context, reques
 		return nil, errIncompleteRequest
 	}
 
-	count, err := sas.inner.CountOrders(ctx,
+	count, oldest, err := sas.inner.CountOrders(ctx,
 		request.AccountID,
 		time.Unix(0, request.Range.Earliest),
 		time.Unix(0, request.Range.Latest),
@@ -625,7 +753,11 @@ func (sas StorageAuthorityServerWrapper) CountOrders(ctx context.Context, reques
 		return nil, err
 	}
 
-	return &sapb.Count{Count: int64(count)}, nil
+	var oldestNano int64
+	if !oldest.IsZero() {
+		oldestNano = oldest.UnixNano()
+	}
+	return &sapb.Count{Count: int64(count), Oldest: oldestNano}, nil
 }
 
 func (sas StorageAuthorityServerWrapper) CountFQDNSets(ctx context.Context, request *sapb.CountFQDNSetsRequest) (*sapb.Count, error) {
@@ -790,6 +922,15 @@ func (sas StorageAuthorityServerWrapper) GetOrderForNames(
 	return sas.inner.GetOrderForNames(ctx, request)
 }
 
+func (sas StorageAuthorityServerWrapper) GetOrdersForAccount(
+	ctx context.Context,
+	request *sapb.GetOrdersForAccountRequest) (*sapb.OrderIDs, error) {
+	if core.IsAnyNilOrZero(request, request.AcctID, request.Limit) {
+		return nil, errIncompleteRequest
+	}
+	return sas.inner.GetOrdersForAccount(ctx, request)
+}
+
 func (sas StorageAuthorityServerWrapper) GetAuthorization2(ctx context.Context, request *sapb.AuthorizationID2) (*corepb.Authorization, error) {
 	if core.IsAnyNilOrZero(request, request.Id) {
 		return nil, errIncompleteRequest
@@ -799,7 +940,12 @@ func (sas StorageAuthorityServerWrapper) GetAuthorization2(ctx context.Context,
 }
 
 func (sas StorageAuthorityServerWrapper) RevokeCertificate(ctx context.Context, req *sapb.RevokeCertificateRequest) (*corepb.Empty, error) {
-	if core.IsAnyNilOrZero(req, req.Serial, req.Date, req.Response) {
+	if core.IsAnyNilOrZero(req, req.Serial, req.Date) {
+		return nil, errIncompleteRequest
+	}
+	// The OCSP response may only be omitted if the ocsp-updater will sign one
+	// from the revocation event queue.
+	if len(req.Response) == 0 && !features.Enabled(features.RevocationEventQueue) {
 		return nil, errIncompleteRequest
 	}
 	return &corepb.Empty{}, sas.inner.RevokeCertificate(ctx, req)
@@ -886,3 +1032,64 @@ func (sas StorageAuthorityServerWrapper) KeyBlocked(ctx context.Context, req *sa
 	// All request checking is done in the method
 	return sas.inner.KeyBlocked(ctx, req)
 }
+
+func (sas StorageAuthorityServerWrapper) GetExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKeyID) (*sapb.ExternalAccountKey, error) {
+	if core.IsAnyNilOrZero(req, req.KeyID) {
+		return nil, errIncompleteRequest
+	}
+	return sas.inner.GetExternalAccountKey(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) AddExternalAccountKey(ctx context.Context, req *sapb.AddExternalAccountKeyRequest) (*corepb.Empty, error) {
+	// All request checking is done in the method
+	return sas.inner.AddExternalAccountKey(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) RevokeExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKeyID) (*corepb.Empty, error) {
+	// All request checking is done in the method
+	return sas.inner.RevokeExternalAccountKey(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) UpdateOCSPResponse(ctx context.Context, req *sapb.UpdateOCSPResponseRequest) (*corepb.Empty, error) {
+	// All request checking is done in the method
+	return sas.inner.UpdateOCSPResponse(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) AddSCT(ctx context.Context, req *sapb.SCT) (*corepb.Empty, error) {
+	// All request checking is done in the method
+	return sas.inner.AddSCT(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) GetSCTs(ctx context.Context, req *sapb.Serial) (*sapb.SCTs, error) {
+	// All request checking is done in the method
+	return sas.inner.GetSCTs(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) GetRecentSCTs(ctx context.Context, req *sapb.GetRecentSCTsRequest) (*sapb.SCTs, error) {
+	// All request checking is done in the method
+	return sas.inner.GetRecentSCTs(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) AddFinalCertSubmissions(ctx context.Context, req *sapb.AddFinalCertSubmissionsRequest) (*corepb.Empty, error) {
+	// All request checking is done in the method
+	return sas.inner.AddFinalCertSubmissions(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) GetFinalCertSubmissions(ctx context.Context, req *sapb.GetFinalCertSubmissionsRequest) (*sapb.FinalCertSubmissions, error) {
+	// All request checking is done in the method
+	return sas.inner.GetFinalCertSubmissions(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) RescheduleFinalCertSubmission(ctx context.Context, req *sapb.FinalCertSubmission) (*corepb.Empty, error) {
+	// All request checking is done in the method
+	return sas.inner.RescheduleFinalCertSubmission(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) RemoveFinalCertSubmission(ctx context.Context, req *sapb.FinalCertSubmissionID) (*corepb.Empty, error) {
+	// All request checking is done in the method
+	return sas.inner.RemoveFinalCertSubmission(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) GetRevokedCerts(req *sapb.GetRevokedCertsRequest, stream sapb.StorageAuthority_GetRevokedCertsServer) error {
+	return sas.inner.GetRevokedCerts(req, stream)
+}
@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"math/big"
+	"net"
 	"strconv"
 	"strings"
 	"time"
@@ -28,6 +29,7 @@ import (
 	"github.com/jmhodges/clock"
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/crl"
 	"github.com/letsencrypt/boulder/lint"
 	"github.com/letsencrypt/boulder/policyasn1"
 	"github.com/letsencrypt/pkcs11key/v4"
@@ -43,6 +45,31 @@ type ProfileConfig struct {
 	Policies            []PolicyInformation
 	MaxValidityPeriod   cmd.ConfigDuration
 	MaxValidityBackdate cmd.ConfigDuration
+	// MaxNotBeforeDelay is how far in the future the NotBefore of a
+	// certificate may be, for orders which request a later start time. If
+	// zero NotBefore may not be in the future.
+	MaxNotBeforeDelay cmd.ConfigDuration
+
+	// ValidityPeriod is the lifetime of certificates issued using this
+	// profile. It is required for named profiles, and must not exceed
+	// MaxValidityPeriod. The default profile uses the CA's Expiry instead.
+	ValidityPeriod cmd.ConfigDuration
+	// ExtKeyUsages lists the extended key usages included in certificates
+	// issued using this profile, by name ("serverAuth" or "clientAuth"). If
+	// empty both are included.
+	ExtKeyUsages []string
+	// OmitOCSPURL causes the OCSP responder URL to be left out of the AIA
+	// extension of certificates issued using this profile.
+	OmitOCSPURL bool
+
+	// CRLURLTemplate, if set, gives each certificate a CRL distribution point
+	// pointing at the CRL of the shard it belongs to, expanded as described
+	// by crl.URL. It replaces the issuer's CRLURL, and together with
+	// CRLSharding it must match the configuration of the crl-updater.
+	CRLURLTemplate string
+	// CRLSharding determines which shard's CRL a certificate is listed in.
+	// It is required if CRLURLTemplate is set.
+	CRLSharding crl.ShardingConfig
 }
 
 // PolicyInformation describes a policy
@@ -67,6 +94,11 @@ type IssuerConfig struct {
 	CRLURL    string
 
 	Location IssuerLoc
+
+	// OCSPResponder, if set, locates a delegated OCSP responder certificate
+	// issued by this issuer, and its key, which are used to sign OCSP
+	// responses in place of the issuer's own key.
+	OCSPResponder *IssuerLoc
 }
 
 // IssuerLoc describes the on-disk location and parameters that an issuer
@@ -104,6 +136,61 @@ func LoadIssuer(location IssuerLoc) (*Certificate, crypto.Signer, error) {
 	return issuerCert, signer, err
 }
 
+// OCSPResponder is a delegated OCSP responder: a certificate which an issuer
+// has authorized to sign OCSP responses on its behalf, and its key.
+type OCSPResponder struct {
+	Cert   *Certificate
+	Signer crypto.Signer
+}
+
+// oidOCSPNoCheck is the id-pkix-ocsp-nocheck extension, which tells clients
+// not to check the revocation status of a delegated responder.
+var oidOCSPNoCheck = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 48, 1, 5}
+
+// LoadOCSPResponder loads a delegated OCSP responder's certificate and key
+// from the locations specified, and checks them with NewOCSPResponder.
+func LoadOCSPResponder(location IssuerLoc, issuer *Certificate) (*OCSPResponder, error) {
+	cert, signer, err := LoadIssuer(location)
+	if err != nil {
+		return nil, err
+	}
+	responder, err := NewOCSPResponder(cert, signer, issuer)
+	if err != nil {
+		return nil, fmt.Errorf("OCSP responder cert %s: %w", location.CertFile, err)
+	}
+	return responder, nil
+}
+
+// NewOCSPResponder returns a delegated OCSP responder for the given
+// certificate and key, after checking that the certificate was issued by
+// issuer for OCSP signing, as RFC 6960 Section 4.2.2.2 requires.
+func NewOCSPResponder(cert *Certificate, signer crypto.Signer, issuer *Certificate) (*OCSPResponder, error) {
+	err := cert.CheckSignatureFrom(issuer.Certificate)
+	if err != nil {
+		return nil, fmt.Errorf("not issued by %q: %w", issuer.Subject.CommonName, err)
+	}
+	if cert.IsCA {
+		return nil, errors.New("is a CA certificate")
+	}
+	if cert.KeyUsage&x509.KeyUsageDigitalSignature == 0 {
+		return nil, errors.New("does not have keyUsage digitalSignature")
+	}
+	if len(cert.ExtKeyUsage) != 1 || cert.ExtKeyUsage[0] != x509.ExtKeyUsageOCSPSigning {
+		return nil, errors.New("must have only the id-kp-OCSPSigning extended key usage")
+	}
+	hasNoCheck := false
+	for _, ext := range cert.Extensions {
+		if ext.Id.Equal(oidOCSPNoCheck) {
+			hasNoCheck = true
+			break
+		}
+	}
+	if !hasNoCheck {
+		return nil, errors.New("does not have the id-pkix-ocsp-nocheck extension")
+	}
+	return &OCSPResponder{Cert: cert, Signer: signer}, nil
+}
+
 func LoadCertificate(path string) (*Certificate, error) {
 	cert, err := core.LoadCert(path)
 	if err != nil {
@@ -166,14 +253,25 @@ type Profile struct {
 	allowSCTList    bool
 	allowCommonName bool
 
-	sigAlg    x509.SignatureAlgorithm
-	ocspURL   string
-	crlURL    string
-	issuerURL string
-	policies  *pkix.Extension
+	sigAlg       x509.SignatureAlgorithm
+	ocspURL      string
+	omitOCSPURL  bool
+	crlURL       string
+	issuerURL    string
+	policies     *pkix.Extension
+	extKeyUsages []x509.ExtKeyUsage
+
+	crlURLTemplate string
+	crlSharding    crl.ShardingConfig
+
+	// delegatedOCSP is set if the issuer's OCSP responses are signed by a
+	// delegated responder, so the issuer itself needn't be able to sign them.
+	delegatedOCSP bool
 
 	maxBackdate time.Duration
+	maxDelay    time.Duration
 	maxValidity time.Duration
+	validity    time.Duration
 }
 
 func parseOID(oidStr string) (asn1.ObjectIdentifier, error) {
@@ -195,6 +293,11 @@ var stringToQualifierType = map[string]asn1.ObjectIdentifier{
 	"id-qt-cps": policyasn1.CPSQualifierOID,
 }
 
+var stringToExtKeyUsage = map[string]x509.ExtKeyUsage{
+	"serverAuth": x509.ExtKeyUsageServerAuth,
+	"clientAuth": x509.ExtKeyUsageClientAuth,
+}
+
 // NewProfile synthesizes the profile config and issuer config into a single
 // object, and checks various aspects for correctness.
 func NewProfile(profileConfig ProfileConfig, issuerConfig IssuerConfig) (*Profile, error) {
@@ -214,8 +317,37 @@ func NewProfile(profileConfig ProfileConfig, issuerConfig IssuerConfig) (*Profil
 		issuerURL:         issuerConfig.IssuerURL,
 		crlURL:            issuerConfig.CRLURL,
 		ocspURL:           issuerConfig.OCSPURL,
+		omitOCSPURL:       profileConfig.OmitOCSPURL,
+		crlURLTemplate:    profileConfig.CRLURLTemplate,
+		crlSharding:       profileConfig.CRLSharding,
+		delegatedOCSP:     issuerConfig.OCSPResponder != nil,
 		maxBackdate:       profileConfig.MaxValidityBackdate.Duration,
+		maxDelay:          profileConfig.MaxNotBeforeDelay.Duration,
 		maxValidity:       profileConfig.MaxValidityPeriod.Duration,
+		validity:          profileConfig.ValidityPeriod.Duration,
+	}
+	if sp.validity > sp.maxValidity {
+		return nil, fmt.Errorf("validity period is more than the maximum allowed period (%s>%s)", sp.validity, sp.maxValidity)
+	}
+	if sp.crlURLTemplate != "" {
+		if sp.crlURL != "" {
+			return nil, errors.New("CRL URL and CRL URL template are mutually exclusive")
+		}
+		err := crl.ValidateURLTemplate(sp.crlURLTemplate)
+		if err != nil {
+			return nil, err
+		}
+		err = sp.crlSharding.Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range profileConfig.ExtKeyUsages {
+		eku, ok := stringToExtKeyUsage[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown extended key usage: %s", name)
+		}
+		sp.extKeyUsages = append(sp.extKeyUsages, eku)
 	}
 	if len(profileConfig.Policies) > 0 {
 		var policies []policyasn1.PolicyInformation
@@ -297,9 +429,12 @@ func (p *Profile) requestValid(clk clock.Clock, req *IssuanceRequest) error {
 	if backdatedBy > p.maxBackdate {
 		return fmt.Errorf("NotBefore is backdated more than the maximum allowed period (%s>%s)", backdatedBy, p.maxBackdate)
 	}
-	if backdatedBy < 0 {
+	if backdatedBy < 0 && p.maxDelay == 0 {
 		return errors.New("NotBefore is in the future")
 	}
+	if -backdatedBy > p.maxDelay {
+		return fmt.Errorf("NotBefore is delayed more than the maximum allowed period (%s>%s)", -backdatedBy, p.maxDelay)
+	}
 
 	if len(req.Serial) > 20 || len(req.Serial) < 8 {
 		return errors.New("serial must be between 8 and 20 bytes")
@@ -322,6 +457,14 @@ func (p *Profile) generateTemplate(clk clock.Clock) *x509.Certificate {
 		BasicConstraintsValid: true,
 	}
 
+	if len(p.extKeyUsages) > 0 {
+		template.ExtKeyUsage = p.extKeyUsages
+	}
+
+	if p.omitOCSPURL {
+		template.OCSPServer = nil
+	}
+
 	if p.crlURL != "" {
 		template.CRLDistributionPoints = []string{p.crlURL}
 	}
@@ -390,6 +533,13 @@ type Issuer struct {
 	Profile *Profile
 	Linter  *lint.Linter
 	Clk     clock.Clock
+
+	// profiles holds the named profiles which can be selected in place of
+	// Profile by setting IssuanceRequest.ProfileName.
+	profiles map[string]*Profile
+
+	// ocspResponder, if set, signs OCSP responses in place of the issuer.
+	ocspResponder *OCSPResponder
 }
 
 // NewIssuer constructs an Issuer on the heap, verifying that the profile
@@ -417,7 +567,7 @@ func NewIssuer(cert *Certificate, signer crypto.Signer, profile *Profile, linter
 		}
 	}
 	// TODO(#5086): Only do this check for ocsp-issuing issuers.
-	if cert.KeyUsage&x509.KeyUsageDigitalSignature == 0 {
+	if !profile.delegatedOCSP && cert.KeyUsage&x509.KeyUsageDigitalSignature == 0 {
 		return nil, errors.New("end-entity ocsp signing cert does not have keyUsage digitalSignature")
 	}
 
@@ -431,6 +581,110 @@ func NewIssuer(cert *Certificate, signer crypto.Signer, profile *Profile, linter
 	return i, nil
 }
 
+// SetOCSPResponder makes the issuer's OCSP responses be signed by a delegated
+// responder. The issuer's profile must have been built from an IssuerConfig
+// with OCSPResponder set.
+func (i *Issuer) SetOCSPResponder(responder *OCSPResponder) error {
+	if !i.Profile.delegatedOCSP {
+		return errors.New("issuer is not configured for a delegated OCSP responder")
+	}
+	if responder == nil {
+		return errors.New("nil OCSP responder")
+	}
+	i.ocspResponder = responder
+	return nil
+}
+
+// OCSPResponder returns the issuer's delegated OCSP responder, or nil if the
+// issuer signs its own OCSP responses.
+func (i *Issuer) OCSPResponder() *OCSPResponder {
+	return i.ocspResponder
+}
+
+// AddProfile makes a named profile available for issuance by this issuer.
+// The profile must have been built from the same IssuerConfig as the issuer's
+// default profile, and must specify a validity period. A single set of CRLs
+// covers all of the issuer's certificates, so every profile which shards CRLs
+// must shard them the same way.
+func (i *Issuer) AddProfile(name string, profile *Profile) error {
+	if name == "" {
+		return errors.New("profile name is required")
+	}
+	if _, present := i.profiles[name]; present {
+		return fmt.Errorf("duplicate profile name %q", name)
+	}
+	if profile.validity == 0 {
+		return fmt.Errorf("profile %q does not specify a validity period", name)
+	}
+	if profile.useForRSALeaves != i.Profile.useForRSALeaves ||
+		profile.useForECDSALeaves != i.Profile.useForECDSALeaves {
+		return fmt.Errorf("profile %q does not match the issuer's leaf key types", name)
+	}
+	if profile.crlURLTemplate != "" {
+		sharding, ok := i.CRLSharding()
+		if ok && sharding != profile.crlSharding {
+			return fmt.Errorf("profile %q shards CRLs differently from the issuer's other profiles", name)
+		}
+	}
+	profile.sigAlg = i.Profile.sigAlg
+	if i.profiles == nil {
+		i.profiles = make(map[string]*Profile)
+	}
+	i.profiles[name] = profile
+	return nil
+}
+
+// profile returns the profile with the given name, or the issuer's default
+// profile if the name is empty.
+func (i *Issuer) profile(name string) (*Profile, error) {
+	if name == "" {
+		return i.Profile, nil
+	}
+	profile, ok := i.profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown profile %q", name)
+	}
+	return profile, nil
+}
+
+// ProfileValidity returns the validity period of certificates issued using
+// the named profile.
+func (i *Issuer) ProfileValidity(name string) (time.Duration, error) {
+	profile, err := i.profile(name)
+	if err != nil {
+		return 0, err
+	}
+	return profile.validity, nil
+}
+
+// CRLSharding returns how the issuer assigns its certificates to CRL shards.
+// The boolean result is false if none of its profiles shard CRLs.
+func (i *Issuer) CRLSharding() (crl.ShardingConfig, bool) {
+	if i.Profile.crlURLTemplate != "" {
+		return i.Profile.crlSharding, true
+	}
+	for _, profile := range i.profiles {
+		if profile.crlURLTemplate != "" {
+			return profile.crlSharding, true
+		}
+	}
+	return crl.ShardingConfig{}, false
+}
+
+// CRLShard returns the index of the CRL shard the certificate described by
+// the request belongs to. The boolean result is false if the request's profile
+// doesn't shard CRLs, in which case the index is meaningless.
+func (i *Issuer) CRLShard(req *IssuanceRequest) (int, bool, error) {
+	profile, err := i.profile(req.ProfileName)
+	if err != nil {
+		return 0, false, err
+	}
+	if profile.crlURLTemplate == "" {
+		return 0, false, nil
+	}
+	return profile.crlSharding.Shard(big.NewInt(0).SetBytes(req.Serial), req.NotAfter), true, nil
+}
+
 // Algs provides the list of leaf certificate public key algorithms for which
 // this issuer is willing to issue. This is not necessarily the same as the
 // public key algorithm or signature algorithm in this issuer's own cert.
@@ -524,12 +778,17 @@ type IssuanceRequest struct {
 	NotBefore time.Time
 	NotAfter  time.Time
 
-	CommonName string
-	DNSNames   []string
+	CommonName  string
+	DNSNames    []string
+	IPAddresses []net.IP
 
 	IncludeMustStaple bool
 	IncludeCTPoison   bool
 	SCTList           []ct.SignedCertificateTimestamp
+
+	// ProfileName selects one of the issuer's named profiles. If empty the
+	// issuer's default profile is used.
+	ProfileName string
 }
 
 // Issue generates a certificate from the provided issuance request and
@@ -538,13 +797,18 @@ type IssuanceRequest struct {
 // zlint. If the linting fails, an error is returned and the certificate
 // is not signed using the issuer's key.
 func (i *Issuer) Issue(req *IssuanceRequest) ([]byte, error) {
+	profile, err := i.profile(req.ProfileName)
+	if err != nil {
+		return nil, err
+	}
+
 	// check request is valid according to the issuance profile
-	if err := i.Profile.requestValid(i.Clk, req); err != nil {
+	if err := profile.requestValid(i.Clk, req); err != nil {
 		return nil, err
 	}
 
 	// generate template from the issuance profile
-	template := i.Profile.generateTemplate(i.Clk)
+	template := profile.generateTemplate(i.Clk)
 
 	// populate template from the issuance request
 	template.NotBefore, template.NotAfter = req.NotBefore, req.NotAfter
@@ -553,7 +817,14 @@ func (i *Issuer) Issue(req *IssuanceRequest) ([]byte, error) {
 		template.Subject.CommonName = req.CommonName
 	}
 	template.DNSNames = req.DNSNames
+	template.IPAddresses = req.IPAddresses
 	template.AuthorityKeyId = i.Cert.SubjectKeyId
+	if profile.crlURLTemplate != "" {
+		shard := profile.crlSharding.Shard(template.SerialNumber, req.NotAfter)
+		template.CRLDistributionPoints = []string{
+			crl.URL(profile.crlURLTemplate, int64(i.Cert.NameID()), shard),
+		}
+	}
 	skid, err := generateSKID(req.PublicKey)
 	if err != nil {
 		return nil, err
@@ -622,6 +893,7 @@ func RequestFromPrecert(precert *x509.Certificate, scts []ct.SignedCertificateTi
 		NotAfter:          precert.NotAfter,
 		CommonName:        precert.Subject.CommonName,
 		DNSNames:          precert.DNSNames,
+		IPAddresses:       precert.IPAddresses,
 		IncludeMustStaple: ContainsMustStaple(precert.Extensions),
 		SCTList:           scts,
 	}, nil
@@ -11,8 +11,11 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
 	"io/ioutil"
 	"math/big"
+	"net"
 	"os"
 	"testing"
 	"time"
@@ -21,6 +24,7 @@ import (
 	"github.com/jmhodges/clock"
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/crl"
 	"github.com/letsencrypt/boulder/lint"
 	"github.com/letsencrypt/boulder/policyasn1"
 	"github.com/letsencrypt/boulder/test"
@@ -161,6 +165,56 @@ func TestNewProfileUnknownQualifierType(t *testing.T) {
 	test.AssertEquals(t, err.Error(), "unknown qualifier type: asd")
 }
 
+func TestNewProfileValidity(t *testing.T) {
+	config := defaultProfileConfig()
+	config.ValidityPeriod = cmd.ConfigDuration{Duration: 2 * time.Hour}
+	_, err := NewProfile(config, defaultIssuerConfig())
+	test.AssertError(t, err, "NewProfile didn't fail with validity period above the maximum")
+	test.AssertEquals(t, err.Error(), "validity period is more than the maximum allowed period (2h0m0s>1h0m0s)")
+
+	config.ValidityPeriod = cmd.ConfigDuration{Duration: time.Hour}
+	profile, err := NewProfile(config, defaultIssuerConfig())
+	test.AssertNotError(t, err, "NewProfile failed")
+	test.AssertEquals(t, profile.validity, time.Hour)
+}
+
+func TestNewProfileExtKeyUsages(t *testing.T) {
+	config := defaultProfileConfig()
+	config.ExtKeyUsages = []string{"clientAuth"}
+	profile, err := NewProfile(config, defaultIssuerConfig())
+	test.AssertNotError(t, err, "NewProfile failed")
+	test.AssertDeepEquals(t, profile.extKeyUsages, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth})
+
+	config.ExtKeyUsages = []string{"codeSigning"}
+	_, err = NewProfile(config, defaultIssuerConfig())
+	test.AssertError(t, err, "NewProfile didn't fail with unknown extended key usage")
+	test.AssertEquals(t, err.Error(), "unknown extended key usage: codeSigning")
+}
+
+func TestNewProfileCRLURLTemplate(t *testing.T) {
+	config := defaultProfileConfig()
+	config.CRLURLTemplate = "http://c.example.com/{issuer}/{shard}.crl"
+	_, err := NewProfile(config, defaultIssuerConfig())
+	test.AssertError(t, err, "NewProfile didn't fail without CRL sharding")
+	test.AssertEquals(t, err.Error(), "number of CRL shards must be positive")
+
+	config.CRLSharding = crl.ShardingConfig{Scheme: crl.ShardBySerial, NumShards: 4}
+	profile, err := NewProfile(config, defaultIssuerConfig())
+	test.AssertNotError(t, err, "NewProfile failed")
+	test.AssertEquals(t, profile.crlURLTemplate, "http://c.example.com/{issuer}/{shard}.crl")
+
+	issuerConfig := defaultIssuerConfig()
+	issuerConfig.CRLURL = "http://c.example.com/crl"
+	_, err = NewProfile(config, issuerConfig)
+	test.AssertError(t, err, "NewProfile didn't fail with both a CRL URL and template")
+	test.AssertEquals(t, err.Error(), "CRL URL and CRL URL template are mutually exclusive")
+
+	config.CRLURLTemplate = "http://c.example.com/crl"
+	_, err = NewProfile(config, defaultIssuerConfig())
+	test.AssertError(t, err, "NewProfile didn't fail with a template without shard")
+	test.AssertEquals(t, err.Error(), "CRL URL template must contain {shard}")
+}
+
 func TestRequestValid(t *testing.T) {
 	fc := clock.NewFake()
 	fc.Add(time.Hour * 24)
@@ -299,6 +353,21 @@ func TestRequestValid(t *testing.T) {
 			},
 			expectedError: "NotBefore is in the future",
 		},
+		{
+			name: "validity is forward dated more than allowed",
+			profile: &Profile{
+				useForECDSALeaves: true,
+				maxValidity:       time.Hour * 2,
+				maxBackdate:       time.Hour,
+				maxDelay:          time.Minute * 30,
+			},
+			request: &IssuanceRequest{
+				PublicKey: &ecdsa.PublicKey{},
+				NotBefore: fc.Now().Add(time.Hour),
+				NotAfter:  fc.Now().Add(time.Hour * 2),
+			},
+			expectedError: "NotBefore is delayed more than the maximum allowed period (1h0m0s>30m0s)",
+		},
 		{
 			name: "serial too short",
 			profile: &Profile{
@@ -339,6 +408,20 @@ func TestRequestValid(t *testing.T) {
 				Serial:    []byte{1, 2, 3, 4, 5, 6, 7, 8},
 			},
 		},
+		{
+			name: "good, forward dated",
+			profile: &Profile{
+				useForECDSALeaves: true,
+				maxValidity:       time.Hour * 2,
+				maxDelay:          time.Hour,
+			},
+			request: &IssuanceRequest{
+				PublicKey: &ecdsa.PublicKey{},
+				NotBefore: fc.Now().Add(time.Hour),
+				NotAfter:  fc.Now().Add(time.Hour * 2),
+				Serial:    []byte{1, 2, 3, 4, 5, 6, 7, 8},
+			},
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -401,6 +484,21 @@ func TestGenerateTemplate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "client auth without ocsp url",
+			profile: &Profile{
+				sigAlg:       x509.SHA256WithRSA,
+				ocspURL:      "ocsp-url",
+				omitOCSPURL:  true,
+				extKeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+			},
+			expectedTemplate: &x509.Certificate{
+				BasicConstraintsValid: true,
+				SignatureAlgorithm:    x509.SHA256WithRSA,
+				ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+				IssuingCertificateURL: []string{""},
+			},
+		},
 	}
 	fc := clock.NewFake()
 	fc.Set(time.Time{}.Add(time.Hour))
@@ -498,6 +596,277 @@ func TestNewIssuerOCSPOnly(t *testing.T) {
 	test.AssertNotError(t, err, "NewIssuer failed")
 }
 
+func TestNewIssuerDelegatedOCSP(t *testing.T) {
+	p := defaultProfile()
+	p.delegatedOCSP = true
+	issuer, err := NewIssuer(
+		&Certificate{
+			&x509.Certificate{
+				PublicKey: &ecdsa.PublicKey{
+					Curve: elliptic.P256(),
+				},
+				KeyUsage: x509.KeyUsageCertSign,
+			},
+		},
+		issuerSigner,
+		p,
+		&lint.Linter{},
+		clock.NewFake(),
+	)
+	test.AssertNotError(t, err, "NewIssuer failed for an issuer with a delegated OCSP responder")
+	test.Assert(t, issuer.OCSPResponder() == nil, "issuer has an OCSP responder before one was set")
+
+	responder := &OCSPResponder{Cert: issuerCert, Signer: issuerSigner}
+	err = issuer.SetOCSPResponder(responder)
+	test.AssertNotError(t, err, "SetOCSPResponder failed")
+	test.Assert(t, issuer.OCSPResponder() == responder, "issuer has the wrong OCSP responder")
+
+	issuer, err = NewIssuer(issuerCert, issuerSigner, defaultProfile(), &lint.Linter{}, clock.NewFake())
+	test.AssertNotError(t, err, "NewIssuer failed")
+	err = issuer.SetOCSPResponder(responder)
+	test.AssertError(t, err, "SetOCSPResponder succeeded for an issuer not configured for one")
+}
+
+// writeOCSPResponder issues a responder certificate from the template with
+// the test issuer, and writes it and its key to files in dir.
+func writeOCSPResponder(t *testing.T, dir string, template *x509.Certificate) IssuerLoc {
+	t.Helper()
+	k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate responder key")
+	der, err := x509.CreateCertificate(rand.Reader, template, issuerCert.Certificate, k.Public(), issuerSigner)
+	test.AssertNotError(t, err, "failed to create responder certificate")
+	keyDER, err := x509.MarshalECPrivateKey(k)
+	test.AssertNotError(t, err, "failed to marshal responder key")
+
+	certFile, err := ioutil.TempFile(dir, "cert")
+	test.AssertNotError(t, err, "failed to create cert file")
+	defer certFile.Close()
+	err = pem.Encode(certFile, &pem.Block{Type: "CERTIFICATE", Bytes: der})
+	test.AssertNotError(t, err, "failed to write cert file")
+	keyFile, err := ioutil.TempFile(dir, "key")
+	test.AssertNotError(t, err, "failed to create key file")
+	defer keyFile.Close()
+	err = pem.Encode(keyFile, &pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	test.AssertNotError(t, err, "failed to write key file")
+	return IssuerLoc{File: keyFile.Name(), CertFile: certFile.Name()}
+}
+
+func TestLoadOCSPResponder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ocsp-responder")
+	test.AssertNotError(t, err, "failed to create temp dir")
+	defer os.RemoveAll(dir)
+
+	responderTemplate := func() *x509.Certificate {
+		return &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "delegated OCSP responder"},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageOCSPSigning},
+			ExtraExtensions: []pkix.Extension{
+				{Id: oidOCSPNoCheck, Value: []byte{5, 0}},
+			},
+		}
+	}
+
+	loc := writeOCSPResponder(t, dir, responderTemplate())
+	responder, err := LoadOCSPResponder(loc, issuerCert)
+	test.AssertNotError(t, err, "LoadOCSPResponder failed")
+	test.AssertNotError(t, responder.Cert.CheckSignatureFrom(issuerCert.Certificate), "responder wasn't issued by the issuer")
+
+	otherIssuer := &Certificate{&x509.Certificate{
+		RawSubject: []byte("other"),
+		PublicKey:  &ecdsa.PublicKey{Curve: elliptic.P256(), X: big.NewInt(1), Y: big.NewInt(1)},
+	}}
+	_, err = LoadOCSPResponder(loc, otherIssuer)
+	test.AssertError(t, err, "LoadOCSPResponder succeeded with the wrong issuer")
+
+	noNoCheck := responderTemplate()
+	noNoCheck.ExtraExtensions = nil
+	_, err = LoadOCSPResponder(writeOCSPResponder(t, dir, noNoCheck), issuerCert)
+	test.AssertError(t, err, "LoadOCSPResponder succeeded without id-pkix-ocsp-nocheck")
+	test.AssertContains(t, err.Error(), "id-pkix-ocsp-nocheck")
+
+	wrongEKU := responderTemplate()
+	wrongEKU.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	_, err = LoadOCSPResponder(writeOCSPResponder(t, dir, wrongEKU), issuerCert)
+	test.AssertError(t, err, "LoadOCSPResponder succeeded without id-kp-OCSPSigning")
+	test.AssertContains(t, err.Error(), "id-kp-OCSPSigning")
+
+	ca := responderTemplate()
+	ca.BasicConstraintsValid = true
+	ca.IsCA = true
+	_, err = LoadOCSPResponder(writeOCSPResponder(t, dir, ca), issuerCert)
+	test.AssertError(t, err, "LoadOCSPResponder succeeded with a CA certificate")
+}
+
+func TestIssuerAddProfile(t *testing.T) {
+	issuer, err := NewIssuer(issuerCert, issuerSigner, defaultProfile(), &lint.Linter{}, clock.NewFake())
+	test.AssertNotError(t, err, "NewIssuer failed")
+
+	config := defaultProfileConfig()
+	config.ValidityPeriod = cmd.ConfigDuration{Duration: 30 * time.Minute}
+	shortLived, err := NewProfile(config, defaultIssuerConfig())
+	test.AssertNotError(t, err, "NewProfile failed")
+
+	err = issuer.AddProfile("", shortLived)
+	test.AssertError(t, err, "AddProfile didn't fail with empty name")
+	test.AssertEquals(t, err.Error(), "profile name is required")
+
+	err = issuer.AddProfile("no-validity", defaultProfile())
+	test.AssertError(t, err, "AddProfile didn't fail with no validity period")
+	test.AssertEquals(t, err.Error(), "profile \"no-validity\" does not specify a validity period")
+
+	ecdsaOnly := defaultIssuerConfig()
+	ecdsaOnly.UseForRSALeaves = false
+	mismatched, err := NewProfile(config, ecdsaOnly)
+	test.AssertNotError(t, err, "NewProfile failed")
+	err = issuer.AddProfile("mismatched", mismatched)
+	test.AssertError(t, err, "AddProfile didn't fail with mismatched key types")
+	test.AssertEquals(t, err.Error(), "profile \"mismatched\" does not match the issuer's leaf key types")
+
+	err = issuer.AddProfile("short-lived", shortLived)
+	test.AssertNotError(t, err, "AddProfile failed")
+	test.AssertEquals(t, shortLived.sigAlg, x509.ECDSAWithSHA256)
+	err = issuer.AddProfile("short-lived", shortLived)
+	test.AssertError(t, err, "AddProfile didn't fail with duplicate name")
+	test.AssertEquals(t, err.Error(), "duplicate profile name \"short-lived\"")
+
+	validity, err := issuer.ProfileValidity("short-lived")
+	test.AssertNotError(t, err, "ProfileValidity failed")
+	test.AssertEquals(t, validity, 30*time.Minute)
+	_, err = issuer.ProfileValidity("unknown")
+	test.AssertError(t, err, "ProfileValidity didn't fail with unknown profile")
+	test.AssertEquals(t, err.Error(), "unknown profile \"unknown\"")
+}
+
+func TestIssueUnknownProfile(t *testing.T) {
+	fc := clock.NewFake()
+	fc.Set(time.Now())
+	issuer, err := NewIssuer(issuerCert, issuerSigner, defaultProfile(), &lint.Linter{}, fc)
+	test.AssertNotError(t, err, "NewIssuer failed")
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate test key")
+	_, err = issuer.Issue(&IssuanceRequest{
+		PublicKey:   pk.Public(),
+		Serial:      []byte{1, 2, 3, 4, 5, 6, 7, 8},
+		DNSNames:    []string{"example.com"},
+		NotBefore:   fc.Now(),
+		NotAfter:    fc.Now().Add(time.Hour),
+		ProfileName: "unknown",
+	})
+	test.AssertError(t, err, "Issue didn't fail with unknown profile")
+	test.AssertEquals(t, err.Error(), "unknown profile \"unknown\"")
+}
+
+func TestIssueNamedProfile(t *testing.T) {
+	fc := clock.NewFake()
+	fc.Set(time.Now())
+	linter, _ := lint.NewLinter(
+		issuerSigner,
+		[]string{"w_ct_sct_policy_count_unsatisfied", "e_sub_cert_aia_does_not_contain_ocsp_url"},
+	)
+	issuer, err := NewIssuer(issuerCert, issuerSigner, defaultProfile(), linter, fc)
+	test.AssertNotError(t, err, "NewIssuer failed")
+	config := defaultProfileConfig()
+	config.ValidityPeriod = cmd.ConfigDuration{Duration: time.Hour}
+	config.ExtKeyUsages = []string{"clientAuth"}
+	config.OmitOCSPURL = true
+	profile, err := NewProfile(config, defaultIssuerConfig())
+	test.AssertNotError(t, err, "NewProfile failed")
+	err = issuer.AddProfile("client-auth", profile)
+	test.AssertNotError(t, err, "AddProfile failed")
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate test key")
+	certBytes, err := issuer.Issue(&IssuanceRequest{
+		PublicKey:   pk.Public(),
+		Serial:      []byte{1, 2, 3, 4, 5, 6, 7, 8},
+		DNSNames:    []string{"example.com"},
+		NotBefore:   fc.Now(),
+		NotAfter:    fc.Now().Add(time.Hour),
+		ProfileName: "client-auth",
+	})
+	test.AssertNotError(t, err, "Issue failed")
+	cert, err := x509.ParseCertificate(certBytes)
+	test.AssertNotError(t, err, "failed to parse certificate")
+	test.AssertDeepEquals(t, cert.ExtKeyUsage, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth})
+	test.AssertEquals(t, len(cert.OCSPServer), 0)
+	test.AssertDeepEquals(t, cert.IssuingCertificateURL, []string{"http://issuer-url"})
+}
+
+func TestIssuerCRLShard(t *testing.T) {
+	fc := clock.NewFake()
+	fc.Set(time.Now())
+	issuer, err := NewIssuer(issuerCert, issuerSigner, defaultProfile(), &lint.Linter{}, fc)
+	test.AssertNotError(t, err, "NewIssuer failed")
+	config := defaultProfileConfig()
+	config.ValidityPeriod = cmd.ConfigDuration{Duration: time.Hour}
+	config.CRLURLTemplate = "http://c.example.com/{issuer}/{shard}.crl"
+	config.CRLSharding = crl.ShardingConfig{Scheme: crl.ShardBySerial, NumShards: 4}
+	profile, err := NewProfile(config, defaultIssuerConfig())
+	test.AssertNotError(t, err, "NewProfile failed")
+	err = issuer.AddProfile("sharded", profile)
+	test.AssertNotError(t, err, "AddProfile failed")
+
+	req := &IssuanceRequest{
+		Serial:   []byte{1, 2, 3, 4, 5, 6, 7, 11},
+		NotAfter: fc.Now().Add(time.Hour),
+	}
+	_, sharded, err := issuer.CRLShard(req)
+	test.AssertNotError(t, err, "CRLShard failed")
+	test.Assert(t, !sharded, "default profile shouldn't shard CRLs")
+
+	req.ProfileName = "sharded"
+	shard, sharded, err := issuer.CRLShard(req)
+	test.AssertNotError(t, err, "CRLShard failed")
+	test.Assert(t, sharded, "profile with a CRL URL template should shard CRLs")
+	test.AssertEquals(t, shard, 3)
+
+	req.ProfileName = "unknown"
+	_, _, err = issuer.CRLShard(req)
+	test.AssertError(t, err, "CRLShard didn't fail with unknown profile")
+
+	sharding, ok := issuer.CRLSharding()
+	test.Assert(t, ok, "issuer with a sharded profile should shard CRLs")
+	test.AssertEquals(t, sharding, config.CRLSharding)
+
+	// Another profile may only shard CRLs the same way.
+	config.CRLSharding.NumShards = 8
+	profile, err = NewProfile(config, defaultIssuerConfig())
+	test.AssertNotError(t, err, "NewProfile failed")
+	err = issuer.AddProfile("resharded", profile)
+	test.AssertError(t, err, "AddProfile accepted a profile with different CRL sharding")
+}
+
+func TestIssueCRLDistributionPoint(t *testing.T) {
+	fc := clock.NewFake()
+	fc.Set(time.Now())
+	linter, _ := lint.NewLinter(issuerSigner, []string{"w_ct_sct_policy_count_unsatisfied"})
+	config := defaultProfileConfig()
+	config.CRLURLTemplate = "http://c.example.com/{issuer}/{shard}.crl"
+	config.CRLSharding = crl.ShardingConfig{Scheme: crl.ShardBySerial, NumShards: 4}
+	profile, err := NewProfile(config, defaultIssuerConfig())
+	test.AssertNotError(t, err, "NewProfile failed")
+	issuer, err := NewIssuer(issuerCert, issuerSigner, profile, linter, fc)
+	test.AssertNotError(t, err, "NewIssuer failed")
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate test key")
+	certBytes, err := issuer.Issue(&IssuanceRequest{
+		PublicKey: pk.Public(),
+		Serial:    []byte{1, 2, 3, 4, 5, 6, 7, 11},
+		DNSNames:  []string{"example.com"},
+		NotBefore: fc.Now(),
+		NotAfter:  fc.Now().Add(time.Hour),
+	})
+	test.AssertNotError(t, err, "Issue failed")
+	cert, err := x509.ParseCertificate(certBytes)
+	test.AssertNotError(t, err, "failed to parse certificate")
+	test.AssertDeepEquals(t, cert.CRLDistributionPoints, []string{
+		fmt.Sprintf("http://c.example.com/%d/3.crl", issuerCert.NameID()),
+	})
+}
+
 func TestIssue(t *testing.T) {
 	for _, tc := range []struct {
 		name         string
@@ -677,6 +1046,33 @@ func TestIssueMustStaple(t *testing.T) {
 	test.AssertDeepEquals(t, cert.Extensions[8], mustStapleExt)
 }
 
+func TestIssueIPAddresses(t *testing.T) {
+	fc := clock.NewFake()
+	fc.Set(time.Now())
+	linter, _ := lint.NewLinter(
+		issuerSigner,
+		[]string{"w_ct_sct_policy_count_unsatisfied"},
+	)
+	signer, err := NewIssuer(issuerCert, issuerSigner, defaultProfile(), linter, fc)
+	test.AssertNotError(t, err, "NewIssuer failed")
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate test key")
+	ips := []net.IP{net.ParseIP("93.184.216.34").To4(), net.ParseIP("2606:2800:220:1:248:1893:25c8:1946")}
+	certBytes, err := signer.Issue(&IssuanceRequest{
+		PublicKey:   pk.Public(),
+		Serial:      []byte{1, 2, 3, 4, 5, 6, 7, 8},
+		DNSNames:    []string{"example.com"},
+		IPAddresses: ips,
+		NotBefore:   fc.Now(),
+		NotAfter:    fc.Now().Add(time.Hour),
+	})
+	test.AssertNotError(t, err, "Issue failed")
+	cert, err := x509.ParseCertificate(certBytes)
+	test.AssertNotError(t, err, "failed to parse certificate")
+	test.AssertDeepEquals(t, cert.DNSNames, []string{"example.com"})
+	test.AssertDeepEquals(t, cert.IPAddresses, ips)
+}
+
 func TestIssueBadLint(t *testing.T) {
 	fc := clock.NewFake()
 	fc.Set(time.Now())
@@ -0,0 +1,382 @@
+package lint
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/letsencrypt/boulder/revocation"
+	"github.com/letsencrypt/boulder/x509crl"
+)
+
+// maxRevocationValidity is the longest time between thisUpdate and nextUpdate
+// which the Baseline Requirements (Sections 4.9.7 and 4.9.10) allow for OCSP
+// responses and CRLs covering subscriber certificates.
+const maxRevocationValidity = 10 * 24 * time.Hour
+
+var (
+	oidCRLNumber                = asn1.ObjectIdentifier{2, 5, 29, 20}
+	oidReasonCode               = asn1.ObjectIdentifier{2, 5, 29, 21}
+	oidIssuingDistributionPoint = asn1.ObjectIdentifier{2, 5, 29, 28}
+	oidAuthorityKeyIdentifier   = asn1.ObjectIdentifier{2, 5, 29, 35}
+	oidOCSPNoCheck              = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 48, 1, 5}
+)
+
+// ocspLint is a check run against a parsed OCSP response. It returns an error
+// describing the problem if the response fails it.
+type ocspLint struct {
+	name  string
+	check func(resp *ocsp.Response) error
+}
+
+// crlLint is a check run against a parsed CRL. It returns an error describing
+// the problem if the CRL fails it.
+type crlLint struct {
+	name  string
+	check func(crl *pkix.CertificateList) error
+}
+
+var ocspLints = []ocspLint{
+	{"e_ocsp_validity_period", checkOCSPValidityPeriod},
+	{"e_ocsp_status", checkOCSPStatus},
+	{"e_ocsp_revocation_time", checkOCSPRevocationTime},
+	{"e_ocsp_revocation_reason", checkOCSPRevocationReason},
+	{"e_ocsp_delegated_responder", checkOCSPDelegatedResponder},
+}
+
+var crlLints = []crlLint{
+	{"e_crl_version", checkCRLVersion},
+	{"e_crl_validity_period", checkCRLValidityPeriod},
+	{"e_crl_number", checkCRLNumber},
+	{"e_crl_authority_key_identifier", checkCRLAuthorityKeyIdentifier},
+	{"e_crl_issuing_distribution_point", checkCRLIssuingDistributionPoint},
+	{"e_crl_revocation_time", checkCRLRevocationTime},
+	{"e_crl_reason_code", checkCRLReasonCode},
+}
+
+// FailedLintsError is returned by RevocationLinter when an OCSP response or
+// CRL fails one or more lints.
+type FailedLintsError struct {
+	// Failures maps the name of each failed lint to the reason it failed.
+	Failures map[string]string
+}
+
+func (e *FailedLintsError) Error() string {
+	names := e.Names()
+	failures := make([]string, len(names))
+	for i, name := range names {
+		failures[i] = fmt.Sprintf("%s (%s)", name, e.Failures[name])
+	}
+	return fmt.Sprintf("failed lints: %s", strings.Join(failures, ", "))
+}
+
+// Names returns the names of the failed lints, sorted.
+func (e *FailedLintsError) Names() []string {
+	names := make([]string, 0, len(e.Failures))
+	for name := range e.Failures {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RevocationLinter lints OCSP responses and CRLs before they are signed, in
+// the same way that Linter lints certificates: by signing them with a
+// throwaway key and running Boulder's own lints against the result.
+type RevocationLinter struct {
+	signer crypto.Signer
+	skip   map[string]bool
+}
+
+// NewRevocationLinter returns a RevocationLinter whose throwaway key has the
+// same algorithm as realSigner. skipLints names any OCSP or CRL lints which
+// shouldn't be run.
+func NewRevocationLinter(realSigner crypto.Signer, skipLints []string) (*RevocationLinter, error) {
+	known := make(map[string]bool, len(ocspLints)+len(crlLints))
+	for _, l := range ocspLints {
+		known[l.name] = true
+	}
+	for _, l := range crlLints {
+		known[l.name] = true
+	}
+	skip := make(map[string]bool, len(skipLints))
+	for _, name := range skipLints {
+		if !known[name] {
+			return nil, fmt.Errorf("unknown OCSP or CRL lint %q", name)
+		}
+		skip[name] = true
+	}
+	signer, err := MakeSigner(realSigner)
+	if err != nil {
+		return nil, err
+	}
+	return &RevocationLinter{signer: signer, skip: skip}, nil
+}
+
+// LintOCSP lints the OCSP response which would be created from tbs for a
+// certificate issued by issuer. A delegated responder certificate in
+// tbs.Certificate is linted but not embedded in the throwaway response, since
+// the throwaway signature wouldn't verify against it.
+func (l RevocationLinter) LintOCSP(tbs ocsp.Response, issuer *x509.Certificate) error {
+	responderCert := tbs.Certificate
+	tbs.Certificate = nil
+	lintResp, err := ocsp.CreateResponse(issuer, issuer, tbs, l.signer)
+	if err != nil {
+		return fmt.Errorf("failed to create lint OCSP response: %w", err)
+	}
+	resp, err := ocsp.ParseResponse(lintResp, nil)
+	if err != nil {
+		return fmt.Errorf("failed to parse lint OCSP response: %w", err)
+	}
+	resp.Certificate = responderCert
+
+	failures := make(map[string]string)
+	for _, lint := range ocspLints {
+		if l.skip[lint.name] {
+			continue
+		}
+		err := lint.check(resp)
+		if err != nil {
+			failures[lint.name] = err.Error()
+		}
+	}
+	if len(failures) > 0 {
+		return &FailedLintsError{Failures: failures}
+	}
+	return nil
+}
+
+// LintCRL lints the CRL which would be created from tbs by issuer.
+func (l RevocationLinter) LintCRL(tbs *x509crl.RevocationList, issuer *x509.Certificate) error {
+	lintCRL, err := x509crl.CreateRevocationList(rand.Reader, tbs, issuer, l.signer)
+	if err != nil {
+		return fmt.Errorf("failed to create lint CRL: %w", err)
+	}
+	crl, err := x509.ParseCRL(lintCRL)
+	if err != nil {
+		return fmt.Errorf("failed to parse lint CRL: %w", err)
+	}
+
+	failures := make(map[string]string)
+	for _, lint := range crlLints {
+		if l.skip[lint.name] {
+			continue
+		}
+		err := lint.check(crl)
+		if err != nil {
+			failures[lint.name] = err.Error()
+		}
+	}
+	if len(failures) > 0 {
+		return &FailedLintsError{Failures: failures}
+	}
+	return nil
+}
+
+func checkValidityPeriod(thisUpdate, nextUpdate time.Time) error {
+	if nextUpdate.IsZero() {
+		return errors.New("nextUpdate is missing")
+	}
+	if !nextUpdate.After(thisUpdate) {
+		return errors.New("nextUpdate is not after thisUpdate")
+	}
+	if nextUpdate.Sub(thisUpdate) > maxRevocationValidity {
+		return fmt.Errorf("validity period is longer than %s", maxRevocationValidity)
+	}
+	return nil
+}
+
+// checkReason checks that a revocation reason is one which the CA may use
+// for a subscriber certificate: RFC 5280 doesn't define 7, removeFromCRL is
+// only for delta CRLs, and the Baseline Requirements (Section 7.2.2) forbid
+// certificateHold.
+func checkReason(reason int) error {
+	if _, ok := revocation.ReasonToString[revocation.Reason(reason)]; !ok {
+		return fmt.Errorf("unknown revocation reason %d", reason)
+	}
+	if reason == ocsp.CertificateHold || reason == ocsp.RemoveFromCRL {
+		return fmt.Errorf("revocation reason %s is not allowed", revocation.ReasonToString[revocation.Reason(reason)])
+	}
+	return nil
+}
+
+func checkOCSPValidityPeriod(resp *ocsp.Response) error {
+	return checkValidityPeriod(resp.ThisUpdate, resp.NextUpdate)
+}
+
+// checkOCSPStatus checks that the response is good or revoked: the CA only
+// signs responses for certificates it knows about.
+func checkOCSPStatus(resp *ocsp.Response) error {
+	if resp.Status != ocsp.Good && resp.Status != ocsp.Revoked {
+		return fmt.Errorf("status %d is neither good nor revoked", resp.Status)
+	}
+	return nil
+}
+
+// checkOCSPRevocationTime checks that a revoked response says when the
+// certificate was revoked. The CA truncates thisUpdate to the hour, so a
+// certificate revoked within the hour may have a revocationTime after
+// thisUpdate, but never after nextUpdate.
+func checkOCSPRevocationTime(resp *ocsp.Response) error {
+	if resp.Status != ocsp.Revoked {
+		return nil
+	}
+	if resp.RevokedAt.IsZero() {
+		return errors.New("revoked response has no revocationTime")
+	}
+	if resp.RevokedAt.After(resp.NextUpdate) {
+		return errors.New("revocationTime is after nextUpdate")
+	}
+	return nil
+}
+
+func checkOCSPRevocationReason(resp *ocsp.Response) error {
+	if resp.Status != ocsp.Revoked {
+		return nil
+	}
+	return checkReason(resp.RevocationReason)
+}
+
+// checkOCSPDelegatedResponder checks that an embedded responder certificate
+// is authorized to sign OCSP responses (RFC 6960 Section 4.2.2.2) and tells
+// clients not to check its own revocation status.
+func checkOCSPDelegatedResponder(resp *ocsp.Response) error {
+	if resp.Certificate == nil {
+		return nil
+	}
+	hasOCSPSigning := false
+	for _, eku := range resp.Certificate.ExtKeyUsage {
+		if eku == x509.ExtKeyUsageOCSPSigning {
+			hasOCSPSigning = true
+		}
+	}
+	if !hasOCSPSigning {
+		return errors.New("responder certificate lacks the id-kp-OCSPSigning extended key usage")
+	}
+	for _, ext := range resp.Certificate.Extensions {
+		if ext.Id.Equal(oidOCSPNoCheck) {
+			return nil
+		}
+	}
+	return errors.New("responder certificate lacks the id-pkix-ocsp-nocheck extension")
+}
+
+func findExtension(exts []pkix.Extension, id asn1.ObjectIdentifier) *pkix.Extension {
+	for i := range exts {
+		if exts[i].Id.Equal(id) {
+			return &exts[i]
+		}
+	}
+	return nil
+}
+
+// checkCRLVersion checks that the CRL is v2, which RFC 5280 Section 5.1.2.1
+// requires of CRLs with extensions.
+func checkCRLVersion(crl *pkix.CertificateList) error {
+	if crl.TBSCertList.Version != 1 {
+		return fmt.Errorf("CRL is version %d, not v2", crl.TBSCertList.Version+1)
+	}
+	return nil
+}
+
+func checkCRLValidityPeriod(crl *pkix.CertificateList) error {
+	return checkValidityPeriod(crl.TBSCertList.ThisUpdate, crl.TBSCertList.NextUpdate)
+}
+
+// checkCRLNumber checks for the non-critical CRL number extension, a
+// non-negative integer of at most 20 octets (RFC 5280 Section 5.2.3).
+func checkCRLNumber(crl *pkix.CertificateList) error {
+	ext := findExtension(crl.TBSCertList.Extensions, oidCRLNumber)
+	if ext == nil {
+		return errors.New("CRL number extension is missing")
+	}
+	if ext.Critical {
+		return errors.New("CRL number extension is critical")
+	}
+	var number *big.Int
+	rest, err := asn1.Unmarshal(ext.Value, &number)
+	if err != nil || len(rest) != 0 {
+		return errors.New("CRL number extension is malformed")
+	}
+	if number.Sign() < 0 {
+		return errors.New("CRL number is negative")
+	}
+	if len(number.Bytes()) > 20 {
+		return errors.New("CRL number is longer than 20 octets")
+	}
+	return nil
+}
+
+// checkCRLAuthorityKeyIdentifier checks for the non-critical authority key
+// identifier extension (RFC 5280 Section 5.2.1).
+func checkCRLAuthorityKeyIdentifier(crl *pkix.CertificateList) error {
+	ext := findExtension(crl.TBSCertList.Extensions, oidAuthorityKeyIdentifier)
+	if ext == nil {
+		return errors.New("authority key identifier extension is missing")
+	}
+	if ext.Critical {
+		return errors.New("authority key identifier extension is critical")
+	}
+	return nil
+}
+
+// checkCRLIssuingDistributionPoint checks for the critical issuing
+// distribution point extension. Boulder shards its CRLs, so without it a
+// relying party would take each shard to be complete for the whole issuer.
+func checkCRLIssuingDistributionPoint(crl *pkix.CertificateList) error {
+	ext := findExtension(crl.TBSCertList.Extensions, oidIssuingDistributionPoint)
+	if ext == nil {
+		return errors.New("issuing distribution point extension is missing")
+	}
+	if !ext.Critical {
+		return errors.New("issuing distribution point extension is not critical")
+	}
+	return nil
+}
+
+func checkCRLRevocationTime(crl *pkix.CertificateList) error {
+	for _, entry := range crl.TBSCertList.RevokedCertificates {
+		if entry.RevocationTime.After(crl.TBSCertList.ThisUpdate) {
+			return fmt.Errorf("entry %x was revoked after thisUpdate", entry.SerialNumber)
+		}
+	}
+	return nil
+}
+
+// checkCRLReasonCode checks that each entry's reason code, if it has one, is
+// non-critical and allowed. The unspecified reason must be expressed by
+// omitting the extension (BRs Section 7.2.2).
+func checkCRLReasonCode(crl *pkix.CertificateList) error {
+	for _, entry := range crl.TBSCertList.RevokedCertificates {
+		ext := findExtension(entry.Extensions, oidReasonCode)
+		if ext == nil {
+			continue
+		}
+		if ext.Critical {
+			return fmt.Errorf("entry %x has a critical reason code", entry.SerialNumber)
+		}
+		var reason asn1.Enumerated
+		rest, err := asn1.Unmarshal(ext.Value, &reason)
+		if err != nil || len(rest) != 0 {
+			return fmt.Errorf("entry %x has a malformed reason code", entry.SerialNumber)
+		}
+		if reason == ocsp.Unspecified {
+			return fmt.Errorf("entry %x has reason code unspecified", entry.SerialNumber)
+		}
+		err = checkReason(int(reason))
+		if err != nil {
+			return fmt.Errorf("entry %x: %s", entry.SerialNumber, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package lint
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/letsencrypt/boulder/test"
+	"github.com/letsencrypt/boulder/x509crl"
+)
+
+func makeIssuer(t *testing.T) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate issuer key")
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "lint issuer"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		SubjectKeyId:          []byte{1, 2, 3},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	test.AssertNotError(t, err, "failed to create issuer cert")
+	cert, err := x509.ParseCertificate(der)
+	test.AssertNotError(t, err, "failed to parse issuer cert")
+	return cert, key
+}
+
+func assertFailedLints(t *testing.T, err error, names ...string) {
+	t.Helper()
+	test.AssertError(t, err, "linting should have failed")
+	var lintErr *FailedLintsError
+	test.Assert(t, errors.As(err, &lintErr), "error isn't a FailedLintsError")
+	test.AssertDeepEquals(t, lintErr.Names(), names)
+}
+
+func TestNewRevocationLinter(t *testing.T) {
+	_, key := makeIssuer(t)
+	_, err := NewRevocationLinter(key, []string{"e_ocsp_status", "e_crl_number"})
+	test.AssertNotError(t, err, "NewRevocationLinter failed")
+	_, err = NewRevocationLinter(key, []string{"e_not_a_lint"})
+	test.AssertError(t, err, "NewRevocationLinter accepted an unknown lint")
+}
+
+func TestLintOCSP(t *testing.T) {
+	issuer, key := makeIssuer(t)
+	linter, err := NewRevocationLinter(key, nil)
+	test.AssertNotError(t, err, "NewRevocationLinter failed")
+
+	now := time.Now().Truncate(time.Hour)
+	good := ocsp.Response{
+		Status:       ocsp.Good,
+		SerialNumber: big.NewInt(10),
+		ThisUpdate:   now,
+		NextUpdate:   now.Add(96 * time.Hour),
+	}
+	test.AssertNotError(t, linter.LintOCSP(good, issuer), "good response failed linting")
+
+	revoked := good
+	revoked.Status = ocsp.Revoked
+	revoked.RevokedAt = now.Add(-time.Hour)
+	revoked.RevocationReason = ocsp.KeyCompromise
+	test.AssertNotError(t, linter.LintOCSP(revoked, issuer), "revoked response failed linting")
+
+	tooLong := good
+	tooLong.NextUpdate = now.Add(11 * 24 * time.Hour)
+	assertFailedLints(t, linter.LintOCSP(tooLong, issuer), "e_ocsp_validity_period")
+
+	badRevoked := revoked
+	badRevoked.RevokedAt = now.Add(97 * time.Hour)
+	badRevoked.RevocationReason = ocsp.CertificateHold
+	assertFailedLints(t, linter.LintOCSP(badRevoked, issuer), "e_ocsp_revocation_reason", "e_ocsp_revocation_time")
+
+	unknown := good
+	unknown.Status = ocsp.Unknown
+	assertFailedLints(t, linter.LintOCSP(unknown, issuer), "e_ocsp_status")
+
+	// A delegated responder must be authorized for OCSP signing.
+	delegated := good
+	delegated.Certificate = &x509.Certificate{ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}}
+	assertFailedLints(t, linter.LintOCSP(delegated, issuer), "e_ocsp_delegated_responder")
+	delegated.Certificate = &x509.Certificate{
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageOCSPSigning},
+		Extensions:  []pkix.Extension{{Id: oidOCSPNoCheck, Value: []byte{5, 0}}},
+	}
+	test.AssertNotError(t, linter.LintOCSP(delegated, issuer), "delegated response failed linting")
+
+	skipping, err := NewRevocationLinter(key, []string{"e_ocsp_validity_period"})
+	test.AssertNotError(t, err, "NewRevocationLinter failed")
+	test.AssertNotError(t, skipping.LintOCSP(tooLong, issuer), "skipped lint was run")
+}
+
+func TestLintCRL(t *testing.T) {
+	issuer, key := makeIssuer(t)
+	linter, err := NewRevocationLinter(key, nil)
+	test.AssertNotError(t, err, "NewRevocationLinter failed")
+
+	reason, err := asn1.Marshal(asn1.Enumerated(ocsp.KeyCompromise))
+	test.AssertNotError(t, err, "failed to marshal reason")
+	now := time.Now().Truncate(time.Hour)
+	idp := pkix.Extension{Id: oidIssuingDistributionPoint, Critical: true, Value: []byte{0x30, 0}}
+	template := func() *x509crl.RevocationList {
+		return &x509crl.RevocationList{
+			RevokedCertificates: []pkix.RevokedCertificate{
+				{SerialNumber: big.NewInt(10), RevocationTime: now.Add(-time.Hour)},
+				{
+					SerialNumber:   big.NewInt(11),
+					RevocationTime: now.Add(-time.Hour),
+					Extensions:     []pkix.Extension{{Id: oidReasonCode, Value: reason}},
+				},
+			},
+			Number:          big.NewInt(now.UnixNano()),
+			ThisUpdate:      now,
+			NextUpdate:      now.Add(24 * time.Hour),
+			ExtraExtensions: []pkix.Extension{idp},
+		}
+	}
+	test.AssertNotError(t, linter.LintCRL(template(), issuer), "good CRL failed linting")
+
+	noIDP := template()
+	noIDP.ExtraExtensions = nil
+	assertFailedLints(t, linter.LintCRL(noIDP, issuer), "e_crl_issuing_distribution_point")
+
+	tooLong := template()
+	tooLong.NextUpdate = now.Add(11 * 24 * time.Hour)
+	assertFailedLints(t, linter.LintCRL(tooLong, issuer), "e_crl_validity_period")
+
+	badEntries := template()
+	unspecified, err := asn1.Marshal(asn1.Enumerated(ocsp.Unspecified))
+	test.AssertNotError(t, err, "failed to marshal reason")
+	badEntries.RevokedCertificates[0].RevocationTime = now.Add(time.Hour)
+	badEntries.RevokedCertificates[1].Extensions[0].Value = unspecified
+	assertFailedLints(t, linter.LintCRL(badEntries, issuer), "e_crl_reason_code", "e_crl_revocation_time")
+
+	bigNumber := template()
+	bigNumber.Number = new(big.Int).Lsh(big.NewInt(1), 160)
+	assertFailedLints(t, linter.LintCRL(bigNumber, issuer), "e_crl_number")
+}
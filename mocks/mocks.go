@@ -328,6 +328,22 @@ func (sa *StorageAuthority) GetCertificateStatus(_ context.Context, serial strin
 	}
 }
 
+// GetCertificateAndStatus is a mock
+func (sa *StorageAuthority) GetCertificateAndStatus(ctx context.Context, req *sapb.Serial) (*sapb.CertificateAndStatus, error) {
+	cert, err := sa.GetCertificate(ctx, req.Serial)
+	if err != nil {
+		return nil, err
+	}
+	status, err := sa.GetCertificateStatus(ctx, req.Serial)
+	if err != nil {
+		return nil, err
+	}
+	return &sapb.CertificateAndStatus{
+		Certificate: bgrpc.CertToPB(cert),
+		Status:      bgrpc.CertStatusToPB(status),
+	}, nil
+}
+
 // AddPrecertificate is a mock
 func (sa *StorageAuthority) AddPrecertificate(ctx context.Context, req *sapb.AddCertificateRequest) (empty *corepb.Empty, err error) {
 	return
@@ -440,8 +456,8 @@ func (sa *StorageAuthority) CountPendingAuthorizations(_ context.Context, _ int6
 }
 
 // CountOrders is a mock
-func (sa *StorageAuthority) CountOrders(_ context.Context, _ int64, _, _ time.Time) (int, error) {
-	return 0, nil
+func (sa *StorageAuthority) CountOrders(_ context.Context, _ int64, _, _ time.Time) (int, time.Time, error) {
+	return 0, time.Time{}, nil
 }
 
 // DeactivateAuthorization is a mock
@@ -533,6 +549,21 @@ func (sa *StorageAuthority) GetOrderForNames(_ context.Context, _ *sapb.GetOrder
 	return nil, nil
 }
 
+// GetOrdersForAccount is a mock. Account 1 has orders 1 through 5, and all
+// other accounts have none.
+func (sa *StorageAuthority) GetOrdersForAccount(_ context.Context, req *sapb.GetOrdersForAccountRequest) (*sapb.OrderIDs, error) {
+	resp := &sapb.OrderIDs{}
+	if req.AcctID != 1 {
+		return resp, nil
+	}
+	for id := int64(5); id > 0 && int64(len(resp.Ids)) < req.Limit; id-- {
+		if req.Cursor == 0 || id < req.Cursor {
+			resp.Ids = append(resp.Ids, id)
+		}
+	}
+	return resp, nil
+}
+
 func (sa *StorageAuthority) GetValidOrderAuthorizations(_ context.Context, _ *sapb.GetValidOrderAuthorizationsRequest) (map[string]*core.Authorization, error) {
 	return nil, nil
 }
@@ -690,6 +721,82 @@ func (sa *StorageAuthority) KeyBlocked(ctx context.Context, req *sapb.KeyBlocked
 	return &sapb.Exists{Exists: false}, nil
 }
 
+// ExternalAccountHMACKey is the HMAC key of all the mock StorageAuthority's
+// external account binding keys
+var ExternalAccountHMACKey = []byte("0123456789abcdef0123456789abcdef")
+
+// GetExternalAccountKey is a mock. The key IDs "valid", "revoked", and "bound"
+// return keys in the corresponding states; any other key ID is not found.
+func (sa *StorageAuthority) GetExternalAccountKey(_ context.Context, req *sapb.ExternalAccountKeyID) (*sapb.ExternalAccountKey, error) {
+	key := &sapb.ExternalAccountKey{
+		KeyID:     req.KeyID,
+		HmacKey:   ExternalAccountHMACKey,
+		CreatedAt: sa.clk.Now().Add(-time.Hour).UnixNano(),
+	}
+	switch req.KeyID {
+	case "valid":
+		return key, nil
+	case "revoked":
+		key.RevokedAt = sa.clk.Now().Add(-time.Minute).UnixNano()
+		return key, nil
+	case "bound":
+		key.RegistrationID = 1
+		return key, nil
+	default:
+		return nil, berrors.NotFoundError("external account key %q not found", req.KeyID)
+	}
+}
+
+// AddExternalAccountKey is a mock
+func (sa *StorageAuthority) AddExternalAccountKey(_ context.Context, _ *sapb.AddExternalAccountKeyRequest) (*corepb.Empty, error) {
+	return &corepb.Empty{}, nil
+}
+
+// RevokeExternalAccountKey is a mock
+func (sa *StorageAuthority) RevokeExternalAccountKey(_ context.Context, _ *sapb.ExternalAccountKeyID) (*corepb.Empty, error) {
+	return &corepb.Empty{}, nil
+}
+
+// UpdateOCSPResponse is a mock
+func (sa *StorageAuthority) UpdateOCSPResponse(_ context.Context, _ *sapb.UpdateOCSPResponseRequest) (*corepb.Empty, error) {
+	return &corepb.Empty{}, nil
+}
+
+// AddSCT is a mock
+func (sa *StorageAuthority) AddSCT(_ context.Context, _ *sapb.SCT) (*corepb.Empty, error) {
+	return &corepb.Empty{}, nil
+}
+
+// GetSCTs is a mock
+func (sa *StorageAuthority) GetSCTs(_ context.Context, _ *sapb.Serial) (*sapb.SCTs, error) {
+	return &sapb.SCTs{}, nil
+}
+
+// GetRecentSCTs is a mock
+func (sa *StorageAuthority) GetRecentSCTs(_ context.Context, _ *sapb.GetRecentSCTsRequest) (*sapb.SCTs, error) {
+	return &sapb.SCTs{}, nil
+}
+
+// AddFinalCertSubmissions is a mock
+func (sa *StorageAuthority) AddFinalCertSubmissions(_ context.Context, _ *sapb.AddFinalCertSubmissionsRequest) (*corepb.Empty, error) {
+	return &corepb.Empty{}, nil
+}
+
+// GetFinalCertSubmissions is a mock
+func (sa *StorageAuthority) GetFinalCertSubmissions(_ context.Context, _ *sapb.GetFinalCertSubmissionsRequest) (*sapb.FinalCertSubmissions, error) {
+	return &sapb.FinalCertSubmissions{}, nil
+}
+
+// RescheduleFinalCertSubmission is a mock
+func (sa *StorageAuthority) RescheduleFinalCertSubmission(_ context.Context, _ *sapb.FinalCertSubmission) (*corepb.Empty, error) {
+	return &corepb.Empty{}, nil
+}
+
+// RemoveFinalCertSubmission is a mock
+func (sa *StorageAuthority) RemoveFinalCertSubmission(_ context.Context, _ *sapb.FinalCertSubmissionID) (*corepb.Empty, error) {
+	return &corepb.Empty{}, nil
+}
+
 // Publisher is a mock
 type PublisherClient struct {
 	// empty
@@ -0,0 +1,175 @@
+package ocsp
+
+import (
+	"bytes"
+	"container/list"
+	"errors"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/crypto/ocsp"
+)
+
+// CachingSource wraps another Source with a bounded, in-memory LRU cache of
+// its responses, keyed by serial number. Each response is cached until a
+// configurable fraction of its validity interval has passed, so that a small
+// set of frequently requested serials doesn't have to be looked up in the
+// wrapped Source every time.
+//
+// Only successful responses are cached. Since a cached response would
+// otherwise keep being served after its certificate is revoked, callers which
+// learn of a revocation must Invalidate the certificate's serial.
+type CachingSource struct {
+	inner      Source
+	maxEntries int
+	// lifetimeFraction is the fraction of each response's validity interval,
+	// from its ThisUpdate, for which it is cached.
+	lifetimeFraction float64
+	clk              clock.Clock
+
+	mu sync.Mutex
+	// lru holds *cacheEntry values, most recently used first.
+	lru     *list.List
+	entries map[string]*list.Element
+
+	lookups       *prometheus.CounterVec
+	invalidations prometheus.Counter
+}
+
+type cacheEntry struct {
+	serial string
+	// issuerKeyHash is the issuerKeyHash of the request the response was
+	// looked up for. Requests with a different one aren't served from the
+	// cache, since the wrapped Source might have refused them.
+	issuerKeyHash []byte
+	response      []byte
+	header        http.Header
+	expires       time.Time
+}
+
+// NewCachingSource returns a CachingSource which caches up to maxEntries of
+// inner's responses, each for lifetimeFraction of its validity interval. A
+// lifetimeFraction of 1 caches responses until their NextUpdate.
+func NewCachingSource(inner Source, maxEntries int, lifetimeFraction float64, clk clock.Clock, stats prometheus.Registerer) (*CachingSource, error) {
+	if maxEntries <= 0 {
+		return nil, errors.New("OCSP cache size must be positive")
+	}
+	if lifetimeFraction <= 0 || lifetimeFraction > 1 {
+		return nil, errors.New("OCSP cache lifetime fraction must be in (0, 1]")
+	}
+
+	lookups := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ocsp_cache_lookups",
+		Help: "Number of OCSP response cache lookups, labelled by result (hit or miss)",
+	}, []string{"result"})
+	stats.MustRegister(lookups)
+	invalidations := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "ocsp_cache_invalidations",
+		Help: "Number of cached OCSP responses removed by explicit invalidation",
+	})
+	stats.MustRegister(invalidations)
+
+	return &CachingSource{
+		inner:            inner,
+		maxEntries:       maxEntries,
+		lifetimeFraction: lifetimeFraction,
+		clk:              clk,
+		lru:              list.New(),
+		entries:          make(map[string]*list.Element),
+		lookups:          lookups,
+		invalidations:    invalidations,
+	}, nil
+}
+
+// Response returns the cached response for the request's serial if there is
+// an unexpired one, and otherwise looks the response up in the wrapped Source
+// and caches it.
+func (cs *CachingSource) Response(req *ocsp.Request) ([]byte, http.Header, error) {
+	serial := req.SerialNumber.String()
+	resp, header, ok := cs.get(serial, req.IssuerKeyHash)
+	if ok {
+		cs.lookups.WithLabelValues("hit").Inc()
+		return resp, header, nil
+	}
+	cs.lookups.WithLabelValues("miss").Inc()
+
+	resp, header, err := cs.inner.Response(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	cs.add(serial, req.IssuerKeyHash, resp, header)
+	return resp, header, nil
+}
+
+// Invalidate removes any cached response for the given serial, so that the
+// next request for it is looked up in the wrapped Source.
+func (cs *CachingSource) Invalidate(serial *big.Int) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	if elem, ok := cs.entries[serial.String()]; ok {
+		cs.remove(elem)
+		cs.invalidations.Inc()
+	}
+}
+
+// get returns the cached response for the serial, if there is one which
+// hasn't expired and was looked up for the same issuerKeyHash.
+func (cs *CachingSource) get(serial string, issuerKeyHash []byte) ([]byte, http.Header, bool) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	elem, ok := cs.entries[serial]
+	if !ok {
+		return nil, nil, false
+	}
+	entry := elem.Value.(*cacheEntry)
+	if !cs.clk.Now().Before(entry.expires) {
+		cs.remove(elem)
+		return nil, nil, false
+	}
+	if !bytes.Equal(entry.issuerKeyHash, issuerKeyHash) {
+		return nil, nil, false
+	}
+	cs.lru.MoveToFront(elem)
+	return entry.response, entry.header, true
+}
+
+// add caches the response, evicting the least recently used response if the
+// cache is full. Responses which can't be parsed, or whose cache lifetime has
+// already passed, aren't cached.
+func (cs *CachingSource) add(serial string, issuerKeyHash []byte, resp []byte, header http.Header) {
+	parsed, err := ocsp.ParseResponse(resp, nil)
+	if err != nil || parsed.NextUpdate.IsZero() {
+		return
+	}
+	lifetime := time.Duration(float64(parsed.NextUpdate.Sub(parsed.ThisUpdate)) * cs.lifetimeFraction)
+	expires := parsed.ThisUpdate.Add(lifetime)
+	if !cs.clk.Now().Before(expires) {
+		return
+	}
+
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	if elem, ok := cs.entries[serial]; ok {
+		cs.remove(elem)
+	}
+	cs.entries[serial] = cs.lru.PushFront(&cacheEntry{
+		serial:        serial,
+		issuerKeyHash: issuerKeyHash,
+		response:      resp,
+		header:        header,
+		expires:       expires,
+	})
+	for cs.lru.Len() > cs.maxEntries {
+		cs.remove(cs.lru.Back())
+	}
+}
+
+// remove deletes the element from the cache. cs.mu must be held.
+func (cs *CachingSource) remove(elem *list.Element) {
+	cs.lru.Remove(elem)
+	delete(cs.entries, elem.Value.(*cacheEntry).serial)
+}
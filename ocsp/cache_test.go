@@ -0,0 +1,167 @@
+package ocsp
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	goocsp "golang.org/x/crypto/ocsp"
+
+	"github.com/letsencrypt/boulder/test"
+)
+
+// countingSource returns the configured response for every serial and counts
+// how often it is asked.
+type countingSource struct {
+	responses map[string][]byte
+	calls     int
+}
+
+func (src *countingSource) Response(req *goocsp.Request) ([]byte, http.Header, error) {
+	src.calls++
+	resp, ok := src.responses[req.SerialNumber.String()]
+	if !ok {
+		return nil, nil, ErrNotFound
+	}
+	return resp, nil, nil
+}
+
+func makeResponse(t *testing.T, serial int64, thisUpdate, nextUpdate time.Time) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "Failed to generate key")
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test issuer"},
+		NotBefore:    thisUpdate.Add(-time.Hour),
+		NotAfter:     nextUpdate.Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	test.AssertNotError(t, err, "Failed to create issuer")
+	issuer, err := x509.ParseCertificate(der)
+	test.AssertNotError(t, err, "Failed to parse issuer")
+	resp, err := goocsp.CreateResponse(issuer, issuer, goocsp.Response{
+		SerialNumber: big.NewInt(serial),
+		Status:       goocsp.Good,
+		ThisUpdate:   thisUpdate,
+		NextUpdate:   nextUpdate,
+	}, key)
+	test.AssertNotError(t, err, "Failed to create OCSP response")
+	return resp
+}
+
+func setupCache(t *testing.T, maxEntries int) (*CachingSource, *countingSource, clock.FakeClock) {
+	t.Helper()
+	fc := clock.NewFake()
+	fc.Set(time.Date(2021, 4, 10, 0, 0, 0, 0, time.UTC))
+	inner := &countingSource{responses: make(map[string][]byte)}
+	for i := int64(1); i <= 3; i++ {
+		inner.responses[big.NewInt(i).String()] = makeResponse(t, i, fc.Now(), fc.Now().Add(96*time.Hour))
+	}
+	cs, err := NewCachingSource(inner, maxEntries, 0.5, fc, prometheus.NewRegistry())
+	test.AssertNotError(t, err, "NewCachingSource failed")
+	return cs, inner, fc
+}
+
+func request(serial int64) *goocsp.Request {
+	return &goocsp.Request{SerialNumber: big.NewInt(serial), IssuerKeyHash: []byte{1}}
+}
+
+func TestNewCachingSourceValidation(t *testing.T) {
+	_, err := NewCachingSource(&countingSource{}, 0, 0.5, clock.NewFake(), prometheus.NewRegistry())
+	test.AssertError(t, err, "NewCachingSource should fail without entries")
+	_, err = NewCachingSource(&countingSource{}, 10, 0, clock.NewFake(), prometheus.NewRegistry())
+	test.AssertError(t, err, "NewCachingSource should fail without a lifetime")
+	_, err = NewCachingSource(&countingSource{}, 10, 1.5, clock.NewFake(), prometheus.NewRegistry())
+	test.AssertError(t, err, "NewCachingSource should fail with a lifetime past NextUpdate")
+}
+
+func TestCachingSourceHitAndExpiry(t *testing.T) {
+	cs, inner, fc := setupCache(t, 10)
+
+	for i := 0; i < 3; i++ {
+		resp, _, err := cs.Response(request(1))
+		test.AssertNotError(t, err, "Response failed")
+		test.AssertByteEquals(t, resp, inner.responses["1"])
+	}
+	test.AssertEquals(t, inner.calls, 1)
+	test.AssertEquals(t, test.CountCounterVec("result", "hit", cs.lookups), 2)
+	test.AssertEquals(t, test.CountCounterVec("result", "miss", cs.lookups), 1)
+
+	// Responses are cached for half of their 96 hour validity interval.
+	fc.Add(47 * time.Hour)
+	_, _, err := cs.Response(request(1))
+	test.AssertNotError(t, err, "Response failed")
+	test.AssertEquals(t, inner.calls, 1)
+	fc.Add(time.Hour)
+	_, _, err = cs.Response(request(1))
+	test.AssertNotError(t, err, "Response failed")
+	test.AssertEquals(t, inner.calls, 2)
+
+	// A request with a different issuerKeyHash isn't served from the cache.
+	req := request(2)
+	_, _, err = cs.Response(req)
+	test.AssertNotError(t, err, "Response failed")
+	req.IssuerKeyHash = []byte{2}
+	_, _, err = cs.Response(req)
+	test.AssertNotError(t, err, "Response failed")
+	test.AssertEquals(t, inner.calls, 4)
+
+	// Errors aren't cached.
+	_, _, err = cs.Response(request(4))
+	test.AssertErrorIs(t, err, ErrNotFound)
+	_, _, err = cs.Response(request(4))
+	test.AssertErrorIs(t, err, ErrNotFound)
+	test.AssertEquals(t, inner.calls, 6)
+}
+
+func TestCachingSourceEviction(t *testing.T) {
+	cs, inner, _ := setupCache(t, 2)
+
+	for _, serial := range []int64{1, 2, 1, 3} {
+		_, _, err := cs.Response(request(serial))
+		test.AssertNotError(t, err, "Response failed")
+	}
+	test.AssertEquals(t, inner.calls, 3)
+	test.AssertEquals(t, cs.lru.Len(), 2)
+
+	// Serial 2 was the least recently used, so adding 3 evicted it.
+	_, _, err := cs.Response(request(1))
+	test.AssertNotError(t, err, "Response failed")
+	test.AssertEquals(t, inner.calls, 3)
+	_, _, err = cs.Response(request(2))
+	test.AssertNotError(t, err, "Response failed")
+	test.AssertEquals(t, inner.calls, 4)
+}
+
+func TestCachingSourceInvalidate(t *testing.T) {
+	cs, inner, _ := setupCache(t, 10)
+
+	_, _, err := cs.Response(request(1))
+	test.AssertNotError(t, err, "Response failed")
+	cs.Invalidate(big.NewInt(1))
+	cs.Invalidate(big.NewInt(2))
+	test.AssertEquals(t, test.CountCounter(cs.invalidations), 1)
+
+	_, _, err = cs.Response(request(1))
+	test.AssertNotError(t, err, "Response failed")
+	test.AssertEquals(t, inner.calls, 2)
+}
+
+func TestCachingSourceSkipsUnparseable(t *testing.T) {
+	cs, inner, _ := setupCache(t, 10)
+	inner.responses["1"] = []byte("not a response")
+	for i := 0; i < 2; i++ {
+		_, _, err := cs.Response(request(1))
+		test.AssertNotError(t, err, "Response failed")
+	}
+	test.AssertEquals(t, inner.calls, 2)
+}
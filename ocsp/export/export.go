@@ -0,0 +1,100 @@
+// Package export writes signed OCSP responses to a blob store, at the paths
+// from which RFC 5019 GET requests would fetch them, so that they can be
+// served from a static origin with no database behind it.
+package export
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/issuance"
+)
+
+// cacheControl is the Cache-Control header stored with every exported
+// response. It deliberately has no max-age: the metadata is written once but
+// served for the life of the response, so any max-age computed at export time
+// would let caches keep the response past its NextUpdate. Caches instead use
+// the Expires header, which is fixed at NextUpdate.
+const cacheControl = "public, no-transform, must-revalidate"
+
+// Metadata is the HTTP metadata stored alongside an exported response, for
+// the static origin to serve with it.
+type Metadata struct {
+	ContentType  string
+	CacheControl string
+	LastModified time.Time
+	Expires      time.Time
+}
+
+// Store is a blob store which responses can be exported to.
+type Store interface {
+	// Put stores body under key, replacing any existing object. Keys are
+	// slash-separated paths without a leading slash.
+	Put(ctx context.Context, key string, body []byte, meta Metadata) error
+}
+
+// Exporter exports OCSP responses to a Store.
+type Exporter struct {
+	store   Store
+	issuers map[issuance.IssuerID]*issuance.Certificate
+}
+
+// New returns an Exporter which writes the responses of certificates issued
+// by any of the given issuers to store.
+func New(store Store, issuers []*issuance.Certificate) *Exporter {
+	issuersByID := make(map[issuance.IssuerID]*issuance.Certificate, len(issuers))
+	for _, issuer := range issuers {
+		issuersByID[issuer.ID()] = issuer
+	}
+	return &Exporter{
+		store:   store,
+		issuers: issuersByID,
+	}
+}
+
+// Export writes the response for the certificate with the given issuer ID
+// and serial to the store, keyed by its RFC 5019 GET path.
+func (e *Exporter) Export(ctx context.Context, issuerID int64, serial string, response []byte) error {
+	issuer, ok := e.issuers[issuance.IssuerID(issuerID)]
+	if !ok {
+		return fmt.Errorf("no issuer with ID %d", issuerID)
+	}
+	serialInt, err := core.StringToSerial(serial)
+	if err != nil {
+		return err
+	}
+	key, err := Key(issuer.Certificate, serialInt)
+	if err != nil {
+		return err
+	}
+	parsed, err := ocsp.ParseResponse(response, nil)
+	if err != nil {
+		return fmt.Errorf("parsing OCSP response: %w", err)
+	}
+	return e.store.Put(ctx, key, response, Metadata{
+		ContentType:  "application/ocsp-response",
+		CacheControl: cacheControl,
+		LastModified: parsed.ThisUpdate,
+		Expires:      parsed.NextUpdate,
+	})
+}
+
+// Key returns the path at which RFC 5019 clients request the response for
+// the certificate with the given issuer and serial: the base64 encoding of
+// the DER of a request using SHA-1 hashes and no extensions. Since standard
+// base64 may contain '/', a key may span several path segments.
+func Key(issuer *x509.Certificate, serial *big.Int) (string, error) {
+	// ocsp.CreateRequest only uses the certificate's serial number.
+	req, err := ocsp.CreateRequest(&x509.Certificate{SerialNumber: serial}, issuer, nil)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(req), nil
+}
@@ -0,0 +1,111 @@
+package export
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/issuance"
+	"github.com/letsencrypt/boulder/test"
+)
+
+type putCall struct {
+	key  string
+	body []byte
+	meta Metadata
+}
+
+type fakeStore struct {
+	puts []putCall
+}
+
+func (fs *fakeStore) Put(_ context.Context, key string, body []byte, meta Metadata) error {
+	fs.puts = append(fs.puts, putCall{key, body, meta})
+	return nil
+}
+
+func makeIssuer(t *testing.T) (*issuance.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate key")
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "export test issuer"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, k.Public(), k)
+	test.AssertNotError(t, err, "failed to create issuer certificate")
+	cert, err := x509.ParseCertificate(der)
+	test.AssertNotError(t, err, "failed to parse issuer certificate")
+	return &issuance.Certificate{Certificate: cert}, k
+}
+
+func TestKey(t *testing.T) {
+	issuer, k := makeIssuer(t)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(0xdeadbeef),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer.Certificate, k.Public(), k)
+	test.AssertNotError(t, err, "failed to create certificate")
+	cert, err := x509.ParseCertificate(der)
+	test.AssertNotError(t, err, "failed to parse certificate")
+
+	// The key should be the GET path of the request a client would build from
+	// the certificate itself.
+	req, err := ocsp.CreateRequest(cert, issuer.Certificate, nil)
+	test.AssertNotError(t, err, "failed to create request")
+	key, err := Key(issuer.Certificate, cert.SerialNumber)
+	test.AssertNotError(t, err, "Key failed")
+	test.AssertEquals(t, key, base64.StdEncoding.EncodeToString(req))
+}
+
+func TestExport(t *testing.T) {
+	issuer, k := makeIssuer(t)
+	store := &fakeStore{}
+	e := New(store, []*issuance.Certificate{issuer})
+
+	serial := big.NewInt(0xdeadbeef)
+	thisUpdate := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	resp, err := ocsp.CreateResponse(issuer.Certificate, issuer.Certificate, ocsp.Response{
+		SerialNumber: serial,
+		Status:       ocsp.Good,
+		ThisUpdate:   thisUpdate,
+		NextUpdate:   thisUpdate.Add(96 * time.Hour),
+	}, k)
+	test.AssertNotError(t, err, "failed to create response")
+
+	err = e.Export(context.Background(), int64(issuer.ID()), core.SerialToString(serial), resp)
+	test.AssertNotError(t, err, "Export failed")
+	test.AssertEquals(t, len(store.puts), 1)
+	put := store.puts[0]
+	expectedKey, err := Key(issuer.Certificate, serial)
+	test.AssertNotError(t, err, "Key failed")
+	test.AssertEquals(t, put.key, expectedKey)
+	test.AssertByteEquals(t, put.body, resp)
+	test.AssertEquals(t, put.meta.ContentType, "application/ocsp-response")
+	test.AssertEquals(t, put.meta.CacheControl, "public, no-transform, must-revalidate")
+	test.AssertEquals(t, put.meta.LastModified, thisUpdate)
+	test.AssertEquals(t, put.meta.Expires, thisUpdate.Add(96*time.Hour))
+
+	err = e.Export(context.Background(), int64(issuer.ID())+1, core.SerialToString(serial), resp)
+	test.AssertError(t, err, "Export succeeded with an unknown issuer")
+	err = e.Export(context.Background(), int64(issuer.ID()), core.SerialToString(serial), []byte{1, 2, 3})
+	test.AssertError(t, err, "Export succeeded with a malformed response")
+	test.AssertEquals(t, len(store.puts), 1)
+}
@@ -0,0 +1,69 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FilesystemStore is a Store which writes each object to a file under a
+// directory. Since files carry no HTTP metadata, the server of the directory
+// is responsible for the headers it serves them with; only the modification
+// time is set, from Metadata.LastModified.
+type FilesystemStore struct {
+	dir string
+}
+
+// NewFilesystemStore returns a FilesystemStore which writes under dir, which
+// must already exist.
+func NewFilesystemStore(dir string) (*FilesystemStore, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, errors.New("export directory is not a directory")
+	}
+	return &FilesystemStore{dir: dir}, nil
+}
+
+// Put writes body to the file at key, relative to the store's directory.
+// The file is replaced atomically, so readers never see a partial response.
+func (fs *FilesystemStore) Put(_ context.Context, key string, body []byte, meta Metadata) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "..") {
+		return errors.New("invalid export key")
+	}
+	path := filepath.Join(fs.dir, filepath.FromSlash(key))
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(body)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+	err = os.Chmod(tmp.Name(), 0644)
+	if err != nil {
+		return err
+	}
+	if !meta.LastModified.IsZero() {
+		err = os.Chtimes(tmp.Name(), meta.LastModified, meta.LastModified)
+		if err != nil {
+			return err
+		}
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,55 @@
+package export
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestFilesystemStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ocsp-export")
+	test.AssertNotError(t, err, "failed to create temp dir")
+	defer os.RemoveAll(dir)
+
+	_, err = NewFilesystemStore(filepath.Join(dir, "missing"))
+	test.AssertError(t, err, "NewFilesystemStore succeeded with a missing directory")
+
+	fs, err := NewFilesystemStore(dir)
+	test.AssertNotError(t, err, "NewFilesystemStore failed")
+
+	lastModified := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	key := "MFMwUTBPME0wSzAJBgUrDgMCGgUABBQ/ab+c="
+	err = fs.Put(context.Background(), key, []byte{1, 2, 3}, Metadata{LastModified: lastModified})
+	test.AssertNotError(t, err, "Put failed")
+
+	// Keys containing '/' are written to nested directories, as a static file
+	// server would look them up.
+	path := filepath.Join(dir, "MFMwUTBPME0wSzAJBgUrDgMCGgUABBQ", "ab+c=")
+	contents, err := ioutil.ReadFile(path)
+	test.AssertNotError(t, err, "failed to read exported response")
+	test.AssertByteEquals(t, contents, []byte{1, 2, 3})
+	info, err := os.Stat(path)
+	test.AssertNotError(t, err, "failed to stat exported response")
+	test.Assert(t, info.ModTime().Equal(lastModified), "modification time wasn't set from LastModified")
+
+	// Putting the same key again replaces the response, leaving no temporary
+	// files behind.
+	err = fs.Put(context.Background(), key, []byte{4, 5}, Metadata{})
+	test.AssertNotError(t, err, "Put failed")
+	contents, err = ioutil.ReadFile(path)
+	test.AssertNotError(t, err, "failed to read exported response")
+	test.AssertByteEquals(t, contents, []byte{4, 5})
+	entries, err := ioutil.ReadDir(filepath.Dir(path))
+	test.AssertNotError(t, err, "failed to read directory")
+	test.AssertEquals(t, len(entries), 1)
+
+	for _, key := range []string{"", "/abs", "a/../../b"} {
+		err = fs.Put(context.Background(), key, []byte{1}, Metadata{})
+		test.AssertError(t, err, "Put succeeded with an invalid key")
+	}
+}
@@ -0,0 +1,180 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jmhodges/clock"
+)
+
+// S3Store is a Store which uploads each object to a bucket of an
+// S3-compatible object store, with its Metadata as the object's HTTP headers.
+// Requests are path-style and signed with AWS Signature Version 4.
+type S3Store struct {
+	endpoint        *url.URL
+	region          string
+	bucket          string
+	accessKeyID     string
+	secretAccessKey string
+	client          *http.Client
+	clk             clock.Clock
+}
+
+// NewS3Store returns an S3Store which uploads to the named bucket at the
+// given endpoint, e.g. "https://s3.us-east-1.amazonaws.com".
+func NewS3Store(endpoint, region, bucket, accessKeyID, secretAccessKey string, client *http.Client, clk clock.Clock) (*S3Store, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing S3 endpoint: %w", err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, errors.New("S3 endpoint must be an absolute URL")
+	}
+	if region == "" || bucket == "" || accessKeyID == "" || secretAccessKey == "" {
+		return nil, errors.New("S3 region, bucket and credentials are required")
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &S3Store{
+		endpoint:        u,
+		region:          region,
+		bucket:          bucket,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		client:          client,
+		clk:             clk,
+	}, nil
+}
+
+// Put uploads body to the bucket under key with a PutObject request.
+func (s *S3Store) Put(ctx context.Context, key string, body []byte, meta Metadata) error {
+	u := *s.endpoint
+	base := strings.TrimSuffix(u.EscapedPath(), "/")
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.bucket + "/" + key
+	u.RawPath = base + "/" + uriEncode(s.bucket, false) + "/" + uriEncode(key, false)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if meta.ContentType != "" {
+		req.Header.Set("Content-Type", meta.ContentType)
+	}
+	if meta.CacheControl != "" {
+		req.Header.Set("Cache-Control", meta.CacheControl)
+	}
+	if !meta.Expires.IsZero() {
+		req.Header.Set("Expires", meta.Expires.UTC().Format(http.TimeFormat))
+	}
+	payloadHash := sha256.Sum256(body)
+	signV4(req, hex.EncodeToString(payloadHash[:]), s.accessKeyID, s.secretAccessKey, s.region, "s3", s.clk.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("S3 PutObject returned %d: %s", resp.StatusCode, respBody)
+	}
+	return nil
+}
+
+// signV4 adds the headers of an AWS Signature Version 4 to req, signing the
+// host and all of the headers already set on it.
+func signV4(req *http.Request, payloadHash, accessKeyID, secretAccessKey, region, service string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	scope := date + "/" + region + "/" + service + "/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// canonicalQuery returns the query string of a signed request: its
+// parameters sorted by name and then value, each URI-encoded.
+func canonicalQuery(query url.Values) string {
+	var params []string
+	for name, values := range query {
+		for _, value := range values {
+			params = append(params, uriEncode(name, true)+"="+uriEncode(value, true))
+		}
+	}
+	sort.Strings(params)
+	return strings.Join(params, "&")
+}
+
+// uriEncode percent-encodes every byte of s except the unreserved characters
+// of RFC 3986, and '/' unless encodeSlash is set, as Signature Version 4
+// requires.
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
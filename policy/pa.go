@@ -15,8 +15,10 @@ import (
 	"golang.org/x/net/idna"
 	"golang.org/x/text/unicode/norm"
 
+	"github.com/letsencrypt/boulder/bdns"
 	"github.com/letsencrypt/boulder/core"
 	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/features"
 	"github.com/letsencrypt/boulder/iana"
 	"github.com/letsencrypt/boulder/identifier"
 	blog "github.com/letsencrypt/boulder/log"
@@ -199,6 +201,9 @@ var (
 	errMalformedWildcard    = berrors.MalformedError("Domain name contains an invalid wildcard. A wildcard is only permitted before the first dot in a domain name")
 	errICANNTLDWildcard     = berrors.MalformedError("Domain name is a wildcard for an ICANN TLD")
 	errWildcardNotSupported = berrors.MalformedError("Wildcard domain names are not supported")
+	errInvalidIP            = berrors.MalformedError("IP address is invalid")
+	errNonCanonicalIP       = berrors.MalformedError("IP address is not in its canonical textual form")
+	errReservedIP           = berrors.RejectedIdentifierError("IP address is in a reserved address block")
 )
 
 // ValidDomain checks that a domain isn't:
@@ -336,9 +341,8 @@ func ValidEmail(address string) error {
 // identifier. It expects domains in id to be lowercase to prevent mismatched
 // cases breaking queries.
 //
-// We place several criteria on identifiers we are willing to issue for:
+// We place several criteria on DNS identifiers we are willing to issue for:
 //
-//  * MUST self-identify as DNS identifiers
 //  * MUST contain only bytes in the DNS hostname character set
 //  * MUST NOT have more than maxLabels labels
 //  * MUST follow the DNS hostname syntax rules in RFC 1035 and RFC 2181
@@ -350,9 +354,16 @@ func ValidEmail(address string) error {
 //  * MUST NOT be a label-wise suffix match for a name on the block list,
 //    where comparison is case-independent (normalized to lower case)
 //
+// When the IPIdentifiers feature is enabled IP identifiers are also accepted,
+// subject to the criteria described on willingToIssueIP. Any other identifier
+// type is rejected.
+//
 // If WillingToIssue returns an error, it will be of type MalformedRequestError
 // or RejectedIdentifierError
 func (pa *AuthorityImpl) WillingToIssue(id identifier.ACMEIdentifier) error {
+	if id.Type == identifier.IP && features.Enabled(features.IPIdentifiers) {
+		return pa.willingToIssueIP(id.Value)
+	}
 	if id.Type != identifier.DNS {
 		return errInvalidIdentifier
 	}
@@ -370,6 +381,38 @@ func (pa *AuthorityImpl) WillingToIssue(id identifier.ACMEIdentifier) error {
 	return nil
 }
 
+// willingToIssueIP determines whether the CA is willing to issue for the
+// provided IP address. The address:
+//
+//  * MUST parse as an IPv4 or IPv6 address
+//  * MUST be in the canonical textual form produced by net.IP's String
+//    method, so that equivalent addresses can't be spelled differently
+//  * MUST NOT be in one of the private or reserved ranges that the DNS
+//    resolver also refuses to return
+//  * MUST NOT exactly match an entry on the exact block list
+func (pa *AuthorityImpl) willingToIssueIP(value string) error {
+	ip := net.ParseIP(value)
+	if ip == nil {
+		return errInvalidIP
+	}
+	if ip.String() != value {
+		return errNonCanonicalIP
+	}
+	if bdns.IsReservedIP(ip) {
+		return errReservedIP
+	}
+
+	pa.blocklistMu.RLock()
+	defer pa.blocklistMu.RUnlock()
+	if pa.blocklist == nil {
+		return fmt.Errorf("Hostname policy not yet loaded.")
+	}
+	if pa.exactBlocklist[value] {
+		return errPolicyForbidden
+	}
+	return nil
+}
+
 // WillingToIssueWildcards is an extension of WillingToIssue that accepts DNS
 // identifiers for well formed wildcard domains in addition to regular
 // identifiers.
@@ -436,7 +479,11 @@ func (pa *AuthorityImpl) WillingToIssueWildcards(idents []identifier.ACMEIdentif
 // willingToIssueWildcard vets a single identifier. It is used by
 // the plural WillingToIssueWildcards when evaluating a list of identifiers.
 func (pa *AuthorityImpl) willingToIssueWildcard(ident identifier.ACMEIdentifier) error {
-	// We're only willing to process DNS identifiers
+	// IP identifiers can't be wildcards, so they only need the regular checks
+	if ident.Type == identifier.IP {
+		return pa.WillingToIssue(ident)
+	}
+	// Otherwise we're only willing to process DNS identifiers
 	if ident.Type != identifier.DNS {
 		return errInvalidIdentifier
 	}
@@ -532,14 +579,23 @@ func (pa *AuthorityImpl) checkHostLists(domain string) error {
 
 // ChallengesFor makes a decision of what challenges are acceptable for
 // the given identifier.
-func (pa *AuthorityImpl) ChallengesFor(identifier identifier.ACMEIdentifier) ([]core.Challenge, error) {
+func (pa *AuthorityImpl) ChallengesFor(ident identifier.ACMEIdentifier) ([]core.Challenge, error) {
 	challenges := []core.Challenge{}
 
 	token := core.NewToken()
 
-	// If the identifier is for a DNS wildcard name we only
-	// provide a DNS-01 challenge as a matter of CA policy.
-	if strings.HasPrefix(identifier.Value, "*.") {
+	// If the identifier is for an IP address there is no DNS zone to place
+	// a DNS-01 record in, so we only provide the challenges that connect to the
+	// address directly (RFC 8738, Section 7).
+	if ident.Type == identifier.IP {
+		if pa.ChallengeTypeEnabled(core.ChallengeTypeHTTP01) {
+			challenges = append(challenges, core.HTTPChallenge01(token))
+		}
+
+		if pa.ChallengeTypeEnabled(core.ChallengeTypeTLSALPN01) {
+			challenges = append(challenges, core.TLSALPNChallenge01(token))
+		}
+	} else if strings.HasPrefix(ident.Value, "*.") {
 		// We must have the DNS-01 challenge type enabled to create challenges for
 		// a wildcard identifier per LE policy.
 		if !pa.ChallengeTypeEnabled(core.ChallengeTypeDNS01) {
@@ -451,6 +451,75 @@ func TestMalformedExactBlocklist(t *testing.T) {
 	test.AssertEquals(t, err.Error(), "Malformed ExactBlockedNames entry, only one label: \"com\"")
 }
 
+// TestWillingToIssueIP tests that IP identifiers are only accepted with the
+// IPIdentifiers feature enabled, and then only when they are canonical,
+// public and not on the exact blocklist.
+func TestWillingToIssueIP(t *testing.T) {
+	pa := paImpl(t)
+
+	bannedBytes, err := yaml.Marshal(blockedNamesPolicy{
+		HighRiskBlockedNames: []string{"zombo.gov.us"},
+		ExactBlockedNames:    []string{"93.184.216.35"},
+	})
+	test.AssertNotError(t, err, "Couldn't serialize banned list")
+	f, _ := ioutil.TempFile("", "test-ip-banlist.*.yaml")
+	defer os.Remove(f.Name())
+	err = ioutil.WriteFile(f.Name(), bannedBytes, 0640)
+	test.AssertNotError(t, err, "Couldn't write serialized banned list to file")
+	err = pa.SetHostnamePolicyFile(f.Name())
+	test.AssertNotError(t, err, "Couldn't load policy contents from file")
+
+	// Without the feature flag IP identifiers are an unknown type.
+	err = pa.WillingToIssue(identifier.ACMEIdentifier{Type: identifier.IP, Value: "93.184.216.34"})
+	test.AssertEquals(t, err, errInvalidIdentifier)
+
+	_ = features.Set(map[string]bool{"IPIdentifiers": true})
+	defer features.Reset()
+
+	testCases := []struct {
+		ip  string
+		err error
+	}{
+		{"93.184.216.34", nil},
+		{"2606:2800:220:1:248:1893:25c8:1946", nil},
+		{"", errInvalidIP},
+		{"example.com", errInvalidIP},
+		{"93.184.216.034", errInvalidIP},
+		{"2606:2800:0220:1:248:1893:25c8:1946", errNonCanonicalIP},
+		{"2606:2800:220:1:248:1893:25C8:1946", errNonCanonicalIP},
+		{"::ffff:93.184.216.34", errNonCanonicalIP},
+		{"10.0.0.1", errReservedIP},
+		{"127.0.0.1", errReservedIP},
+		{"::1", errReservedIP},
+		{"fe80::1", errReservedIP},
+		{"93.184.216.35", errPolicyForbidden},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.ip, func(t *testing.T) {
+			ident := identifier.ACMEIdentifier{Type: identifier.IP, Value: tc.ip}
+			test.AssertEquals(t, pa.WillingToIssue(ident), tc.err)
+			test.AssertEquals(t, pa.willingToIssueWildcard(ident), tc.err)
+		})
+	}
+}
+
+// TestChallengesForIP tests that DNS-01 is never offered for IP identifiers.
+func TestChallengesForIP(t *testing.T) {
+	pa, err := New(map[core.AcmeChallenge]bool{
+		core.ChallengeTypeHTTP01:    true,
+		core.ChallengeTypeTLSALPN01: true,
+		core.ChallengeTypeDNS01:     true,
+	})
+	test.AssertNotError(t, err, "Couldn't create policy implementation")
+
+	challenges, err := pa.ChallengesFor(identifier.ACMEIdentifier{Type: identifier.IP, Value: "93.184.216.34"})
+	test.AssertNotError(t, err, "ChallengesFor failed")
+	test.AssertEquals(t, len(challenges), 2)
+	for _, challenge := range challenges {
+		test.Assert(t, challenge.Type != core.ChallengeTypeDNS01, "DNS-01 challenge returned for IP identifier")
+	}
+}
+
 func TestValidEmailError(t *testing.T) {
 	err := ValidEmail("(๑•́ ω •̀๑)")
 	test.AssertEquals(t, err.Error(), "\"(๑•́ ω •̀๑)\" is not a valid e-mail address")
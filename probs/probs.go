@@ -3,30 +3,32 @@ package probs
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/letsencrypt/boulder/identifier"
 )
 
 // Error types that can be used in ACME payloads
 const (
-	ConnectionProblem            = ProblemType("connection")
-	MalformedProblem             = ProblemType("malformed")
-	ServerInternalProblem        = ProblemType("serverInternal")
-	TLSProblem                   = ProblemType("tls")
-	UnauthorizedProblem          = ProblemType("unauthorized")
-	RateLimitedProblem           = ProblemType("rateLimited")
-	BadNonceProblem              = ProblemType("badNonce")
-	InvalidEmailProblem          = ProblemType("invalidEmail")
-	RejectedIdentifierProblem    = ProblemType("rejectedIdentifier")
-	AccountDoesNotExistProblem   = ProblemType("accountDoesNotExist")
-	CAAProblem                   = ProblemType("caa")
-	DNSProblem                   = ProblemType("dns")
-	AlreadyRevokedProblem        = ProblemType("alreadyRevoked")
-	OrderNotReadyProblem         = ProblemType("orderNotReady")
-	BadSignatureAlgorithmProblem = ProblemType("badSignatureAlgorithm")
-	BadPublicKeyProblem          = ProblemType("badPublicKey")
-	BadRevocationReasonProblem   = ProblemType("badRevocationReason")
-	BadCSRProblem                = ProblemType("badCSR")
+	ConnectionProblem              = ProblemType("connection")
+	MalformedProblem               = ProblemType("malformed")
+	ServerInternalProblem          = ProblemType("serverInternal")
+	TLSProblem                     = ProblemType("tls")
+	UnauthorizedProblem            = ProblemType("unauthorized")
+	RateLimitedProblem             = ProblemType("rateLimited")
+	BadNonceProblem                = ProblemType("badNonce")
+	InvalidEmailProblem            = ProblemType("invalidEmail")
+	RejectedIdentifierProblem      = ProblemType("rejectedIdentifier")
+	AccountDoesNotExistProblem     = ProblemType("accountDoesNotExist")
+	CAAProblem                     = ProblemType("caa")
+	DNSProblem                     = ProblemType("dns")
+	AlreadyRevokedProblem          = ProblemType("alreadyRevoked")
+	OrderNotReadyProblem           = ProblemType("orderNotReady")
+	BadSignatureAlgorithmProblem   = ProblemType("badSignatureAlgorithm")
+	BadPublicKeyProblem            = ProblemType("badPublicKey")
+	BadRevocationReasonProblem     = ProblemType("badRevocationReason")
+	BadCSRProblem                  = ProblemType("badCSR")
+	ExternalAccountRequiredProblem = ProblemType("externalAccountRequired")
 
 	V1ErrorNS = "urn:acme:error:"
 	V2ErrorNS = "urn:ietf:params:acme:error:"
@@ -46,6 +48,9 @@ type ProblemDetails struct {
 	// SubProblems are optional additional per-identifier problems. See
 	// RFC 8555 Section 6.7.1: https://tools.ietf.org/html/rfc8555#section-6.7.1
 	SubProblems []SubProblemDetails `json:"subproblems,omitempty"`
+	// ResetTime is an optional extension member for rateLimited problems
+	// giving the time at which the rate limit will permit the request again.
+	ResetTime *time.Time `json:"resetTime,omitempty"`
 }
 
 // SubProblemDetails represents sub-problems specific to an identifier that are
@@ -68,6 +73,7 @@ func (pd *ProblemDetails) WithSubProblems(subProbs []SubProblemDetails) *Problem
 		Detail:      pd.Detail,
 		HTTPStatus:  pd.HTTPStatus,
 		SubProblems: append(pd.SubProblems, subProbs...),
+		ResetTime:   pd.ResetTime,
 	}
 }
 
@@ -99,7 +105,8 @@ func ProblemDetailsToStatusCode(prob *ProblemDetails) int {
 		return http.StatusInternalServerError
 	case
 		UnauthorizedProblem,
-		CAAProblem:
+		CAAProblem,
+		ExternalAccountRequiredProblem:
 		return http.StatusForbidden
 	case RateLimitedProblem:
 		return statusTooManyRequests
@@ -334,3 +341,13 @@ func BadCSR(detail string, a ...interface{}) *ProblemDetails {
 		HTTPStatus: http.StatusBadRequest,
 	}
 }
+
+// ExternalAccountRequired returns a ProblemDetails representing an
+// ExternalAccountRequiredProblem.
+func ExternalAccountRequired(detail string) *ProblemDetails {
+	return &ProblemDetails{
+		Type:       ExternalAccountRequiredProblem,
+		Detail:     detail,
+		HTTPStatus: http.StatusForbidden,
+	}
+}
@@ -27,17 +27,21 @@ import (
 
 	"github.com/letsencrypt/boulder/canceled"
 	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
 	"github.com/letsencrypt/boulder/issuance"
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/metrics"
 	pubpb "github.com/letsencrypt/boulder/publisher/proto"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
 )
 
 // Log contains the CT client for a particular CT log
 type Log struct {
-	logID  string
-	uri    string
-	client *ctClient.LogClient
+	logID    string
+	uri      string
+	client   *ctClient.LogClient
+	keyID    [sha256.Size]byte
+	verifier *ct.SignatureVerifier
 }
 
 // logCache contains a cache of *Log's that are constructed as required by
@@ -107,11 +111,24 @@ func NewLog(uri, b64PK, userAgent string, logger blog.Logger) (*Log, error) {
 	}
 	url.Path = strings.TrimSuffix(url.Path, "/")
 
-	pemPK := fmt.Sprintf("-----BEGIN PUBLIC KEY-----\n%s\n-----END PUBLIC KEY-----",
-		b64PK)
+	der, err := base64.StdEncoding.DecodeString(b64PK)
+	if err != nil {
+		return nil, fmt.Errorf("decoding log public key: %s", err)
+	}
+	pk, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("parsing log public key: %s", err)
+	}
+	verifier, err := ct.NewSignatureVerifier(pk)
+	if err != nil {
+		return nil, fmt.Errorf("making SCT verifier: %s", err)
+	}
+
+	// The public key is deliberately left out of the client's options, so that
+	// it doesn't verify SCTs itself: the Publisher verifies them in
+	// singleLogSubmit, where failures can be told apart from other errors.
 	opts := jsonclient.Options{
 		Logger:    logAdaptor{logger},
-		PublicKey: pemPK,
 		UserAgent: userAgent,
 	}
 	httpClient := &http.Client{
@@ -153,12 +170,31 @@ func NewLog(uri, b64PK, userAgent string, logger blog.Logger) (*Log, error) {
 	}
 
 	return &Log{
-		logID:  b64PK,
-		uri:    url.String(),
-		client: client,
+		logID:    b64PK,
+		uri:      url.String(),
+		client:   client,
+		keyID:    sha256.Sum256(der),
+		verifier: verifier,
 	}, nil
 }
 
+// verifySCT checks that an SCT was issued by the log, and that its signature
+// over the log entry for the given chain is valid.
+func (l *Log) verifySCT(sct *ct.SignedCertificateTimestamp, chain []ct.ASN1Cert, isPrecert bool) error {
+	if sct.LogID.KeyID != l.keyID {
+		return fmt.Errorf("SCT has log ID %x, expected %x", sct.LogID.KeyID, l.keyID)
+	}
+	etype := ct.X509LogEntryType
+	if isPrecert {
+		etype = ct.PrecertLogEntryType
+	}
+	leaf, err := ct.MerkleTreeLeafFromRawChain(chain, etype, sct.Timestamp)
+	if err != nil {
+		return fmt.Errorf("building log entry: %s", err)
+	}
+	return l.verifier.VerifySCTSignature(*sct, ct.LogEntry{Leaf: *leaf})
+}
+
 type ctSubmissionRequest struct {
 	Chain []string `json:"chain"`
 }
@@ -166,6 +202,7 @@ type ctSubmissionRequest struct {
 type pubMetrics struct {
 	submissionLatency *prometheus.HistogramVec
 	probeLatency      *prometheus.HistogramVec
+	invalidSCTs       *prometheus.CounterVec
 }
 
 func initMetrics(stats prometheus.Registerer) *pubMetrics {
@@ -189,9 +226,19 @@ func initMetrics(stats prometheus.Registerer) *pubMetrics {
 	)
 	stats.MustRegister(probeLatency)
 
+	invalidSCTs := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ct_invalid_scts",
+			Help: "Count of SCTs rejected because they failed verification against the log's public key",
+		},
+		[]string{"log"},
+	)
+	stats.MustRegister(invalidSCTs)
+
 	return &pubMetrics{
 		submissionLatency: submissionLatency,
 		probeLatency:      probeLatency,
+		invalidSCTs:       invalidSCTs,
 	}
 }
 
@@ -202,6 +249,13 @@ type Impl struct {
 	issuerBundles map[issuance.IssuerNameID][]ct.ASN1Cert
 	ctLogsCache   logCache
 	metrics       *pubMetrics
+	// logList, if set, is used to refuse submissions to logs which the log
+	// list says aren't in a usable state or don't accept the certificate.
+	logList *loglist.Source
+	// sa, if set, is used to store each verified SCT.
+	sa core.StorageAdder
+	// storing tracks the SCTs which are still being stored.
+	storing sync.WaitGroup
 }
 
 // New creates a Publisher that will submit certificates
@@ -209,10 +263,14 @@ type Impl struct {
 func New(
 	bundles map[issuance.IssuerNameID][]ct.ASN1Cert,
 	userAgent string,
+	logList *loglist.Source,
+	sa core.StorageAdder,
 	logger blog.Logger,
 	stats prometheus.Registerer,
 ) *Impl {
 	return &Impl{
+		logList:       logList,
+		sa:            sa,
 		issuerBundles: bundles,
 		userAgent:     userAgent,
 		ctLogsCache: logCache{
@@ -223,6 +281,40 @@ func New(
 	}
 }
 
+// WaitForStorage blocks until every SCT received so far has been stored, or
+// has failed to be.
+func (pub *Impl) WaitForStorage() {
+	pub.storing.Wait()
+}
+
+// refusedError is returned by checkLogList. Since the log list won't allow the
+// submission however often it's retried, it's a permanent failure.
+type refusedError struct {
+	error
+}
+
+// checkLogList returns an error if the log with the given base64 public key
+// is in the log list but isn't in one of the list's allowed states, or doesn't
+// accept certificates expiring at the given time. Logs which aren't in the
+// list at all, such as informational logs, are allowed.
+func (pub *Impl) checkLogList(logKey string, expiration time.Time) error {
+	if pub.logList == nil {
+		return nil
+	}
+	log, ok := pub.logList.All().Find(logKey)
+	if !ok {
+		return nil
+	}
+	_, ok = pub.logList.List().Find(logKey)
+	if !ok {
+		return refusedError{fmt.Errorf("log %q is %s in the log list", log.Description, log.State)}
+	}
+	if !log.Accepts(expiration) {
+		return refusedError{fmt.Errorf("log %q doesn't accept certificates expiring at %s", log.Description, expiration)}
+	}
+	return nil
+}
+
 // SubmitToSingleCTWithResult will submit the certificate represented by certDER to the CT
 // log specified by log URL and public key (base64) and return the SCT to the caller
 func (pub *Impl) SubmitToSingleCTWithResult(ctx context.Context, req *pubpb.Request) (*pubpb.Result, error) {
@@ -241,6 +333,12 @@ func (pub *Impl) SubmitToSingleCTWithResult(ctx context.Context, req *pubpb.Requ
 	}
 	chain = append(chain, issuerBundle...)
 
+	err = pub.checkLogList(req.LogPublicKey, cert.NotAfter)
+	if err != nil {
+		pub.log.AuditErrf("Refusing to submit certificate to CT log %q: %s", req.LogURL, err)
+		return nil, err
+	}
+
 	// Add a log URL/pubkey to the cache, if already present the
 	// existing *Log will be returned, otherwise one will be constructed, added
 	// and returned.
@@ -276,9 +374,40 @@ func (pub *Impl) SubmitToSingleCTWithResult(ctx context.Context, req *pubpb.Requ
 	if err != nil {
 		return nil, err
 	}
+	if pub.sa != nil {
+		pub.storing.Add(1)
+		go pub.storeSCT(core.SerialToString(cert.SerialNumber), ctLog, sct, sctBytes, isPrecert)
+	}
 	return &pubpb.Result{Sct: sctBytes}, nil
 }
 
+// storeSCTTimeout bounds how long storing an SCT may take. Storage uses its
+// own context, rather than the submission's, since it outlives the
+// submission.
+const storeSCTTimeout = 5 * time.Second
+
+// storeSCT records a verified SCT in the SA so that we know which logs have
+// each certificate. It runs in the background, so that SA latency doesn't
+// delay issuance, and must be preceded by a call to pub.storing.Add(1).
+// Failures are logged but don't fail the submission, since the SCT itself is
+// still valid.
+func (pub *Impl) storeSCT(serial string, ctLog *Log, sct *ct.SignedCertificateTimestamp, sctBytes []byte, isPrecert bool) {
+	defer pub.storing.Done()
+	ctx, cancel := context.WithTimeout(context.Background(), storeSCTTimeout)
+	defer cancel()
+	_, err := pub.sa.AddSCT(ctx, &sapb.SCT{
+		Serial:         serial,
+		LogID:          sct.LogID.KeyID[:],
+		LogURL:         ctLog.uri,
+		Timestamp:      int64(sct.Timestamp) * int64(time.Millisecond),
+		Precertificate: isPrecert,
+		Sct:            sctBytes,
+	})
+	if err != nil {
+		pub.log.AuditErrf("Failed to store SCT from CT log at %s for serial %s: %s", ctLog.uri, serial, err)
+	}
+}
+
 func (pub *Impl) singleLogSubmit(
 	ctx context.Context,
 	chain []ct.ASN1Cert,
@@ -328,6 +457,12 @@ func (pub *Impl) singleLogSubmit(
 		return nil, fmt.Errorf("SCT Timestamp was too far in the past (%s)", timestamp)
 	}
 
+	err = ctLog.verifySCT(sct, chain, isPrecert)
+	if err != nil {
+		pub.metrics.invalidSCTs.With(prometheus.Labels{"log": ctLog.uri}).Inc()
+		return nil, fmt.Errorf("SCT failed verification: %s", err)
+	}
+
 	return sct, nil
 }
 
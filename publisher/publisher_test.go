@@ -5,6 +5,7 @@ import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/sha256"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/asn1"
@@ -26,10 +27,14 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
 	"github.com/letsencrypt/boulder/issuance"
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/mocks"
 	pubpb "github.com/letsencrypt/boulder/publisher/proto"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
 	"github.com/letsencrypt/boulder/test"
 )
 
@@ -106,6 +111,35 @@ func lyingLogSrv(k *ecdsa.PrivateKey, timestamp time.Time) *testLogSrv {
 	return testLog
 }
 
+// badSigLogSrv signs SCTs with signingKey, but claims that they are from the
+// log whose key is claimedKey.
+func badSigLogSrv(signingKey, claimedKey *ecdsa.PrivateKey) *testLogSrv {
+	testLog := &testLogSrv{}
+	m := http.NewServeMux()
+	m.HandleFunc("/ct/", func(w http.ResponseWriter, r *http.Request) {
+		decoder := json.NewDecoder(r.Body)
+		var jsonReq ctSubmissionRequest
+		err := decoder.Decode(&jsonReq)
+		if err != nil {
+			return
+		}
+		var sct map[string]interface{}
+		err = json.Unmarshal(CreateTestingSignedSCT(jsonReq.Chain, signingKey, true, time.Now()), &sct)
+		if err != nil {
+			return
+		}
+		rawKey, _ := x509.MarshalPKIXPublicKey(&claimedKey.PublicKey)
+		logID := sha256.Sum256(rawKey)
+		sct["id"] = base64.StdEncoding.EncodeToString(logID[:])
+		json.NewEncoder(w).Encode(sct)
+		atomic.AddInt64(&testLog.submissions, 1)
+	})
+
+	testLog.Server = httptest.NewUnstartedServer(m)
+	testLog.Server.Start()
+	return testLog
+}
+
 func errorBodyLogSrv() *httptest.Server {
 	m := http.NewServeMux()
 	m.HandleFunc("/ct/", func(w http.ResponseWriter, r *http.Request) {
@@ -159,6 +193,8 @@ func setup(t *testing.T) (*Impl, *x509.Certificate, *ecdsa.PrivateKey) {
 	pub := New(
 		issuerBundles,
 		"test-user-agent/1.0",
+		nil,
+		nil,
 		log,
 		metrics.NoopRegisterer)
 
@@ -267,6 +303,123 @@ func TestTimestampVerificationPast(t *testing.T) {
 	}
 }
 
+func TestSCTVerification(t *testing.T) {
+	pub, _, k := setup(t)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "Couldn't generate test key")
+
+	issuerBundles, precert, err := makePrecert(k)
+	test.AssertNotError(t, err, "Failed to create test leaf")
+	pub.issuerBundles = issuerBundles
+
+	// An SCT from a different log is rejected.
+	server := logSrv(otherKey)
+	defer server.Close()
+	port, err := getPort(server.URL)
+	test.AssertNotError(t, err, "Failed to get test server port")
+	testLog := addLog(t, pub, port, &k.PublicKey)
+	_, err = pub.SubmitToSingleCTWithResult(ctx, &pubpb.Request{LogURL: testLog.uri, LogPublicKey: testLog.logID, Der: precert, Precert: true})
+	test.AssertError(t, err, "SCT from the wrong log was accepted")
+	test.AssertContains(t, err.Error(), "SCT failed verification: SCT has log ID")
+	test.AssertEquals(t, test.CountCounterVec("log", testLog.uri, pub.metrics.invalidSCTs), 1)
+
+	// An SCT with the right log ID but a bad signature is rejected. Logs are
+	// cached by key, so this needs a new Publisher.
+	pub, _, _ = setup(t)
+	pub.issuerBundles = issuerBundles
+	server = badSigLogSrv(otherKey, k)
+	defer server.Close()
+	port, err = getPort(server.URL)
+	test.AssertNotError(t, err, "Failed to get test server port")
+	testLog = addLog(t, pub, port, &k.PublicKey)
+	_, err = pub.SubmitToSingleCTWithResult(ctx, &pubpb.Request{LogURL: testLog.uri, LogPublicKey: testLog.logID, Der: precert, Precert: true})
+	test.AssertError(t, err, "SCT with a bad signature was accepted")
+	test.AssertContains(t, err.Error(), "SCT failed verification")
+	test.AssertEquals(t, test.CountCounterVec("log", testLog.uri, pub.metrics.invalidSCTs), 1)
+}
+
+// recordingSA is a mock SA which records the SCTs it's asked to store
+type recordingSA struct {
+	mocks.StorageAuthority
+	scts []*sapb.SCT
+}
+
+func (sa *recordingSA) AddSCT(_ context.Context, req *sapb.SCT) (*corepb.Empty, error) {
+	sa.scts = append(sa.scts, req)
+	return &corepb.Empty{}, nil
+}
+
+func TestSCTStorage(t *testing.T) {
+	pub, _, k := setup(t)
+	sa := &recordingSA{}
+	pub.sa = sa
+
+	server := logSrv(k)
+	defer server.Close()
+	port, err := getPort(server.URL)
+	test.AssertNotError(t, err, "Failed to get test server port")
+	testLog := addLog(t, pub, port, &k.PublicKey)
+
+	issuerBundles, precert, err := makePrecert(k)
+	test.AssertNotError(t, err, "Failed to create test leaf")
+	pub.issuerBundles = issuerBundles
+
+	res, err := pub.SubmitToSingleCTWithResult(ctx, &pubpb.Request{LogURL: testLog.uri, LogPublicKey: testLog.logID, Der: precert, Precert: true})
+	test.AssertNotError(t, err, "Submission failed")
+	pub.WaitForStorage()
+	test.AssertEquals(t, len(sa.scts), 1)
+	stored := sa.scts[0]
+	test.AssertEquals(t, stored.Serial, core.SerialToString(big.NewInt(0)))
+	test.AssertEquals(t, stored.LogURL, testLog.uri)
+	test.AssertEquals(t, base64.StdEncoding.EncodeToString(stored.LogID), base64.StdEncoding.EncodeToString(testLog.keyID[:]))
+	test.Assert(t, stored.Precertificate, "SCT wasn't stored as a precertificate SCT")
+	test.AssertByteEquals(t, stored.Sct, res.Sct)
+}
+
+func TestLogListFiltering(t *testing.T) {
+	pub, _, k := setup(t)
+
+	server := logSrv(k)
+	defer server.Close()
+	port, err := getPort(server.URL)
+	test.AssertNotError(t, err, "Failed to get test server port")
+	testLog := addLog(t, pub, port, &k.PublicKey)
+
+	issuerBundles, precert, err := makePrecert(k)
+	test.AssertNotError(t, err, "Failed to create test leaf")
+	pub.issuerBundles = issuerBundles
+	req := &pubpb.Request{LogURL: testLog.uri, LogPublicKey: testLog.logID, Der: precert, Precert: true}
+
+	// A log which isn't in the list is allowed.
+	pub.logList = loglist.NewStaticSource(nil, loglist.Usable)
+	_, err = pub.SubmitToSingleCTWithResult(ctx, req)
+	test.AssertNotError(t, err, "Submission to log not in the log list failed")
+
+	// A log which is in the list, but isn't usable, is refused.
+	entry := loglist.Log{Description: "test", URL: testLog.uri, Key: testLog.logID, State: loglist.Retired}
+	pub.logList = loglist.NewStaticSource(loglist.List{entry}, loglist.Usable)
+	_, err = pub.SubmitToSingleCTWithResult(ctx, req)
+	test.AssertError(t, err, "Submission to retired log succeeded")
+	test.AssertContains(t, err.Error(), "is retired")
+
+	// A usable log whose shard doesn't include the certificate's expiration is
+	// refused.
+	entry.State = loglist.Usable
+	entry.StartInclusive = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	entry.EndExclusive = time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	pub.logList = loglist.NewStaticSource(loglist.List{entry}, loglist.Usable)
+	_, err = pub.SubmitToSingleCTWithResult(ctx, req)
+	test.AssertError(t, err, "Submission to log outside its shard succeeded")
+	test.AssertContains(t, err.Error(), "doesn't accept")
+
+	// An unsharded usable log is allowed.
+	entry.StartInclusive = time.Time{}
+	entry.EndExclusive = time.Time{}
+	pub.logList = loglist.NewStaticSource(loglist.List{entry}, loglist.Usable)
+	_, err = pub.SubmitToSingleCTWithResult(ctx, req)
+	test.AssertNotError(t, err, "Submission to usable log failed")
+}
+
 func TestLogCache(t *testing.T) {
 	cache := logCache{
 		logs: make(map[string]*Log),
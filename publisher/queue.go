@@ -0,0 +1,204 @@
+package publisher
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/core"
+	blog "github.com/letsencrypt/boulder/log"
+	pubpb "github.com/letsencrypt/boulder/publisher/proto"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// FinalCertQueue works through the durable queue of final certificate
+// submissions which the RA writes to the SA, submitting each certificate to
+// its log and retrying failed submissions with exponential backoff. Because
+// the queue is stored in the SA, submissions survive publisher restarts.
+//
+// Submissions which can never succeed, because the log list refuses the log
+// or the certificate has expired, and those which have failed maxAttempts
+// times, are dropped from the queue with an audit log.
+//
+// The queue isn't locked, so when several publishers run a FinalCertQueue they
+// may occasionally submit the same certificate to a log twice. That's
+// harmless: logs return the existing SCT for a duplicate submission.
+type FinalCertQueue struct {
+	pub         *Impl
+	sa          core.StorageAuthority
+	clk         clock.Clock
+	log         blog.Logger
+	batchSize   int
+	baseBackoff time.Duration
+	maxBackoff  time.Duration
+	maxAttempts int
+	timeout     time.Duration
+
+	backlog     prometheus.Gauge
+	backlogAge  prometheus.Gauge
+	submissions *prometheus.CounterVec
+}
+
+// NewFinalCertQueue creates a FinalCertQueue which submits certificates using
+// the given Publisher. Each pass handles up to batchSize submissions, each
+// with the given timeout. A submission which has failed n times is retried
+// after a delay which starts at baseBackoff and doubles with each failure, up
+// to maxBackoff, until it has been attempted maxAttempts times.
+func NewFinalCertQueue(
+	pub *Impl,
+	sa core.StorageAuthority,
+	clk clock.Clock,
+	batchSize int,
+	baseBackoff time.Duration,
+	maxBackoff time.Duration,
+	maxAttempts int,
+	timeout time.Duration,
+	logger blog.Logger,
+	stats prometheus.Registerer,
+) *FinalCertQueue {
+	backlog := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "ct_final_cert_queue_backlog",
+		Help: "Number of final certificate CT submissions waiting in the queue",
+	})
+	stats.MustRegister(backlog)
+	backlogAge := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "ct_final_cert_queue_oldest_seconds",
+		Help: "Age of the oldest final certificate CT submission waiting in the queue",
+	})
+	stats.MustRegister(backlogAge)
+	submissions := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ct_final_cert_queue_submissions",
+		Help: "Count of attempted final certificate CT submissions from the queue, by result",
+	}, []string{"result"})
+	stats.MustRegister(submissions)
+
+	return &FinalCertQueue{
+		pub:         pub,
+		sa:          sa,
+		clk:         clk,
+		log:         logger,
+		batchSize:   batchSize,
+		baseBackoff: baseBackoff,
+		maxBackoff:  maxBackoff,
+		maxAttempts: maxAttempts,
+		timeout:     timeout,
+		backlog:     backlog,
+		backlogAge:  backlogAge,
+		submissions: submissions,
+	}
+}
+
+// Run processes a batch of due submissions every frequency, until the context
+// is canceled.
+func (q *FinalCertQueue) Run(ctx context.Context, frequency time.Duration) {
+	ticker := time.NewTicker(frequency)
+	defer ticker.Stop()
+	for {
+		err := q.processBatch(ctx)
+		if err != nil {
+			q.log.AuditErrf("Failed to process final certificate CT submission queue: %s", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// errExpired is returned by submit when the certificate has expired, since
+// logs won't accept it.
+var errExpired = errors.New("certificate has expired")
+
+// processBatch attempts each of a batch of due submissions. Successful
+// submissions are removed from the queue, and failed ones are rescheduled or,
+// if they can't succeed, dropped.
+func (q *FinalCertQueue) processBatch(ctx context.Context) error {
+	batch, err := q.sa.GetFinalCertSubmissions(ctx, &sapb.GetFinalCertSubmissionsRequest{
+		Limit: int64(q.batchSize),
+	})
+	if err != nil {
+		return err
+	}
+	q.backlog.Set(float64(batch.Backlog))
+	if batch.OldestCreated != 0 {
+		q.backlogAge.Set(q.clk.Since(time.Unix(0, batch.OldestCreated)).Seconds())
+	} else {
+		q.backlogAge.Set(0)
+	}
+
+	certs := make(map[string]*x509.Certificate)
+	for _, sub := range batch.Submissions {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		err := q.submit(ctx, sub, certs)
+		if err == nil {
+			q.submissions.WithLabelValues("success").Inc()
+			_, err = q.sa.RemoveFinalCertSubmission(ctx, &sapb.FinalCertSubmissionID{Id: sub.Id})
+			if err != nil {
+				q.log.AuditErrf("Failed to remove final certificate CT submission %d from the queue: %s", sub.Id, err)
+			}
+			continue
+		}
+		q.submissions.WithLabelValues("failure").Inc()
+		attempts := sub.Attempts + 1
+		var refused refusedError
+		if errors.As(err, &refused) || errors.Is(err, errExpired) || attempts >= int64(q.maxAttempts) {
+			q.submissions.WithLabelValues("dropped").Inc()
+			q.log.AuditErrf("Dropping final certificate CT submission of serial %s to %s after %d attempts: %s",
+				sub.Serial, sub.Log.Url, attempts, err)
+			_, err = q.sa.RemoveFinalCertSubmission(ctx, &sapb.FinalCertSubmissionID{Id: sub.Id})
+			if err != nil {
+				q.log.AuditErrf("Failed to remove final certificate CT submission %d from the queue: %s", sub.Id, err)
+			}
+			continue
+		}
+		nextAttempt := q.clk.Now().Add(core.RetryBackoff(int(attempts), q.baseBackoff, q.maxBackoff, 2))
+		q.log.Warningf("Final certificate CT submission of serial %s to %s failed (attempt %d, retrying at %s): %s",
+			sub.Serial, sub.Log.Url, attempts, nextAttempt, err)
+		_, err = q.sa.RescheduleFinalCertSubmission(ctx, &sapb.FinalCertSubmission{
+			Id:          sub.Id,
+			Attempts:    attempts,
+			NextAttempt: nextAttempt.UnixNano(),
+		})
+		if err != nil {
+			q.log.AuditErrf("Failed to reschedule final certificate CT submission %d: %s", sub.Id, err)
+		}
+	}
+	return nil
+}
+
+// submit submits the certificate for a queued submission to its log, using
+// and filling a cache of certificates by serial.
+func (q *FinalCertQueue) submit(ctx context.Context, sub *sapb.FinalCertSubmission, certs map[string]*x509.Certificate) error {
+	ctx, cancel := context.WithTimeout(ctx, q.timeout)
+	defer cancel()
+	cert, ok := certs[sub.Serial]
+	if !ok {
+		certObj, err := q.sa.GetCertificate(ctx, sub.Serial)
+		if err != nil {
+			return err
+		}
+		cert, err = x509.ParseCertificate(certObj.DER)
+		if err != nil {
+			return fmt.Errorf("parsing certificate: %w", err)
+		}
+		certs[sub.Serial] = cert
+	}
+	if !q.clk.Now().Before(cert.NotAfter) {
+		return errExpired
+	}
+	_, err := q.pub.SubmitToSingleCTWithResult(ctx, &pubpb.Request{
+		LogURL:       sub.Log.Url,
+		LogPublicKey: sub.Log.PublicKey,
+		Der:          cert.Raw,
+		Precert:      false,
+	})
+	return err
+}
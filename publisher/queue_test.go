@@ -0,0 +1,168 @@
+package publisher
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	io_prometheus_client "github.com/prometheus/client_model/go"
+
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/mocks"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// queueSA is a mock SA which returns a fixed batch of final certificate
+// submissions and records what happens to them
+type queueSA struct {
+	mocks.StorageAuthority
+	der         []byte
+	batch       *sapb.FinalCertSubmissions
+	removed     []int64
+	rescheduled []*sapb.FinalCertSubmission
+}
+
+func (sa *queueSA) GetFinalCertSubmissions(_ context.Context, _ *sapb.GetFinalCertSubmissionsRequest) (*sapb.FinalCertSubmissions, error) {
+	return sa.batch, nil
+}
+
+func (sa *queueSA) GetCertificate(_ context.Context, serial string) (core.Certificate, error) {
+	return core.Certificate{Serial: serial, DER: sa.der}, nil
+}
+
+func (sa *queueSA) RemoveFinalCertSubmission(_ context.Context, req *sapb.FinalCertSubmissionID) (*corepb.Empty, error) {
+	sa.removed = append(sa.removed, req.Id)
+	return &corepb.Empty{}, nil
+}
+
+func (sa *queueSA) RescheduleFinalCertSubmission(_ context.Context, req *sapb.FinalCertSubmission) (*corepb.Empty, error) {
+	sa.rescheduled = append(sa.rescheduled, req)
+	return &corepb.Empty{}, nil
+}
+
+func gaugeValue(g prometheus.Gauge) float64 {
+	var m io_prometheus_client.Metric
+	_ = g.Write(&m)
+	return m.Gauge.GetValue()
+}
+
+func TestFinalCertQueue(t *testing.T) {
+	pub, leaf, k := setup(t)
+	pkDER, err := x509.MarshalPKIXPublicKey(&k.PublicKey)
+	test.AssertNotError(t, err, "Failed to marshal key")
+	pkB64 := base64.StdEncoding.EncodeToString(pkDER)
+
+	workingSrv := logSrv(k)
+	defer workingSrv.Close()
+	port, err := getPort(workingSrv.URL)
+	test.AssertNotError(t, err, "Failed to get test server port")
+	workingURI := fmt.Sprintf("http://localhost:%d", port)
+
+	badSrv := errorBodyLogSrv()
+	defer badSrv.Close()
+	port, err = getPort(badSrv.URL)
+	test.AssertNotError(t, err, "Failed to get test server port")
+	badURI := fmt.Sprintf("http://localhost:%d", port)
+	// Logs are cached by key, so the bad log needs a key of its own.
+	badKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "Failed to generate key")
+	badDER, err := x509.MarshalPKIXPublicKey(&badKey.PublicKey)
+	test.AssertNotError(t, err, "Failed to marshal key")
+
+	fc := clock.NewFake()
+	fc.Set(leaf.NotBefore.Add(time.Hour))
+	serial := core.SerialToString(leaf.SerialNumber)
+	sa := &queueSA{
+		der: leaf.Raw,
+		batch: &sapb.FinalCertSubmissions{
+			Submissions: []*sapb.FinalCertSubmission{
+				{Id: 1, Serial: serial, Log: &sapb.CTLog{Url: workingURI, PublicKey: pkB64}},
+				{Id: 2, Serial: serial, Log: &sapb.CTLog{Url: badURI, PublicKey: base64.StdEncoding.EncodeToString(badDER)}, Attempts: 2},
+			},
+			Backlog:       5,
+			OldestCreated: fc.Now().Add(-time.Hour).UnixNano(),
+		},
+	}
+	q := NewFinalCertQueue(pub, sa, fc, 10, time.Minute, time.Hour, 5, time.Second, log, metrics.NoopRegisterer)
+
+	err = q.processBatch(context.Background())
+	test.AssertNotError(t, err, "processBatch failed")
+	test.AssertDeepEquals(t, sa.removed, []int64{1})
+	test.AssertEquals(t, len(sa.rescheduled), 1)
+	test.AssertEquals(t, sa.rescheduled[0].Id, int64(2))
+	test.AssertEquals(t, sa.rescheduled[0].Attempts, int64(3))
+	test.Assert(t, sa.rescheduled[0].NextAttempt > fc.Now().UnixNano(), "failed submission wasn't rescheduled into the future")
+	test.Assert(t, sa.rescheduled[0].NextAttempt <= fc.Now().Add(time.Hour).UnixNano(), "failed submission was rescheduled past the max backoff")
+
+	test.AssertEquals(t, test.CountCounterVec("result", "success", q.submissions), 1)
+	test.AssertEquals(t, test.CountCounterVec("result", "failure", q.submissions), 1)
+	test.AssertEquals(t, gaugeValue(q.backlog), float64(5))
+	test.AssertEquals(t, gaugeValue(q.backlogAge), time.Hour.Seconds())
+}
+
+func TestFinalCertQueueDrops(t *testing.T) {
+	pub, leaf, k := setup(t)
+	pkDER, err := x509.MarshalPKIXPublicKey(&k.PublicKey)
+	test.AssertNotError(t, err, "Failed to marshal key")
+	pkB64 := base64.StdEncoding.EncodeToString(pkDER)
+
+	badSrv := errorBodyLogSrv()
+	defer badSrv.Close()
+	port, err := getPort(badSrv.URL)
+	test.AssertNotError(t, err, "Failed to get test server port")
+	badURI := fmt.Sprintf("http://localhost:%d", port)
+
+	fc := clock.NewFake()
+	fc.Set(leaf.NotBefore.Add(time.Hour))
+	serial := core.SerialToString(leaf.SerialNumber)
+	sub := &sapb.FinalCertSubmission{Id: 1, Serial: serial, Log: &sapb.CTLog{Url: badURI, PublicKey: pkB64}, Attempts: 3}
+	sa := &queueSA{
+		der:   leaf.Raw,
+		batch: &sapb.FinalCertSubmissions{Submissions: []*sapb.FinalCertSubmission{sub}},
+	}
+	q := NewFinalCertQueue(pub, sa, fc, 10, time.Minute, time.Hour, 5, time.Second, log, metrics.NoopRegisterer)
+
+	// A submission with attempts left is rescheduled.
+	err = q.processBatch(context.Background())
+	test.AssertNotError(t, err, "processBatch failed")
+	test.AssertEquals(t, len(sa.rescheduled), 1)
+	test.AssertEquals(t, len(sa.removed), 0)
+
+	// A submission which has run out of attempts is dropped.
+	sub.Attempts = 4
+	err = q.processBatch(context.Background())
+	test.AssertNotError(t, err, "processBatch failed")
+	test.AssertEquals(t, len(sa.rescheduled), 1)
+	test.AssertDeepEquals(t, sa.removed, []int64{1})
+
+	// A submission to a log which the log list refuses is dropped, however
+	// many attempts it has left.
+	sub.Attempts = 0
+	entry := loglist.Log{Description: "test", URL: badURI, Key: pkB64, State: loglist.Retired}
+	pub.logList = loglist.NewStaticSource(loglist.List{entry}, loglist.Usable)
+	err = q.processBatch(context.Background())
+	test.AssertNotError(t, err, "processBatch failed")
+	test.AssertEquals(t, len(sa.rescheduled), 1)
+	test.AssertDeepEquals(t, sa.removed, []int64{1, 1})
+	pub.logList = nil
+
+	// A submission of an expired certificate is dropped.
+	fc.Set(leaf.NotAfter)
+	err = q.processBatch(context.Background())
+	test.AssertNotError(t, err, "processBatch failed")
+	test.AssertEquals(t, len(sa.rescheduled), 1)
+	test.AssertDeepEquals(t, sa.removed, []int64{1, 1, 1})
+	test.AssertEquals(t, test.CountCounterVec("result", "dropped", q.submissions), 3)
+}
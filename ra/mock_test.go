@@ -13,8 +13,8 @@ type mockInvalidAuthorizationsAuthority struct {
 	domainWithFailures string
 }
 
-func (sa *mockInvalidAuthorizationsAuthority) CountOrders(ctx context.Context, _ int64, _ time.Time, _ time.Time) (int, error) {
-	return 0, nil
+func (sa *mockInvalidAuthorizationsAuthority) CountOrders(ctx context.Context, _ int64, _ time.Time, _ time.Time) (int, time.Time, error) {
+	return 0, time.Time{}, nil
 }
 
 func (sa *mockInvalidAuthorizationsAuthority) PreviousCertificateExists(
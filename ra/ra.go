@@ -69,6 +69,9 @@ type RegistrationAuthorityImpl struct {
 	clk       clock.Clock
 	log       blog.Logger
 	keyPolicy goodkey.KeyPolicy
+	// accountKeyPolicy checks the keys of new registrations. It defaults to
+	// keyPolicy, which checks the keys of CSRs.
+	accountKeyPolicy goodkey.KeyPolicy
 	// How long before a newly created authorization expires.
 	authorizationLifetime        time.Duration
 	pendingAuthorizationLifetime time.Duration
@@ -81,6 +84,14 @@ type RegistrationAuthorityImpl struct {
 	issuers map[issuance.IssuerNameID]*issuance.Certificate
 	purger  akamaipb.AkamaiPurgerClient
 
+	// certProfiles maps the names of the certificate profiles which orders may
+	// request to the set of account IDs allowed to request them. A nil set
+	// allows every account.
+	certProfiles map[string]map[int64]bool
+	// orderValidity bounds the certificate validity periods which orders may
+	// request. Orders can't request one while MaxPeriod is zero.
+	orderValidity OrderValidityBounds
+
 	ctpolicy *ctpolicy.CTPolicy
 
 	ctpolicyResults         *prometheus.HistogramVec
@@ -183,6 +194,7 @@ func NewRegistrationAuthorityImpl(
 		rlPolicies:                   ratelimit.New(),
 		maxContactsPerReg:            maxContactsPerReg,
 		keyPolicy:                    keyPolicy,
+		accountKeyPolicy:             keyPolicy,
 		maxNames:                     maxNames,
 		reuseValidAuthz:              reuseValidAuthz,
 		publisher:                    pubc,
@@ -216,6 +228,121 @@ func (ra *RegistrationAuthorityImpl) rateLimitPoliciesLoadError(err error) {
 	ra.log.Errf("error reloading rate limit policy: %s", err)
 }
 
+// maxCertificateProfileNameLength is the length of the orders table's
+// certificateProfileName column.
+const maxCertificateProfileNameLength = 32
+
+// SetCertificateProfiles configures the certificate profiles which orders may
+// request, keyed by profile name. Each profile maps to the IDs of the accounts
+// allowed to request it; an empty list allows every account. It returns an
+// error if a name is too long for the SA to store.
+func (ra *RegistrationAuthorityImpl) SetCertificateProfiles(profiles map[string][]int64) error {
+	certProfiles := make(map[string]map[int64]bool, len(profiles))
+	for name, regIDs := range profiles {
+		if len(name) > maxCertificateProfileNameLength {
+			return fmt.Errorf("certificate profile name %q is longer than %d characters",
+				name, maxCertificateProfileNameLength)
+		}
+		var allowed map[int64]bool
+		if len(regIDs) > 0 {
+			allowed = make(map[int64]bool, len(regIDs))
+			for _, regID := range regIDs {
+				allowed[regID] = true
+			}
+		}
+		certProfiles[name] = allowed
+	}
+	ra.certProfiles = certProfiles
+	return nil
+}
+
+// OrderValidityBounds limits the certificate validity periods which orders may
+// request with the RFC 8555 notBefore and notAfter fields.
+type OrderValidityBounds struct {
+	// MinPeriod and MaxPeriod bound the length of a requested validity
+	// period. A zero MaxPeriod disallows requesting one at all.
+	MinPeriod time.Duration
+	MaxPeriod time.Duration
+	// MaxNotBeforeDelay is how far in the future a requested notBefore may
+	// be.
+	MaxNotBeforeDelay time.Duration
+}
+
+// SetAccountKeyPolicy sets the key policy which the keys of new
+// registrations are checked against, in place of the one used for CSRs.
+func (ra *RegistrationAuthorityImpl) SetAccountKeyPolicy(kp goodkey.KeyPolicy) {
+	ra.accountKeyPolicy = kp
+}
+
+// SetOrderValidityBounds configures the certificate validity periods which
+// orders may request.
+func (ra *RegistrationAuthorityImpl) SetOrderValidityBounds(bounds OrderValidityBounds) {
+	ra.orderValidity = bounds
+}
+
+// checkOrderValidity returns an error if the certificate validity period
+// requested by an order, given as Unix nanosecond timestamps which are zero if
+// not requested, falls outside the configured bounds. If only notBefore is
+// requested the CA's default validity period applies from that time, and if
+// only notAfter is requested the period runs from issuance.
+func (ra *RegistrationAuthorityImpl) checkOrderValidity(notBefore, notAfter int64) error {
+	if notBefore == 0 && notAfter == 0 {
+		return nil
+	}
+	// Without the SA storing the requested period it would be silently
+	// dropped from the order.
+	bounds := ra.orderValidity
+	if bounds.MaxPeriod == 0 || !features.Enabled(features.StoreOrderValidity) {
+		return berrors.MalformedError("NotBefore and NotAfter are not supported")
+	}
+
+	now := ra.clk.Now()
+	start := now
+	if notBefore != 0 {
+		start = time.Unix(0, notBefore)
+		if start.Before(now) {
+			return berrors.MalformedError("notBefore must not be in the past")
+		}
+		if start.Sub(now) > bounds.MaxNotBeforeDelay {
+			return berrors.MalformedError(
+				"notBefore must not be more than %s in the future", bounds.MaxNotBeforeDelay)
+		}
+	}
+	if notAfter != 0 {
+		period := time.Unix(0, notAfter).Sub(start)
+		if period <= 0 {
+			return berrors.MalformedError("notAfter must be later than notBefore")
+		}
+		if period < bounds.MinPeriod {
+			return berrors.MalformedError(
+				"requested validity period %s is shorter than the minimum of %s", period, bounds.MinPeriod)
+		}
+		if period > bounds.MaxPeriod {
+			return berrors.MalformedError(
+				"requested validity period %s is longer than the maximum of %s", period, bounds.MaxPeriod)
+		}
+	}
+	return nil
+}
+
+// checkCertificateProfile returns an error if the named certificate profile
+// is unknown, or if the account isn't allowed to request it. Profiles can
+// only be requested when the SA stores them, as otherwise the profile would be
+// silently dropped from the order.
+func (ra *RegistrationAuthorityImpl) checkCertificateProfile(name string, regID int64) error {
+	if !features.Enabled(features.StoreCertificateProfileName) {
+		return berrors.MalformedError("certificate profiles are not supported")
+	}
+	allowed, ok := ra.certProfiles[name]
+	if !ok {
+		return berrors.MalformedError("certificate profile %q is not supported", name)
+	}
+	if allowed != nil && !allowed[regID] {
+		return berrors.UnauthorizedError("account is not authorized to request certificate profile %q", name)
+	}
+	return nil
+}
+
 // certificateRequestAuthz is a struct for holding information about a valid
 // authz referenced during a certificateRequestEvent. It holds both the
 // authorization ID and the challenge type that made the authorization valid. We
@@ -257,6 +384,13 @@ type certificateRequestEvent struct {
 	// objects. It can be used to understand how the names in a certificate
 	// request were authorized.
 	Authorizations map[string]certificateRequestAuthz
+	// CertificateProfileName is the name of the certificate profile requested
+	// by the order, if any
+	CertificateProfileName string `json:",omitempty"`
+	// CTPolicyRules are the browser CT policy rules satisfied by the SCTs
+	// embedded in the certificate, if the OperatorDiverseSCTs feature is
+	// enabled
+	CTPolicyRules []string `json:",omitempty"`
 }
 
 // noRegistrationID is used for the regID parameter to GetThreshold when no
@@ -288,7 +422,9 @@ func (ra *RegistrationAuthorityImpl) checkRegistrationIPLimit(
 	}
 
 	if count >= limit.GetThreshold(ip.String(), noRegistrationID) {
-		return berrors.RateLimitError("too many registrations for this IP")
+		// The SA doesn't tell us when the oldest counted registration was
+		// created, so the full window is the best estimate we have.
+		return berrors.RateLimitError(limit.Window.Duration, "too many registrations for this IP")
 	}
 
 	return nil
@@ -325,7 +461,7 @@ func (ra *RegistrationAuthorityImpl) checkRegistrationLimits(ctx context.Context
 		ra.log.Infof("Rate limit exceeded, RegistrationsByIPRange, IP: %s", ip)
 		// For the fuzzyRegLimit we use a new error message that specifically
 		// mentions that the limit being exceeded is applied to a *range* of IPs
-		return berrors.RateLimitError("too many registrations for this IP range")
+		return berrors.RateLimitError(fuzzyRegLimit.Window.Duration, "too many registrations for this IP range")
 	}
 	ra.rateLimitCounter.WithLabelValues("registrations_by_ip_range", "pass").Inc()
 
@@ -334,7 +470,7 @@ func (ra *RegistrationAuthorityImpl) checkRegistrationLimits(ctx context.Context
 
 // NewRegistration constructs a new Registration from a request.
 func (ra *RegistrationAuthorityImpl) NewRegistration(ctx context.Context, init core.Registration) (core.Registration, error) {
-	if err := ra.keyPolicy.GoodKey(ctx, init.Key.Key); err != nil {
+	if err := ra.accountKeyPolicy.GoodKey(ctx, init.Key.Key); err != nil {
 		return core.Registration{}, berrors.MalformedError("invalid public key: %s", err.Error())
 	}
 	if err := ra.checkRegistrationLimits(ctx, init.InitialIP); err != nil {
@@ -347,9 +483,10 @@ func (ra *RegistrationAuthorityImpl) NewRegistration(ctx context.Context, init c
 	}
 	_ = mergeUpdate(&reg, init)
 
-	// This field isn't updatable by the end user, so it isn't copied by
-	// MergeUpdate. But we need to fill it in for new registrations.
+	// These fields aren't updatable by the end user, so they aren't copied by
+	// MergeUpdate. But we need to fill them in for new registrations.
 	reg.InitialIP = init.InitialIP
+	reg.ExternalAccountID = init.ExternalAccountID
 
 	if err := ra.validateContacts(ctx, reg.Contact); err != nil {
 		return core.Registration{}, err
@@ -445,7 +582,9 @@ func (ra *RegistrationAuthorityImpl) checkPendingAuthorizationLimit(ctx context.
 		if int(countPB.Count) >= limit.GetThreshold(noKey, regID) {
 			ra.rateLimitCounter.WithLabelValues("pending_authorizations_by_registration_id", "exceeded").Inc()
 			ra.log.Infof("Rate limit exceeded, PendingAuthorizationsByRegID, regID: %d", regID)
-			return berrors.RateLimitError("too many currently pending authorizations")
+			// Pending authorizations aren't counted within a window, so there is
+			// no meaningful time at which this limit resets.
+			return berrors.RateLimitError(0, "too many currently pending authorizations")
 		}
 		ra.rateLimitCounter.WithLabelValues("pending_authorizations_by_registration_id", "pass").Inc()
 	}
@@ -453,23 +592,60 @@ func (ra *RegistrationAuthorityImpl) checkPendingAuthorizationLimit(ctx context.
 }
 
 // checkInvalidAuthorizationLimits checks the failed validation limit for each
-// of the provided hostnames. It returns the first error.
+// of the provided hostnames. If more than one hostname is over the limit the
+// returned error has a suberror for each of them. Any other error is returned
+// as soon as it is seen.
 func (ra *RegistrationAuthorityImpl) checkInvalidAuthorizationLimits(ctx context.Context, regID int64, hostnames []string) error {
-	results := make(chan error, len(hostnames))
+	type limitResult struct {
+		hostname string
+		err      error
+	}
+	results := make(chan limitResult, len(hostnames))
 	for _, hostname := range hostnames {
 		go func(hostname string) {
-			results <- ra.checkInvalidAuthorizationLimit(ctx, regID, hostname)
+			results <- limitResult{hostname, ra.checkInvalidAuthorizationLimit(ctx, regID, hostname)}
 		}(hostname)
 	}
 	// We don't have to wait for all of the goroutines to finish because there's
 	// enough capacity in the chan for them all to write their result even if
 	// nothing is reading off the chan anymore.
+	var subErrors []berrors.SubBoulderError
 	for i := 0; i < len(hostnames); i++ {
-		if err := <-results; err != nil {
-			return err
+		result := <-results
+		if result.err == nil {
+			continue
+		}
+		var bErr *berrors.BoulderError
+		if !errors.As(result.err, &bErr) || bErr.Type != berrors.RateLimit {
+			return result.err
 		}
+		subErrors = append(subErrors, berrors.SubBoulderError{
+			Identifier:   identifier.FromString(result.hostname),
+			BoulderError: bErr,
+		})
 	}
-	return nil
+	if len(subErrors) == 0 {
+		return nil
+	}
+	if len(subErrors) == 1 {
+		return subErrors[0].BoulderError
+	}
+	sort.Slice(subErrors, func(i, j int) bool {
+		return subErrors[i].Identifier.Value < subErrors[j].Identifier.Value
+	})
+	// The order can't succeed until every identifier is under its limit, so
+	// the client should wait for the longest of the RetryAfters.
+	var retryAfter time.Duration
+	for _, subErr := range subErrors {
+		if subErr.RetryAfter > retryAfter {
+			retryAfter = subErr.RetryAfter
+		}
+	}
+	return berrors.RateLimitError(
+		retryAfter,
+		"too many failed authorizations recently for %q and %d more identifiers",
+		subErrors[0].Identifier.Value, len(subErrors)-1,
+	).(*berrors.BoulderError).WithSubErrors(subErrors)
 }
 
 func (ra *RegistrationAuthorityImpl) checkInvalidAuthorizationLimit(ctx context.Context, regID int64, hostname string) error {
@@ -496,7 +672,7 @@ func (ra *RegistrationAuthorityImpl) checkInvalidAuthorizationLimit(ctx context.
 	noKey := ""
 	if count.Count >= int64(limit.GetThreshold(noKey, regID)) {
 		ra.log.Infof("Rate limit exceeded, InvalidAuthorizationsByRegID, regID: %d", regID)
-		return berrors.RateLimitError("too many failed authorizations recently")
+		return berrors.RateLimitError(limit.Window.Duration, "too many failed authorizations recently")
 	}
 	return nil
 }
@@ -511,7 +687,7 @@ func (ra *RegistrationAuthorityImpl) checkNewOrdersPerAccountLimit(ctx context.C
 	}
 	latest := ra.clk.Now()
 	earliest := latest.Add(-limit.Window.Duration)
-	count, err := ra.SA.CountOrders(ctx, acctID, earliest, latest)
+	count, oldest, err := ra.SA.CountOrders(ctx, acctID, earliest, latest)
 	if err != nil {
 		return err
 	}
@@ -519,7 +695,7 @@ func (ra *RegistrationAuthorityImpl) checkNewOrdersPerAccountLimit(ctx context.C
 	noKey := ""
 	if count >= limit.GetThreshold(noKey, acctID) {
 		ra.rateLimitCounter.WithLabelValues("new_order_by_registration_id", "exceeded").Inc()
-		return berrors.RateLimitError("too many new orders recently")
+		return berrors.RateLimitError(ra.retryAfter(limit, oldest), "too many new orders recently")
 	}
 	ra.rateLimitCounter.WithLabelValues("new_order_by_registration_id", "pass").Inc()
 	return nil
@@ -528,7 +704,29 @@ func (ra *RegistrationAuthorityImpl) checkNewOrdersPerAccountLimit(ctx context.C
 // NewAuthorization constructs a new Authz from a request. Values (domains) in
 // request.Identifier will be lowercased before storage.
 func (ra *RegistrationAuthorityImpl) NewAuthorization(ctx context.Context, request core.Authorization, regID int64) (core.Authorization, error) {
-	identifier := request.Identifier
+	return ra.newAuthorization(ctx, request.Identifier, regID, true)
+}
+
+// NewPreAuthorization constructs a new authorization for the requested
+// identifier on behalf of an ACMEv2 client, as described in RFC 8555 Section
+// 7.4.1. It is subject to the same pending and failed authorization rate limits
+// as authorizations created for new orders.
+func (ra *RegistrationAuthorityImpl) NewPreAuthorization(ctx context.Context, req *rapb.NewPreAuthorizationRequest) (*corepb.Authorization, error) {
+	authz, err := ra.newAuthorization(ctx, identifier.ACMEIdentifier{
+		Type:  identifier.IdentifierType(req.IdentifierType),
+		Value: req.IdentifierValue,
+	}, req.RegistrationID, false)
+	if err != nil {
+		return nil, err
+	}
+	return bgrpc.AuthzToPB(authz)
+}
+
+// newAuthorization returns an authorization for the given identifier and
+// registration, reusing an existing valid or pending authorization where
+// possible and otherwise creating a new pending one. The apiV1 argument
+// controls whether the V1DisableNewValidations feature applies.
+func (ra *RegistrationAuthorityImpl) newAuthorization(ctx context.Context, identifier identifier.ACMEIdentifier, regID int64, apiV1 bool) (core.Authorization, error) {
 	identifier.Value = strings.ToLower(identifier.Value)
 
 	// Check that the identifier is present and appropriate
@@ -597,7 +795,7 @@ func (ra *RegistrationAuthorityImpl) NewAuthorization(ctx context.Context, reque
 		return bgrpc.PBToAuthz(pendingPB)
 	}
 
-	if features.Enabled(features.V1DisableNewValidations) {
+	if apiV1 && features.Enabled(features.V1DisableNewValidations) {
 		exists, err := ra.SA.PreviousCertificateExists(ctx, &sapb.PreviousCertificateExistsRequest{
 			Domain: identifier.Value,
 			RegID:  regID,
@@ -632,8 +830,8 @@ func (ra *RegistrationAuthorityImpl) NewAuthorization(ctx context.Context, reque
 }
 
 // MatchesCSR tests the contents of a generated certificate to make sure
-// that the PublicKey, CommonName, and DNSNames match those provided in
-// the CSR that was used to generate the certificate. It also checks the
+// that the PublicKey, CommonName, DNSNames, and IPAddresses match those provided
+// in the CSR that was used to generate the certificate. It also checks the
 // following fields for:
 //		* notBefore is not more than 24 hours ago
 //		* BasicConstraintsValid is true
@@ -644,10 +842,18 @@ func (ra *RegistrationAuthorityImpl) MatchesCSR(parsedCertificate *x509.Certific
 	// Check issued certificate matches what was expected from the CSR
 	hostNames := make([]string, len(csr.DNSNames))
 	copy(hostNames, csr.DNSNames)
+	ipAddresses := core.SANNames(nil, csr.IPAddresses)
 	if len(csr.Subject.CommonName) > 0 {
-		hostNames = append(hostNames, csr.Subject.CommonName)
+		// A CommonName holding an IP address must appear among the IP address
+		// SANs rather than the DNS name SANs.
+		if ip := net.ParseIP(csr.Subject.CommonName); ip != nil {
+			ipAddresses = append(ipAddresses, ip.String())
+		} else {
+			hostNames = append(hostNames, csr.Subject.CommonName)
+		}
 	}
 	hostNames = core.UniqueLowerNames(hostNames)
+	ipAddresses = core.UniqueLowerNames(ipAddresses)
 
 	if !core.KeyDigestEquals(parsedCertificate.PublicKey, csr.PublicKey) {
 		return berrors.InternalServerError("generated certificate public key doesn't match CSR public key")
@@ -662,7 +868,8 @@ func (ra *RegistrationAuthorityImpl) MatchesCSR(parsedCertificate *x509.Certific
 	if !reflect.DeepEqual(parsedNames, hostNames) {
 		return berrors.InternalServerError("generated certificate DNSNames don't match CSR DNSNames")
 	}
-	if !reflect.DeepEqual(parsedCertificate.IPAddresses, csr.IPAddresses) {
+	parsedIPAddresses := core.UniqueLowerNames(core.SANNames(nil, parsedCertificate.IPAddresses))
+	if len(parsedIPAddresses) != len(parsedCertificate.IPAddresses) || !reflect.DeepEqual(parsedIPAddresses, ipAddresses) {
 		return berrors.InternalServerError("generated certificate IPAddresses don't match CSR IPAddresses")
 	}
 	if !reflect.DeepEqual(parsedCertificate.EmailAddresses, csr.EmailAddresses) {
@@ -792,20 +999,37 @@ func (ra *RegistrationAuthorityImpl) checkAuthorizationsCAA(
 		}
 	}
 
+	if len(badNames) == 0 {
+		if len(recheckAuthzs) > 0 {
+			return ra.recheckCAA(ctx, recheckAuthzs)
+		}
+		return nil
+	}
+
+	// Some names are unauthorized. Report them along with any CAA recheck
+	// failures so the client can fix every name in one go.
+	var subErrors []berrors.SubBoulderError
+	for _, name := range badNames {
+		subErrors = append(subErrors, berrors.SubBoulderError{
+			Identifier:   identifier.FromString(name),
+			BoulderError: berrors.UnauthorizedError("authorization not found or expired").(*berrors.BoulderError),
+		})
+	}
 	if len(recheckAuthzs) > 0 {
-		if err := ra.recheckCAA(ctx, recheckAuthzs); err != nil {
+		caaSubErrors, err := ra.recheckCAASubErrors(ctx, recheckAuthzs)
+		if err != nil {
 			return err
 		}
+		subErrors = append(subErrors, caaSubErrors...)
 	}
-
-	if len(badNames) > 0 {
-		return berrors.UnauthorizedError(
-			"authorizations for these names not found or expired: %s",
-			strings.Join(badNames, ", "),
-		)
+	err := berrors.UnauthorizedError(
+		"authorizations for these names not found or expired: %s",
+		strings.Join(badNames, ", "),
+	)
+	if len(subErrors) == 1 {
+		return err
 	}
-
-	return nil
+	return err.(*berrors.BoulderError).WithSubErrors(subErrors)
 }
 
 // recheckCAA accepts a list of of names that need to have their CAA records
@@ -813,6 +1037,34 @@ func (ra *RegistrationAuthorityImpl) checkAuthorizationsCAA(
 // performs the CAA checks required for each. If any of the rechecks fail an
 // error is returned.
 func (ra *RegistrationAuthorityImpl) recheckCAA(ctx context.Context, authzs []*core.Authorization) error {
+	subErrors, err := ra.recheckCAASubErrors(ctx, authzs)
+	if err != nil {
+		return err
+	}
+	if len(subErrors) > 0 {
+		var detail string
+		// If there was only one error, then use it as the top level error that is
+		// returned.
+		if len(subErrors) == 1 {
+			return subErrors[0].BoulderError
+		}
+		detail = fmt.Sprintf(
+			"Rechecking CAA for %q and %d more identifiers failed. "+
+				"Refer to sub-problems for more information",
+			subErrors[0].Identifier.Value,
+			len(subErrors)-1)
+		return (&berrors.BoulderError{
+			Type:   berrors.CAA,
+			Detail: detail,
+		}).WithSubErrors(subErrors)
+	}
+	return nil
+}
+
+// recheckCAASubErrors rechecks CAA for each of the given authorizations and
+// returns a suberror for every identifier that failed the recheck. Errors other
+// than CAA failures are returned directly.
+func (ra *RegistrationAuthorityImpl) recheckCAASubErrors(ctx context.Context, authzs []*core.Authorization) ([]berrors.SubBoulderError, error) {
 	ra.recheckCAACounter.Add(float64(len(authzs)))
 
 	type authzCAAResult struct {
@@ -877,28 +1129,11 @@ func (ra *RegistrationAuthorityImpl) recheckCAA(ctx context.Context, authzs []*c
 					Identifier:   recheckResult.authz.Identifier,
 					BoulderError: bErr})
 			} else {
-				return err
+				return nil, err
 			}
 		}
 	}
-	if len(subErrors) > 0 {
-		var detail string
-		// If there was only one error, then use it as the top level error that is
-		// returned.
-		if len(subErrors) == 1 {
-			return subErrors[0].BoulderError
-		}
-		detail = fmt.Sprintf(
-			"Rechecking CAA for %q and %d more identifiers failed. "+
-				"Refer to sub-problems for more information",
-			subErrors[0].Identifier.Value,
-			len(subErrors)-1)
-		return (&berrors.BoulderError{
-			Type:   berrors.CAA,
-			Detail: detail,
-		}).WithSubErrors(subErrors)
-	}
-	return nil
+	return subErrors, nil
 }
 
 // failOrder marks an order as failed by setting the problem details field of
@@ -962,7 +1197,7 @@ func (ra *RegistrationAuthorityImpl) FinalizeOrder(ctx context.Context, req *rap
 
 	// Dedupe, lowercase and sort both the names from the CSR and the names in the
 	// order.
-	csrNames := core.UniqueLowerNames(csrOb.DNSNames)
+	csrNames := core.UniqueLowerNames(core.SANNames(csrOb.DNSNames, csrOb.IPAddresses))
 	orderNames := core.UniqueLowerNames(order.Names)
 
 	// Immediately reject the request if the number of names differ
@@ -1002,7 +1237,12 @@ func (ra *RegistrationAuthorityImpl) FinalizeOrder(ctx context.Context, req *rap
 	// We use IssuerNameID 0 here because (as of now) only the v1 flow sets this
 	// field. This v2 flow allows the CA to select the issuer based on the CSR's
 	// PublicKeyAlgorithm.
-	cert, err := ra.issueCertificate(ctx, issueReq, accountID(order.RegistrationID), orderID(order.Id), issuance.IssuerNameID(0))
+	opts := issuanceOptions{
+		profileName: order.CertificateProfileName,
+		notBefore:   order.NotBefore,
+		notAfter:    order.NotAfter,
+	}
+	cert, err := ra.issueCertificate(ctx, issueReq, accountID(order.RegistrationID), orderID(order.Id), issuance.IssuerNameID(0), opts)
 	if err != nil {
 		// Fail the order. The problem is computed using
 		// `web.ProblemDetailsForError`, the same function the WFE uses to convert
@@ -1052,7 +1292,7 @@ func (ra *RegistrationAuthorityImpl) NewCertificate(ctx context.Context, req cor
 	// NewCertificate provides an order ID of 0, indicating this is a classic ACME
 	// v1 issuance request from the new certificate endpoint that is not
 	// associated with an ACME v2 order.
-	return ra.issueCertificate(ctx, req, accountID(regID), orderID(0), issuance.IssuerNameID(issuerNameID))
+	return ra.issueCertificate(ctx, req, accountID(regID), orderID(0), issuance.IssuerNameID(issuerNameID), issuanceOptions{})
 }
 
 // To help minimize the chance that an accountID would be used as an order ID
@@ -1061,6 +1301,16 @@ func (ra *RegistrationAuthorityImpl) NewCertificate(ctx context.Context, req cor
 type accountID int64
 type orderID int64
 
+// issuanceOptions holds the certificate options requested by an ACME v2 order.
+// The zero value, used for ACME v1 issuance, leaves every choice to the CA.
+type issuanceOptions struct {
+	profileName string
+	// notBefore and notAfter are Unix nanosecond timestamps, or zero if not
+	// requested.
+	notBefore int64
+	notAfter  int64
+}
+
 // issueCertificate sets up a log event structure and captures any errors
 // encountered during issuance, then calls issueCertificateInner.
 // Used by both v1's NewCertificate and v2's FinalizeOrder.
@@ -1069,16 +1319,18 @@ func (ra *RegistrationAuthorityImpl) issueCertificate(
 	req core.CertificateRequest,
 	acctID accountID,
 	oID orderID,
-	issuerNameID issuance.IssuerNameID) (core.Certificate, error) {
+	issuerNameID issuance.IssuerNameID,
+	opts issuanceOptions) (core.Certificate, error) {
 	// Construct the log event
 	logEvent := certificateRequestEvent{
-		ID:          core.NewToken(),
-		OrderID:     int64(oID),
-		Requester:   int64(acctID),
-		RequestTime: ra.clk.Now(),
+		ID:                     core.NewToken(),
+		OrderID:                int64(oID),
+		Requester:              int64(acctID),
+		RequestTime:            ra.clk.Now(),
+		CertificateProfileName: opts.profileName,
 	}
 	var result string
-	cert, err := ra.issueCertificateInner(ctx, req, acctID, oID, issuerNameID, &logEvent)
+	cert, err := ra.issueCertificateInner(ctx, req, acctID, oID, issuerNameID, opts, &logEvent)
 	if err != nil {
 		logEvent.Error = err.Error()
 		result = "error"
@@ -1110,12 +1362,22 @@ func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 	acctID accountID,
 	oID orderID,
 	issuerNameID issuance.IssuerNameID,
+	opts issuanceOptions,
 	logEvent *certificateRequestEvent) (core.Certificate, error) {
 	emptyCert := core.Certificate{}
 	if acctID <= 0 {
 		return emptyCert, berrors.MalformedError("invalid account ID: %d", acctID)
 	}
 
+	// The account may have lost access to the certificate profile since the
+	// order was created.
+	if opts.profileName != "" {
+		err := ra.checkCertificateProfile(opts.profileName, int64(acctID))
+		if err != nil {
+			return emptyCert, err
+		}
+	}
+
 	// OrderID can be 0 if `issueCertificate` is called by `NewCertificate` for
 	// the classic issuance flow. It should never be less than 0.
 	if oID < 0 {
@@ -1129,11 +1391,10 @@ func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 
 	csr := req.CSR
 	logEvent.CommonName = csr.Subject.CommonName
-	logEvent.Names = csr.DNSNames
+	logEvent.Names = core.SANNames(csr.DNSNames, csr.IPAddresses)
 
-	// Validate that authorization key is authorized for all domains in the CSR
-	names := make([]string, len(csr.DNSNames))
-	copy(names, csr.DNSNames)
+	// Validate that authorization key is authorized for all names in the CSR
+	names := core.SANNames(csr.DNSNames, csr.IPAddresses)
 
 	if core.KeyDigestEquals(csr.PublicKey, account.Key) {
 		return emptyCert, berrors.MalformedError("certificate public key must be different than account key")
@@ -1187,10 +1448,13 @@ func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 
 	// Create the certificate and log the result
 	issueReq := &capb.IssueCertificateRequest{
-		Csr:            csr.Raw,
-		RegistrationID: int64(acctID),
-		OrderID:        int64(oID),
-		IssuerNameID:   int64(issuerNameID),
+		Csr:                    csr.Raw,
+		RegistrationID:         int64(acctID),
+		OrderID:                int64(oID),
+		IssuerNameID:           int64(issuerNameID),
+		CertificateProfileName: opts.profileName,
+		NotBefore:              opts.notBefore,
+		NotAfter:               opts.notAfter,
 	}
 
 	// wrapError adds a prefix to an error. If the error is a boulder error then
@@ -1212,15 +1476,19 @@ func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 	if err != nil {
 		return emptyCert, wrapError(err, "parsing precertificate")
 	}
-	scts, err := ra.getSCTs(ctx, precert.DER, parsedPrecert.NotAfter)
+	scts, rules, err := ra.getSCTs(ctx, precert.DER, parsedPrecert.NotBefore, parsedPrecert.NotAfter)
 	if err != nil {
 		return emptyCert, wrapError(err, "getting SCTs")
 	}
+	for _, rule := range rules {
+		logEvent.CTPolicyRules = append(logEvent.CTPolicyRules, rule.String())
+	}
 	cert, err := ra.CA.IssueCertificateForPrecertificate(ctx, &capb.IssueCertificateForPrecertificateRequest{
-		DER:            precert.DER,
-		SCTs:           scts,
-		RegistrationID: int64(acctID),
-		OrderID:        int64(oID),
+		DER:                    precert.DER,
+		SCTs:                   scts,
+		RegistrationID:         int64(acctID),
+		OrderID:                int64(oID),
+		CertificateProfileName: opts.profileName,
 	})
 	if err != nil {
 		return emptyCert, wrapError(err, "issuing certificate for precertificate")
@@ -1233,8 +1501,12 @@ func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 		return emptyCert, berrors.InternalServerError("failed to parse certificate: %s", err.Error())
 	}
 
-	// Asynchronously submit the final certificate to any configured logs
-	go ra.ctpolicy.SubmitFinalCert(cert.Der, parsedCertificate.NotAfter)
+	// Submit the final certificate to any configured logs, either by queueing
+	// the submissions for the publisher to retry until they succeed or, if
+	// that's disabled or fails, asynchronously.
+	if !features.Enabled(features.FinalCertCTQueue) || !ra.queueFinalCert(ctx, parsedCertificate) {
+		go ra.ctpolicy.SubmitFinalCert(cert.Der, parsedCertificate.NotAfter)
+	}
 
 	err = ra.MatchesCSR(parsedCertificate, csr)
 	if err != nil {
@@ -1254,9 +1526,40 @@ func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 	return res, nil
 }
 
-func (ra *RegistrationAuthorityImpl) getSCTs(ctx context.Context, cert []byte, expiration time.Time) (core.SCTDERs, error) {
+// queueFinalCert adds a submission of the final certificate to each final CT
+// log to the SA's durable queue, which the publisher works through. It returns
+// false if the submissions couldn't be queued.
+func (ra *RegistrationAuthorityImpl) queueFinalCert(ctx context.Context, cert *x509.Certificate) bool {
+	finalLogs := ra.ctpolicy.FinalLogs(cert.NotAfter)
+	if len(finalLogs) == 0 {
+		return true
+	}
+	req := &sapb.AddFinalCertSubmissionsRequest{
+		Serial: core.SerialToString(cert.SerialNumber),
+	}
+	for _, l := range finalLogs {
+		req.Logs = append(req.Logs, &sapb.CTLog{Url: l.URI, PublicKey: l.Key})
+	}
+	_, err := ra.SA.AddFinalCertSubmissions(ctx, req)
+	if err != nil {
+		ra.log.Warningf("failed to queue final certificate %s for CT submission: %s", req.Serial, err)
+		return false
+	}
+	return true
+}
+
+// getSCTs gets SCTs for a precertificate. If the OperatorDiverseSCTs feature
+// is enabled, it also returns the browser CT policy rules the SCTs satisfy.
+func (ra *RegistrationAuthorityImpl) getSCTs(ctx context.Context, cert []byte, notBefore, expiration time.Time) (core.SCTDERs, []ctpolicy.Rule, error) {
 	started := ra.clk.Now()
-	scts, err := ra.ctpolicy.GetSCTs(ctx, cert, expiration)
+	var scts core.SCTDERs
+	var rules []ctpolicy.Rule
+	var err error
+	if features.Enabled(features.OperatorDiverseSCTs) {
+		scts, rules, err = ra.ctpolicy.GetCompliantSCTs(ctx, cert, notBefore, expiration)
+	} else {
+		scts, err = ra.ctpolicy.GetSCTs(ctx, cert, expiration)
+	}
 	took := ra.clk.Since(started)
 	// The final cert has already been issued so actually return it to the
 	// user even if this fails since we aren't actually doing anything with
@@ -1271,18 +1574,23 @@ func (ra *RegistrationAuthorityImpl) getSCTs(ctx context.Context, cert []byte, e
 		}
 		ra.log.Warningf("ctpolicy.GetSCTs failed: %s", err)
 		ra.ctpolicyResults.With(prometheus.Labels{"result": state}).Observe(took.Seconds())
-		return nil, err
+		return nil, nil, err
 	}
 	ra.ctpolicyResults.With(prometheus.Labels{"result": "success"}).Observe(took.Seconds())
-	return scts, nil
+	return scts, rules, nil
 }
 
 // domainsForRateLimiting transforms a list of FQDNs into a list of eTLD+1's
 // for the purpose of rate limiting. It also de-duplicates the output
-// domains. Exact public suffix matches are included.
+// domains. Exact public suffix matches are included. IP addresses have no
+// public suffix and are included as-is.
 func domainsForRateLimiting(names []string) ([]string, error) {
 	var domains []string
 	for _, name := range names {
+		if net.ParseIP(name) != nil {
+			domains = append(domains, name)
+			continue
+		}
 		domain, err := publicsuffix.Domain(name)
 		if err != nil {
 			// The only possible errors are:
@@ -1300,27 +1608,48 @@ func domainsForRateLimiting(names []string) ([]string, error) {
 // enforceNameCounts uses the provided count RPC to find a count of certificates
 // for each of the names. If the count for any of the names exceeds the limit
 // for the given registration then the names out of policy are returned to be
-// used for a rate limit error.
+// used for a rate limit error, along with how long until each of them is
+// back under the limit.
 func (ra *RegistrationAuthorityImpl) enforceNameCounts(
 	ctx context.Context,
 	names []string,
 	limit ratelimit.RateLimitPolicy,
-	regID int64) ([]string, error) {
+	regID int64) ([]string, map[string]time.Duration, error) {
 
 	now := ra.clk.Now()
 	windowBegin := limit.WindowBegin(now)
 	counts, err := ra.SA.CountCertificatesByNames(ctx, names, windowBegin, now)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var badNames []string
+	retryAfters := make(map[string]time.Duration)
 	for _, entry := range counts {
 		if int(entry.Count) >= limit.GetThreshold(entry.Name, regID) {
 			badNames = append(badNames, entry.Name)
+			var oldest time.Time
+			if entry.Oldest != 0 {
+				oldest = time.Unix(0, entry.Oldest)
+			}
+			retryAfters[entry.Name] = ra.retryAfter(limit, oldest)
 		}
 	}
-	return badNames, nil
+	return badNames, retryAfters, nil
+}
+
+// retryAfter returns how long it will be until oldest, the time of the oldest
+// event counted against limit, leaves the limit's window. If oldest is the zero
+// value the full window is returned, since that is an upper bound on the wait.
+func (ra *RegistrationAuthorityImpl) retryAfter(limit ratelimit.RateLimitPolicy, oldest time.Time) time.Duration {
+	if oldest.IsZero() {
+		return limit.Window.Duration
+	}
+	retryAfter := oldest.Add(limit.Window.Duration).Sub(ra.clk.Now())
+	if retryAfter < 0 {
+		return 0
+	}
+	return retryAfter
 }
 
 func (ra *RegistrationAuthorityImpl) checkCertificatesPerNameLimit(ctx context.Context, names []string, limit ratelimit.RateLimitPolicy, regID int64) error {
@@ -1341,7 +1670,7 @@ func (ra *RegistrationAuthorityImpl) checkCertificatesPerNameLimit(ctx context.C
 		return err
 	}
 
-	namesOutOfLimit, err := ra.enforceNameCounts(ctx, tldNames, limit, regID)
+	namesOutOfLimit, retryAfters, err := ra.enforceNameCounts(ctx, tldNames, limit, regID)
 	if err != nil {
 		return fmt.Errorf("checking certificates per name limit for %q: %s",
 			names, err)
@@ -1362,17 +1691,25 @@ func (ra *RegistrationAuthorityImpl) checkCertificatesPerNameLimit(ctx context.C
 
 		ra.log.Infof("Rate limit exceeded, CertificatesForDomain, regID: %d, domains: %s", regID, strings.Join(namesOutOfLimit, ", "))
 		ra.rateLimitCounter.WithLabelValues("certificates_for_domain", "exceeded").Inc()
+		// The request can't succeed until every name is back under the limit,
+		// so the overall wait is the longest of the individual waits.
+		var retryAfter time.Duration
+		for _, name := range namesOutOfLimit {
+			if retryAfters[name] > retryAfter {
+				retryAfter = retryAfters[name]
+			}
+		}
 		if len(namesOutOfLimit) > 1 {
 			var subErrors []berrors.SubBoulderError
 			for _, name := range namesOutOfLimit {
 				subErrors = append(subErrors, berrors.SubBoulderError{
-					Identifier:   identifier.DNSIdentifier(name),
-					BoulderError: berrors.RateLimitError("too many certificates already issued").(*berrors.BoulderError),
+					Identifier:   identifier.FromString(name),
+					BoulderError: berrors.RateLimitError(retryAfters[name], "too many certificates already issued").(*berrors.BoulderError),
 				})
 			}
-			return berrors.RateLimitError("too many certificates already issued for multiple names (%s and %d others)", namesOutOfLimit[0], len(namesOutOfLimit)).(*berrors.BoulderError).WithSubErrors(subErrors)
+			return berrors.RateLimitError(retryAfter, "too many certificates already issued for multiple names (%s and %d others)", namesOutOfLimit[0], len(namesOutOfLimit)).(*berrors.BoulderError).WithSubErrors(subErrors)
 		}
-		return berrors.RateLimitError("too many certificates already issued for: %s", namesOutOfLimit[0])
+		return berrors.RateLimitError(retryAfter, "too many certificates already issued for: %s", namesOutOfLimit[0])
 	}
 	ra.rateLimitCounter.WithLabelValues("certificates_for_domain", "pass").Inc()
 
@@ -1386,7 +1723,10 @@ func (ra *RegistrationAuthorityImpl) checkCertificatesPerFQDNSetLimit(ctx contex
 	}
 	names = core.UniqueLowerNames(names)
 	if int(count) >= limit.GetThreshold(strings.Join(names, ","), regID) {
+		// The SA doesn't tell us when the oldest counted certificate was issued,
+		// so the full window is the best estimate we have.
 		return berrors.RateLimitError(
+			limit.Window.Duration,
 			"too many certificates already issued for exact set of domains: %s",
 			strings.Join(names, ","),
 		)
@@ -1694,6 +2034,8 @@ func revokeEvent(state, serial, cn string, names []string, revocationCode revoca
 func (ra *RegistrationAuthorityImpl) revokeCertificate(ctx context.Context, cert x509.Certificate, code revocation.Reason, revokedBy int64, source string, comment string) error {
 	reason := int32(code)
 	revokedAt := ra.clk.Now().UnixNano()
+	serial := core.SerialToString(cert.SerialNumber)
+	var response []byte
 	ocspResponse, err := ra.CA.GenerateOCSP(ctx, &capb.GenerateOCSPRequest{
 		CertDER:   cert.Raw,
 		Status:    string(core.OCSPStatusRevoked),
@@ -1701,14 +2043,21 @@ func (ra *RegistrationAuthorityImpl) revokeCertificate(ctx context.Context, cert
 		RevokedAt: revokedAt,
 	})
 	if err != nil {
-		return err
+		// With the revocation event queue, the ocsp-updater signs the revoked
+		// response if the CA can't do so now, so the revocation itself
+		// doesn't have to wait for the CA.
+		if !features.Enabled(features.RevocationEventQueue) {
+			return err
+		}
+		ra.log.Warningf("Failed to generate OCSP response for revoked certificate %s, leaving it to the ocsp-updater: %s", serial, err)
+	} else {
+		response = ocspResponse.Response
 	}
-	serial := core.SerialToString(cert.SerialNumber)
 	err = ra.SA.RevokeCertificate(ctx, &sapb.RevokeCertificateRequest{
 		Serial:   serial,
 		Reason:   int64(code),
 		Date:     revokedAt,
-		Response: ocspResponse.Response,
+		Response: response,
 	})
 	if err != nil {
 		return err
@@ -1845,7 +2194,7 @@ func (ra *RegistrationAuthorityImpl) DeactivateAuthorization(ctx context.Context
 func (ra *RegistrationAuthorityImpl) checkOrderNames(names []string) error {
 	idents := make([]identifier.ACMEIdentifier, len(names))
 	for i, name := range names {
-		idents[i] = identifier.DNSIdentifier(name)
+		idents[i] = identifier.FromString(name)
 	}
 	if err := ra.PA.WillingToIssueWildcards(idents); err != nil {
 		return err
@@ -1856,8 +2205,22 @@ func (ra *RegistrationAuthorityImpl) checkOrderNames(names []string) error {
 // NewOrder creates a new order object
 func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.NewOrderRequest) (*corepb.Order, error) {
 	order := &corepb.Order{
-		RegistrationID: req.RegistrationID,
-		Names:          core.UniqueLowerNames(req.Names),
+		RegistrationID:         req.RegistrationID,
+		Names:                  core.UniqueLowerNames(req.Names),
+		CertificateProfileName: req.CertificateProfileName,
+		NotBefore:              req.NotBefore,
+		NotAfter:               req.NotAfter,
+	}
+
+	if order.CertificateProfileName != "" {
+		err := ra.checkCertificateProfile(order.CertificateProfileName, order.RegistrationID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ra.checkOrderValidity(order.NotBefore, order.NotAfter); err != nil {
+		return nil, err
 	}
 
 	if len(order.Names) > ra.maxNames {
@@ -1885,8 +2248,12 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 	if err != nil && !errors.Is(err, berrors.NotFound) {
 		return nil, err
 	}
-	// If there was an order, return it
-	if existingOrder != nil {
+	// If there was an order for the same certificate profile and validity
+	// period, return it
+	if existingOrder != nil &&
+		existingOrder.CertificateProfileName == order.CertificateProfileName &&
+		existingOrder.NotBefore == order.NotBefore &&
+		existingOrder.NotAfter == order.NotAfter {
 		return existingOrder, nil
 	}
 
@@ -1988,10 +2355,7 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 	// authorization for each.
 	var newAuthzs []*corepb.Authorization
 	for _, name := range missingAuthzNames {
-		pb, err := ra.createPendingAuthz(ctx, order.RegistrationID, identifier.ACMEIdentifier{
-			Type:  identifier.DNS,
-			Value: name,
-		})
+		pb, err := ra.createPendingAuthz(ctx, order.RegistrationID, identifier.FromString(name))
 		if err != nil {
 			return nil, err
 		}
@@ -2106,6 +2470,7 @@ func wildcardOverlap(dnsNames []string) error {
 	for _, v := range dnsNames {
 		nameMap[v] = true
 	}
+	var redundant []string
 	for name := range nameMap {
 		if name[0] == '*' {
 			continue
@@ -2113,9 +2478,25 @@ func wildcardOverlap(dnsNames []string) error {
 		labels := strings.Split(name, ".")
 		labels[0] = "*"
 		if nameMap[strings.Join(labels, ".")] {
-			return berrors.MalformedError(
-				"Domain name %q is redundant with a wildcard domain in the same request. Remove one or the other from the certificate request.", name)
+			redundant = append(redundant, name)
 		}
 	}
-	return nil
+	if len(redundant) == 0 {
+		return nil
+	}
+	sort.Strings(redundant)
+	const explanation = "is redundant with a wildcard domain in the same request. Remove one or the other from the certificate request."
+	if len(redundant) == 1 {
+		return berrors.MalformedError("Domain name %q %s", redundant[0], explanation)
+	}
+	var subErrors []berrors.SubBoulderError
+	for _, name := range redundant {
+		subErrors = append(subErrors, berrors.SubBoulderError{
+			Identifier:   identifier.DNSIdentifier(name),
+			BoulderError: berrors.MalformedError("Domain name %s", explanation).(*berrors.BoulderError),
+		})
+	}
+	return berrors.MalformedError(
+		"Domain name %q and %d more are redundant with wildcard domains in the same request. Refer to sub-problems for more information.",
+		redundant[0], len(redundant)-1).(*berrors.BoulderError).WithSubErrors(subErrors)
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
@@ -447,6 +448,29 @@ func TestNewRegistration(t *testing.T) {
 	test.Assert(t, core.KeyDigestEquals(reg.Key, AccountKeyB), "Retrieved registration differed.")
 }
 
+func TestNewRegistrationEd25519(t *testing.T) {
+	_, _, ra, _, cleanUp := initAuthorities(t)
+	defer cleanUp()
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	test.AssertNotError(t, err, "Failed to generate Ed25519 key")
+	input := core.Registration{
+		Key:       &jose.JSONWebKey{Key: pub},
+		InitialIP: net.ParseIP("7.6.6.5"),
+	}
+
+	_, err = ra.NewRegistration(ctx, input)
+	test.AssertErrorIs(t, err, berrors.Malformed)
+
+	// Allowing Ed25519 account keys doesn't allow them in CSRs.
+	accountKP := ra.keyPolicy
+	accountKP.AllowEd25519 = true
+	ra.SetAccountKeyPolicy(accountKP)
+	_, err = ra.NewRegistration(ctx, input)
+	test.AssertNotError(t, err, "NewRegistration failed with an Ed25519 key")
+	err = ra.keyPolicy.GoodKey(ctx, pub)
+	test.AssertError(t, err, "CSR key policy allowed an Ed25519 key")
+}
+
 type mockSAFailsNewRegistration struct {
 	mocks.StorageAuthority
 }
@@ -1285,6 +1309,54 @@ func TestAuthzFailedRateLimitingNewOrder(t *testing.T) {
 	testcase()
 }
 
+func TestMatchesCSRIPAddresses(t *testing.T) {
+	fc := clock.NewFake()
+	fc.Set(time.Now())
+	ra := &RegistrationAuthorityImpl{clk: fc}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate test key")
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:     pkix.Name{CommonName: "93.184.216.34"},
+		DNSNames:    []string{"example.com"},
+		IPAddresses: []net.IP{net.ParseIP("2606:2800:220:1:248:1893:25c8:1946")},
+	}, key)
+	test.AssertNotError(t, err, "failed to create test CSR")
+	csr, err := x509.ParseCertificateRequest(csrDER)
+	test.AssertNotError(t, err, "failed to parse test CSR")
+
+	issue := func(ips []net.IP) *x509.Certificate {
+		template := &x509.Certificate{
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: "93.184.216.34"},
+			DNSNames:              []string{"example.com"},
+			IPAddresses:           ips,
+			NotBefore:             fc.Now(),
+			NotAfter:              fc.Now().Add(time.Hour),
+			BasicConstraintsValid: true,
+			ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		}
+		certDER, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+		test.AssertNotError(t, err, "failed to create test certificate")
+		cert, err := x509.ParseCertificate(certDER)
+		test.AssertNotError(t, err, "failed to parse test certificate")
+		return cert
+	}
+
+	// The IP address CommonName must appear among the IP address SANs, in any
+	// order.
+	cert := issue([]net.IP{net.ParseIP("2606:2800:220:1:248:1893:25c8:1946"), net.ParseIP("93.184.216.34")})
+	test.AssertNotError(t, ra.MatchesCSR(cert, csr), "MatchesCSR rejected matching IP addresses")
+
+	cert = issue([]net.IP{net.ParseIP("2606:2800:220:1:248:1893:25c8:1946")})
+	err = ra.MatchesCSR(cert, csr)
+	test.AssertError(t, err, "MatchesCSR accepted a certificate missing the CommonName IP address")
+
+	cert = issue([]net.IP{net.ParseIP("2606:2800:220:1:248:1893:25c8:1946"), net.ParseIP("93.184.216.35")})
+	err = ra.MatchesCSR(cert, csr)
+	test.AssertError(t, err, "MatchesCSR accepted a certificate with the wrong IP address")
+}
+
 func TestDomainsForRateLimiting(t *testing.T) {
 	domains, err := domainsForRateLimiting([]string{})
 	test.AssertNotError(t, err, "failed on empty")
@@ -1309,6 +1381,10 @@ func TestDomainsForRateLimiting(t *testing.T) {
 	domains, err = domainsForRateLimiting([]string{"github.io", "foo.github.io", "bar.github.io"})
 	test.AssertNotError(t, err, "failed on public suffix private domain")
 	test.AssertDeepEquals(t, domains, []string{"bar.github.io", "foo.github.io", "github.io"})
+
+	domains, err = domainsForRateLimiting([]string{"www.example.com", "93.184.216.34", "2606:2800:220:1:248:1893:25c8:1946"})
+	test.AssertNotError(t, err, "failed on IP addresses")
+	test.AssertDeepEquals(t, domains, []string{"2606:2800:220:1:248:1893:25c8:1946", "93.184.216.34", "example.com"})
 }
 
 func TestRateLimitLiveReload(t *testing.T) {
@@ -1444,6 +1520,22 @@ func TestCheckCertificatesPerNameLimit(t *testing.T) {
 	test.AssertErrorWraps(t, err, &bErr)
 	test.AssertEquals(t, len(bErr.SubErrors), 2)
 
+	// The overall retry-after is the longest wait across the names out of
+	// limit, and each sub error carries its own wait.
+	mockSA.nameCounts["example.com"].Oldest = fc.Now().Add(-20 * time.Hour).UnixNano()
+	mockSA.nameCounts["other-example.com"].Oldest = fc.Now().Add(-22 * time.Hour).UnixNano()
+	err = ra.checkCertificatesPerNameLimit(ctx, []string{"example.com", "other-example.com", "good-example.com"}, rlp, 99)
+	test.AssertErrorWraps(t, err, &bErr)
+	test.AssertEquals(t, bErr.RetryAfter, 3*time.Hour)
+	for _, subErr := range bErr.SubErrors {
+		switch subErr.Identifier.Value {
+		case "example.com":
+			test.AssertEquals(t, subErr.RetryAfter, 3*time.Hour)
+		case "other-example.com":
+			test.AssertEquals(t, subErr.RetryAfter, time.Hour)
+		}
+	}
+
 	// SA misbehaved and didn't send back a count for every input name
 	err = ra.checkCertificatesPerNameLimit(ctx, []string{"zombo.com", "www.example.com", "example.com"}, rlp, 99)
 	test.AssertError(t, err, "incorrectly failed to error on misbehaving SA")
@@ -1468,6 +1560,23 @@ func TestCheckCertificatesPerNameLimit(t *testing.T) {
 	test.AssertErrorIs(t, err, berrors.RateLimit)
 }
 
+func TestRetryAfter(t *testing.T) {
+	fc := clock.NewFake()
+	ra := &RegistrationAuthorityImpl{clk: fc}
+	limit := ratelimit.RateLimitPolicy{
+		Threshold: 3,
+		Window:    cmd.ConfigDuration{Duration: 24 * time.Hour},
+	}
+
+	// With no known oldest event the whole window is the best estimate.
+	test.AssertEquals(t, ra.retryAfter(limit, time.Time{}), 24*time.Hour)
+	// Otherwise it is the time until the oldest event leaves the window.
+	test.AssertEquals(t, ra.retryAfter(limit, fc.Now().Add(-20*time.Hour)), 4*time.Hour)
+	// An oldest event that has already left the window never yields a
+	// negative wait.
+	test.AssertEquals(t, ra.retryAfter(limit, fc.Now().Add(-25*time.Hour)), time.Duration(0))
+}
+
 // TestCheckExactCertificateLimit tests that the duplicate certificate limit
 // applied to FQDN sets is respected.
 func TestCheckExactCertificateLimit(t *testing.T) {
@@ -2068,6 +2177,53 @@ func TestRecheckCAAInternalServerError(t *testing.T) {
 	test.AssertErrorIs(t, err, berrors.InternalServer)
 }
 
+func TestCheckAuthorizationsCAASubErrors(t *testing.T) {
+	fc := clock.NewFake()
+	ra := &RegistrationAuthorityImpl{
+		clk:                   fc,
+		log:                   blog.NewMock(),
+		caa:                   &caaFailer{},
+		authorizationLifetime: 30 * 24 * time.Hour,
+		recheckCAACounter:     prometheus.NewCounter(prometheus.CounterOpts{Name: "recheck_caa"}),
+	}
+
+	// An authorization old enough to need a CAA recheck, which will fail for
+	// a.com, and one fresh enough not to.
+	staleExpiry := fc.Now().Add(time.Hour)
+	freshExpiry := fc.Now().Add(ra.authorizationLifetime)
+	staleAuthz := makeHTTP01Authorization("a.com")
+	staleAuthz.Expires = &staleExpiry
+	freshAuthz := makeHTTP01Authorization("b.com")
+	freshAuthz.Expires = &freshExpiry
+	authzs := map[string]*core.Authorization{
+		"a.com": staleAuthz,
+		"b.com": freshAuthz,
+	}
+
+	// A single missing authorization is reported without suberrors.
+	err := ra.checkAuthorizationsCAA(ctx, []string{"b.com", "e.com"}, authzs, 1, fc.Now())
+	test.AssertErrorIs(t, err, berrors.Unauthorized)
+	var bErr *berrors.BoulderError
+	test.AssertErrorWraps(t, err, &bErr)
+	test.AssertEquals(t, bErr.Detail, "authorizations for these names not found or expired: e.com")
+	test.AssertEquals(t, len(bErr.SubErrors), 0)
+
+	// Missing authorizations and failed CAA rechecks are all reported at once.
+	err = ra.checkAuthorizationsCAA(ctx, []string{"a.com", "b.com", "e.com", "f.com"}, authzs, 1, fc.Now())
+	test.AssertErrorIs(t, err, berrors.Unauthorized)
+	test.AssertErrorWraps(t, err, &bErr)
+	test.AssertEquals(t, bErr.Detail, "authorizations for these names not found or expired: e.com, f.com")
+	subErrTypes := make(map[string]berrors.ErrorType)
+	for _, subErr := range bErr.SubErrors {
+		subErrTypes[subErr.Identifier.Value] = subErr.Type
+	}
+	test.AssertDeepEquals(t, subErrTypes, map[string]berrors.ErrorType{
+		"a.com": berrors.CAA,
+		"e.com": berrors.Unauthorized,
+		"f.com": berrors.Unauthorized,
+	})
+}
+
 func TestNewOrder(t *testing.T) {
 	_, _, ra, fc, cleanUp := initAuthorities(t)
 	defer cleanUp()
@@ -2129,6 +2285,175 @@ func TestNewOrder(t *testing.T) {
 	test.AssertEquals(t, err.Error(), "Cannot issue for \"a\": Domain name needs at least one dot")
 }
 
+func TestCheckCertificateProfile(t *testing.T) {
+	ra := &RegistrationAuthorityImpl{}
+	err := ra.SetCertificateProfiles(map[string][]int64{
+		"short-lived": nil,
+		"client-auth": {1, 2},
+	})
+	test.AssertNotError(t, err, "SetCertificateProfiles failed")
+
+	// Names which wouldn't fit in the orders table are refused.
+	err = ra.SetCertificateProfiles(map[string][]int64{
+		strings.Repeat("a", maxCertificateProfileNameLength+1): nil,
+	})
+	test.AssertError(t, err, "SetCertificateProfiles accepted a name which is too long")
+
+	// Profiles are refused unless the SA stores them.
+	err = ra.checkCertificateProfile("short-lived", 3)
+	test.AssertError(t, err, "profile was accepted without StoreCertificateProfileName")
+	test.AssertErrorIs(t, err, berrors.Malformed)
+
+	_ = features.Set(map[string]bool{"StoreCertificateProfileName": true})
+	defer features.Reset()
+
+	err = ra.checkCertificateProfile("short-lived", 3)
+	test.AssertNotError(t, err, "profile open to every account was rejected")
+
+	err = ra.checkCertificateProfile("client-auth", 2)
+	test.AssertNotError(t, err, "allowed account was rejected")
+
+	err = ra.checkCertificateProfile("client-auth", 3)
+	test.AssertError(t, err, "account not in the allow list wasn't rejected")
+	test.AssertErrorIs(t, err, berrors.Unauthorized)
+	test.AssertEquals(t, err.Error(), "account is not authorized to request certificate profile \"client-auth\"")
+
+	err = ra.checkCertificateProfile("unknown", 1)
+	test.AssertError(t, err, "unknown profile wasn't rejected")
+	test.AssertErrorIs(t, err, berrors.Malformed)
+	test.AssertEquals(t, err.Error(), "certificate profile \"unknown\" is not supported")
+}
+
+func TestCheckOrderValidity(t *testing.T) {
+	fc := clock.NewFake()
+	fc.Set(time.Date(2021, 4, 1, 12, 0, 0, 0, time.UTC))
+	ra := &RegistrationAuthorityImpl{clk: fc}
+	now := fc.Now()
+
+	err := ra.checkOrderValidity(0, 0)
+	test.AssertNotError(t, err, "order without a requested validity period was rejected")
+
+	err = ra.checkOrderValidity(0, now.Add(time.Hour).UnixNano())
+	test.AssertError(t, err, "requested validity period accepted without configured bounds")
+	test.AssertEquals(t, err.Error(), "NotBefore and NotAfter are not supported")
+
+	ra.SetOrderValidityBounds(OrderValidityBounds{
+		MinPeriod:         time.Hour,
+		MaxPeriod:         24 * time.Hour,
+		MaxNotBeforeDelay: 48 * time.Hour,
+	})
+
+	err = ra.checkOrderValidity(0, now.Add(time.Hour).UnixNano())
+	test.AssertError(t, err, "requested validity period accepted without StoreOrderValidity")
+	test.AssertEquals(t, err.Error(), "NotBefore and NotAfter are not supported")
+
+	_ = features.Set(map[string]bool{"StoreOrderValidity": true})
+	defer features.Reset()
+
+	testCases := []struct {
+		name        string
+		notBefore   time.Time
+		notAfter    time.Time
+		expectedErr string
+	}{
+		{
+			name:     "notAfter only",
+			notAfter: now.Add(3 * time.Hour),
+		},
+		{
+			name:      "notBefore only",
+			notBefore: now.Add(24 * time.Hour),
+		},
+		{
+			name:      "notBefore and notAfter",
+			notBefore: now.Add(24 * time.Hour),
+			notAfter:  now.Add(48 * time.Hour),
+		},
+		{
+			name:        "notBefore in the past",
+			notBefore:   now.Add(-time.Minute),
+			expectedErr: "notBefore must not be in the past",
+		},
+		{
+			name:        "notBefore too far in the future",
+			notBefore:   now.Add(49 * time.Hour),
+			expectedErr: "notBefore must not be more than 48h0m0s in the future",
+		},
+		{
+			name:        "notAfter before notBefore",
+			notBefore:   now.Add(2 * time.Hour),
+			notAfter:    now.Add(time.Hour),
+			expectedErr: "notAfter must be later than notBefore",
+		},
+		{
+			name:        "validity period too short",
+			notAfter:    now.Add(30 * time.Minute),
+			expectedErr: "requested validity period 30m0s is shorter than the minimum of 1h0m0s",
+		},
+		{
+			name:        "validity period too long",
+			notBefore:   now.Add(time.Hour),
+			notAfter:    now.Add(26 * time.Hour),
+			expectedErr: "requested validity period 25h0m0s is longer than the maximum of 24h0m0s",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var notBefore, notAfter int64
+			if !tc.notBefore.IsZero() {
+				notBefore = tc.notBefore.UnixNano()
+			}
+			if !tc.notAfter.IsZero() {
+				notAfter = tc.notAfter.UnixNano()
+			}
+			err := ra.checkOrderValidity(notBefore, notAfter)
+			if tc.expectedErr == "" {
+				test.AssertNotError(t, err, "checkOrderValidity failed")
+				return
+			}
+			test.AssertError(t, err, "checkOrderValidity didn't fail")
+			test.AssertErrorIs(t, err, berrors.Malformed)
+			test.AssertEquals(t, err.Error(), tc.expectedErr)
+		})
+	}
+}
+
+func TestNewOrderCertificateProfile(t *testing.T) {
+	_, _, ra, _, cleanUp := initAuthorities(t)
+	defer cleanUp()
+	ra.orderLifetime = time.Hour
+	err := ra.SetCertificateProfiles(map[string][]int64{
+		"client-auth": {Registration.ID},
+		"restricted":  {Registration.ID + 1},
+	})
+	test.AssertNotError(t, err, "SetCertificateProfiles failed")
+	_ = features.Set(map[string]bool{"StoreCertificateProfileName": true})
+	defer features.Reset()
+
+	_, err = ra.NewOrder(context.Background(), &rapb.NewOrderRequest{
+		RegistrationID:         Registration.ID,
+		Names:                  []string{"profile.com"},
+		CertificateProfileName: "restricted",
+	})
+	test.AssertError(t, err, "NewOrder with a disallowed profile didn't fail")
+	test.AssertErrorIs(t, err, berrors.Unauthorized)
+
+	_, err = ra.NewOrder(context.Background(), &rapb.NewOrderRequest{
+		RegistrationID:         Registration.ID,
+		Names:                  []string{"profile.com"},
+		CertificateProfileName: "unknown",
+	})
+	test.AssertError(t, err, "NewOrder with an unknown profile didn't fail")
+	test.AssertErrorIs(t, err, berrors.Malformed)
+
+	_, err = ra.NewOrder(context.Background(), &rapb.NewOrderRequest{
+		RegistrationID:         Registration.ID,
+		Names:                  []string{"profile.com"},
+		CertificateProfileName: "client-auth",
+	})
+	test.AssertNotError(t, err, "ra.NewOrder failed")
+}
+
 // TestNewOrderLegacyAuthzReuse tests that a legacy acme v1 authorization from
 // the `new-authz` endpoint isn't reused by a V2 order created by the same
 // account.
@@ -3486,7 +3811,7 @@ func TestCTPolicyMeasurements(t *testing.T) {
 
 	_, err := ra.issueCertificate(ctx, core.CertificateRequest{
 		CSR: ExampleCSR,
-	}, accountID(Registration.ID), 0, 0)
+	}, accountID(Registration.ID), 0, 0, issuanceOptions{})
 	test.AssertError(t, err, "ra.issueCertificate didn't fail when CTPolicy.GetSCTs timed out")
 	test.AssertEquals(t, test.CountHistogramSamples(ra.ctpolicyResults.With(prometheus.Labels{"result": "failure"})), 1)
 }
@@ -3517,6 +3842,20 @@ func TestWildcardOverlap(t *testing.T) {
 	if err != nil {
 		t.Errorf("Got error %q, expected none", err)
 	}
+
+	// Every redundant name is reported, each with its own suberror.
+	err = wildcardOverlap([]string{
+		"*.example.com",
+		"*.example.net",
+		"www.example.com",
+		"www.example.net",
+	})
+	test.AssertErrorIs(t, err, berrors.Malformed)
+	var bErr *berrors.BoulderError
+	test.AssertErrorWraps(t, err, &bErr)
+	test.AssertEquals(t, len(bErr.SubErrors), 2)
+	test.AssertEquals(t, bErr.SubErrors[0].Identifier.Value, "www.example.com")
+	test.AssertEquals(t, bErr.SubErrors[1].Identifier.Value, "www.example.net")
 }
 
 // mockCAFailPrecert is a mock CA that always returns an error from `IssuePrecertificate`
@@ -3702,7 +4041,7 @@ func TestIssueCertificateInnerErrs(t *testing.T) {
 			// Mock the CA
 			ra.CA = tc.Mock
 			// Attempt issuance
-			_, err = ra.issueCertificateInner(ctx, req, accountID(Registration.ID), orderID(order.Id), issuance.IssuerNameID(0), logEvent)
+			_, err = ra.issueCertificateInner(ctx, req, accountID(Registration.ID), orderID(order.Id), issuance.IssuerNameID(0), issuanceOptions{}, logEvent)
 			// We expect all of the testcases to fail because all use mocked CAs that deliberately error
 			test.AssertError(t, err, "issueCertificateInner with failing mock CA did not fail")
 			// If there is an expected `error` then match the error message
@@ -3771,6 +4110,62 @@ func TestDisableNewV1Validations(t *testing.T) {
 	test.AssertEquals(t, err.Error(), "Validations for new domains are disabled in the V1 API (https://community.letsencrypt.org/t/end-of-life-plan-for-acmev1/88430)")
 }
 
+type mockSAPendingAuthzCount struct {
+	mockSAPreviousValidations
+	pendingCount int64
+}
+
+func (ms *mockSAPendingAuthzCount) CountPendingAuthorizations2(_ context.Context, _ *sapb.RegistrationID) (*sapb.Count, error) {
+	return &sapb.Count{Count: ms.pendingCount}, nil
+}
+
+func TestNewPreAuthorization(t *testing.T) {
+	_, _, ra, _, cleanUp := initAuthorities(t)
+	defer cleanUp()
+	ms := mockSAPendingAuthzCount{}
+	ra.SA = &ms
+
+	// Pre-authorization is an ACMEv2 feature, so it isn't subject to the V1
+	// restriction on validating new domains.
+	_ = features.Set(map[string]bool{"V1DisableNewValidations": true})
+	defer features.Reset()
+
+	authz, err := ra.NewPreAuthorization(context.Background(), &rapb.NewPreAuthorizationRequest{
+		RegistrationID:  1,
+		IdentifierType:  string(identifier.DNS),
+		IdentifierValue: "Bloop-Not-Example.com",
+	})
+	test.AssertNotError(t, err, "ra.NewPreAuthorization failed")
+	test.AssertEquals(t, authz.Id, "1")
+	test.AssertEquals(t, authz.Identifier, "bloop-not-example.com")
+	test.AssertEquals(t, authz.RegistrationID, int64(1))
+	test.AssertEquals(t, authz.Status, string(core.StatusPending))
+
+	_, err = ra.NewPreAuthorization(context.Background(), &rapb.NewPreAuthorizationRequest{
+		RegistrationID:  1,
+		IdentifierType:  string(identifier.DNS),
+		IdentifierValue: "*.bloop-not-example.com",
+	})
+	test.AssertError(t, err, "ra.NewPreAuthorization allowed a wildcard identifier")
+	test.AssertErrorIs(t, err, berrors.Malformed)
+
+	// The pending authorizations rate limit applies as it does for new orders.
+	ra.rlPolicies = &dummyRateLimitConfig{
+		PendingAuthorizationsPerAccountPolicy: ratelimit.RateLimitPolicy{
+			Threshold: 1,
+			Window:    cmd.ConfigDuration{Duration: 24 * time.Hour},
+		},
+	}
+	ms.pendingCount = 1
+	_, err = ra.NewPreAuthorization(context.Background(), &rapb.NewPreAuthorizationRequest{
+		RegistrationID:  1,
+		IdentifierType:  string(identifier.DNS),
+		IdentifierValue: "bloop-not-example.com",
+	})
+	test.AssertError(t, err, "ra.NewPreAuthorization didn't enforce the pending authorizations limit")
+	test.AssertErrorIs(t, err, berrors.RateLimit)
+}
+
 func TestNewOrderMaxNames(t *testing.T) {
 	_, _, ra, _, cleanUp := initAuthorities(t)
 	defer cleanUp()
@@ -3917,3 +4312,60 @@ func TestRevocationAddBlockedKey(t *testing.T) {
 	test.AssertEquals(t, test.CountCounterVec(
 		"reason", "keyCompromise", ra.revocationReasonCounter), 2)
 }
+
+type mockSARevoke struct {
+	mocks.StorageAuthority
+
+	revoked *sapb.RevokeCertificateRequest
+}
+
+func (msar *mockSARevoke) RevokeCertificate(_ context.Context, req *sapb.RevokeCertificateRequest) error {
+	msar.revoked = req
+	return nil
+}
+
+type mockCAOCSPBroken struct {
+	mocks.MockCA
+}
+
+func (mcaob *mockCAOCSPBroken) GenerateOCSP(context.Context, *capb.GenerateOCSPRequest, ...grpc.CallOption) (*capb.OCSPResponse, error) {
+	return nil, fmt.Errorf("CA unavailable")
+}
+
+func TestRevocationWithoutCA(t *testing.T) {
+	_, _, ra, _, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	mockSA := mockSARevoke{}
+	ra.SA = &mockSA
+	ra.CA = &mockCAOCSPBroken{}
+	ra.purger = &mockPurger{}
+
+	k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "ecdsa.GenerateKey failed")
+	template := x509.Certificate{PublicKey: k, SerialNumber: big.NewInt(258)}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, k.Public(), k)
+	test.AssertNotError(t, err, "x509.CreateCertificate failed")
+	cert, err := x509.ParseCertificate(der)
+	test.AssertNotError(t, err, "x509.ParseCertificate failed")
+	ic := issuance.Certificate{Certificate: cert}
+	ra.issuers = map[issuance.IssuerNameID]*issuance.Certificate{
+		ic.NameID(): &ic,
+	}
+
+	// Without the revocation event queue, a CA failure fails the revocation.
+	err = ra.RevokeCertificateWithReg(context.Background(), *cert, ocsp.Unspecified, 0)
+	test.AssertError(t, err, "RevokeCertificateWithReg should have failed without the CA")
+	test.Assert(t, mockSA.revoked == nil, "certificate was revoked without an OCSP response")
+
+	err = features.Set(map[string]bool{"RevocationEventQueue": true})
+	test.AssertNotError(t, err, "failed to set features")
+	defer features.Reset()
+
+	// With it, the certificate is revoked and the ocsp-updater is left to
+	// sign the response.
+	err = ra.RevokeCertificateWithReg(context.Background(), *cert, ocsp.Unspecified, 0)
+	test.AssertNotError(t, err, "RevokeCertificateWithReg failed")
+	test.Assert(t, mockSA.revoked != nil, "certificate was not revoked")
+	test.AssertEquals(t, len(mockSA.revoked.Response), 0)
+}
@@ -0,0 +1,20 @@
+
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `externalAccountKeys` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `keyID` varchar(255) NOT NULL,
+  `hmacKey` blob NOT NULL,
+  `createdAt` datetime NOT NULL,
+  `revokedAt` datetime DEFAULT NULL,
+  `registrationID` bigint(20) DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `keyID` (`keyID`),
+  KEY `registrationID_idx` (`registrationID`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `externalAccountKeys`;
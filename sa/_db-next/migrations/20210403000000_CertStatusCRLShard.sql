@@ -0,0 +1,11 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+ALTER TABLE `certificateStatus` ADD COLUMN `crlShard` int DEFAULT NULL,
+                                ADD KEY `issuerID_crlShard_notAfter_idx` (`issuerID`, `crlShard`, `notAfter`);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+ALTER TABLE `certificateStatus` DROP KEY `issuerID_crlShard_notAfter_idx`,
+                                DROP COLUMN `crlShard`;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `revocationEvents` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `serial` varchar(255) NOT NULL,
+  `created` datetime NOT NULL,
+  `attempts` int(11) NOT NULL DEFAULT 0,
+  `nextAttempt` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `nextAttempt_idx` (`nextAttempt`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `revocationEvents`;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `certificateSCTs` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `serial` varchar(255) NOT NULL,
+  `logID` binary(32) NOT NULL,
+  `logURL` varchar(255) NOT NULL,
+  `timestamp` datetime(3) NOT NULL,
+  `precertificate` tinyint(1) NOT NULL,
+  `sct` blob NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `serial_logID_precertificate` (`serial`, `logID`, `precertificate`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `certificateSCTs`;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `finalCertSubmissions` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `serial` varchar(255) NOT NULL,
+  `logURL` varchar(255) NOT NULL,
+  `logPublicKey` varchar(1024) NOT NULL,
+  `attempts` int(11) NOT NULL,
+  `created` datetime NOT NULL,
+  `nextAttempt` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `nextAttempt_idx` (`nextAttempt`),
+  KEY `created_idx` (`created`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `finalCertSubmissions`;
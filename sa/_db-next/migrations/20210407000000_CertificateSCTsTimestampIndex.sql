@@ -0,0 +1,9 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+ALTER TABLE certificateSCTs ADD INDEX `timestamp_idx` (`timestamp`);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+ALTER TABLE certificateSCTs DROP INDEX `timestamp_idx`;
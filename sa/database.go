@@ -186,7 +186,10 @@ func initTables(dbMap *gorp.DbMap) {
 	dbMap.AddTableWithName(core.Certificate{}, "certificates").SetKeys(false, "Serial")
 	dbMap.AddTableWithName(core.CertificateStatus{}, "certificateStatus").SetKeys(false, "Serial")
 	dbMap.AddTableWithName(core.FQDNSet{}, "fqdnSets").SetKeys(true, "ID")
-	dbMap.AddTableWithName(orderModel{}, "orders").SetKeys(true, "ID")
+	orderTable := dbMap.AddTableWithName(orderModel{}, "orders").SetKeys(true, "ID")
+	orderTable.ColMap("CertificateProfileName").SetTransient(true)
+	orderTable.ColMap("NotBefore").SetTransient(true)
+	orderTable.ColMap("NotAfter").SetTransient(true)
 	dbMap.AddTableWithName(orderToAuthzModel{}, "orderToAuthz").SetKeys(false, "OrderID", "AuthzID")
 	dbMap.AddTableWithName(requestedNameModel{}, "requestedNames").SetKeys(false, "OrderID")
 	dbMap.AddTableWithName(orderFQDNSet{}, "orderFqdnSets").SetKeys(true, "ID")
@@ -195,4 +198,7 @@ func initTables(dbMap *gorp.DbMap) {
 	dbMap.AddTableWithName(recordedSerialModel{}, "serials").SetKeys(true, "ID")
 	dbMap.AddTableWithName(precertificateModel{}, "precertificates").SetKeys(true, "ID")
 	dbMap.AddTableWithName(keyHashModel{}, "keyHashToSerial").SetKeys(true, "ID")
+	dbMap.AddTableWithName(externalAccountKeyModel{}, "externalAccountKeys").SetKeys(true, "ID")
+	dbMap.AddTableWithName(sctModel{}, "certificateSCTs").SetKeys(true, "ID")
+	dbMap.AddTableWithName(finalCertSubmissionModel{}, "finalCertSubmissions").SetKeys(true, "ID")
 }
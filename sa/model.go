@@ -17,7 +17,10 @@ import (
 	corepb "github.com/letsencrypt/boulder/core/proto"
 	"github.com/letsencrypt/boulder/db"
 	"github.com/letsencrypt/boulder/grpc"
+	"github.com/letsencrypt/boulder/identifier"
 	"github.com/letsencrypt/boulder/probs"
+	"github.com/letsencrypt/boulder/revocation"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
 )
 
 // errBadJSON is an error type returned when a json.Unmarshal performed by the
@@ -298,6 +301,10 @@ type precertificateModel struct {
 	Expires        time.Time
 }
 
+// orderFields are the columns of the orders table which exist in every
+// schema version.
+const orderFields = "id, registrationID, expires, created, error, certificateSerial, beganProcessing"
+
 type orderModel struct {
 	ID                int64
 	RegistrationID    int64
@@ -306,6 +313,14 @@ type orderModel struct {
 	Error             []byte
 	CertificateSerial string
 	BeganProcessing   bool
+	// CertificateProfileName is only read and written when the
+	// StoreCertificateProfileName feature is enabled. It is transient to gorp,
+	// which would otherwise use the column in schemas that lack it.
+	CertificateProfileName string `db:"certificateProfileName"`
+	// NotBefore and NotAfter are likewise only read and written when the
+	// StoreOrderValidity feature is enabled.
+	NotBefore *time.Time `db:"notBefore"`
+	NotAfter  *time.Time `db:"notAfter"`
 }
 
 type requestedNameModel struct {
@@ -344,12 +359,19 @@ func orderToModel(order *corepb.Order) (*orderModel, error) {
 
 func modelToOrder(om *orderModel) (*corepb.Order, error) {
 	order := &corepb.Order{
-		Id:                om.ID,
-		RegistrationID:    om.RegistrationID,
-		Expires:           om.Expires.UnixNano(),
-		Created:           om.Created.UnixNano(),
-		CertificateSerial: om.CertificateSerial,
-		BeganProcessing:   om.BeganProcessing,
+		Id:                     om.ID,
+		RegistrationID:         om.RegistrationID,
+		Expires:                om.Expires.UnixNano(),
+		Created:                om.Created.UnixNano(),
+		CertificateSerial:      om.CertificateSerial,
+		BeganProcessing:        om.BeganProcessing,
+		CertificateProfileName: om.CertificateProfileName,
+	}
+	if om.NotBefore != nil {
+		order.NotBefore = om.NotBefore.UnixNano()
+	}
+	if om.NotAfter != nil {
+		order.NotAfter = om.NotAfter.UnixNano()
 	}
 	if len(om.Error) > 0 {
 		var problem corepb.ProblemDetails
@@ -379,10 +401,12 @@ var uintToChallType = map[uint8]string{
 
 var identifierTypeToUint = map[string]uint8{
 	"dns": 0,
+	"ip":  1,
 }
 
 var uintToIdentifierType = map[uint8]string{
 	0: "dns",
+	1: "ip",
 }
 
 var statusToUint = map[string]uint8{
@@ -442,6 +466,7 @@ func hasMultipleNonPendingChallenges(challenges []*corepb.Challenge) bool {
 // authzModel storage representation.
 func authzPBToModel(authz *corepb.Authorization) (*authzModel, error) {
 	am := &authzModel{
+		IdentifierType:  identifierTypeToUint[string(identifier.FromString(authz.Identifier).Type)],
 		IdentifierValue: authz.Identifier,
 		RegistrationID:  authz.RegistrationID,
 		Status:          statusToUint[authz.Status],
@@ -629,3 +654,91 @@ var stringToSourceInt = map[string]int{
 	"API":           1,
 	"admin-revoker": 2,
 }
+
+// externalAccountKeyModel is the description of an external account binding
+// key (RFC 8555 Section 7.3.4) in the database.
+type externalAccountKeyModel struct {
+	ID        int64      `db:"id"`
+	KeyID     string     `db:"keyID"`
+	HMACKey   []byte     `db:"hmacKey"`
+	CreatedAt time.Time  `db:"createdAt"`
+	RevokedAt *time.Time `db:"revokedAt"`
+	// RegistrationID is the registration the key has been bound to, or nil if
+	// the key has not yet been used.
+	RegistrationID *int64 `db:"registrationID"`
+}
+
+func externalAccountKeyModelToPB(m externalAccountKeyModel) *sapb.ExternalAccountKey {
+	pb := &sapb.ExternalAccountKey{
+		KeyID:     m.KeyID,
+		HmacKey:   m.HMACKey,
+		CreatedAt: m.CreatedAt.UnixNano(),
+	}
+	if m.RevokedAt != nil {
+		pb.RevokedAt = m.RevokedAt.UnixNano()
+	}
+	if m.RegistrationID != nil {
+		pb.RegistrationID = *m.RegistrationID
+	}
+	return pb
+}
+
+// revokedCertModel holds the subset of a certificateStatus row which is needed
+// to list a revoked certificate in a CRL.
+type revokedCertModel struct {
+	Serial        string            `db:"serial"`
+	RevokedReason revocation.Reason `db:"revokedReason"`
+	RevokedDate   time.Time         `db:"revokedDate"`
+	NotAfter      time.Time         `db:"notAfter"`
+}
+
+// sctModel is an SCT, received by the publisher from a CT log, for a
+// certificate or precertificate. Only SCTs which the publisher has verified
+// are stored.
+type sctModel struct {
+	ID             int64     `db:"id"`
+	Serial         string    `db:"serial"`
+	LogID          []byte    `db:"logID"`
+	LogURL         string    `db:"logURL"`
+	Timestamp      time.Time `db:"timestamp"`
+	Precertificate bool      `db:"precertificate"`
+	SCT            []byte    `db:"sct"`
+}
+
+func sctModelToPB(m sctModel) *sapb.SCT {
+	return &sapb.SCT{
+		Serial:         m.Serial,
+		LogID:          m.LogID,
+		LogURL:         m.LogURL,
+		Timestamp:      m.Timestamp.UnixNano(),
+		Precertificate: m.Precertificate,
+		Sct:            m.SCT,
+		Id:             m.ID,
+	}
+}
+
+// finalCertSubmissionModel is a queued submission of a final certificate to a
+// CT log, which the publisher retries until it succeeds.
+type finalCertSubmissionModel struct {
+	ID           int64     `db:"id"`
+	Serial       string    `db:"serial"`
+	LogURL       string    `db:"logURL"`
+	LogPublicKey string    `db:"logPublicKey"`
+	Attempts     int64     `db:"attempts"`
+	Created      time.Time `db:"created"`
+	NextAttempt  time.Time `db:"nextAttempt"`
+}
+
+func finalCertSubmissionModelToPB(m finalCertSubmissionModel) *sapb.FinalCertSubmission {
+	return &sapb.FinalCertSubmission{
+		Id:     m.ID,
+		Serial: m.Serial,
+		Log: &sapb.CTLog{
+			Url:       m.LogURL,
+			PublicKey: m.LogPublicKey,
+		},
+		Attempts:    m.Attempts,
+		Created:     m.Created.UnixNano(),
+		NextAttempt: m.NextAttempt.UnixNano(),
+	}
+}
@@ -12,6 +12,7 @@ import (
 	corepb "github.com/letsencrypt/boulder/core/proto"
 	"github.com/letsencrypt/boulder/db"
 	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/features"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 )
@@ -80,6 +81,11 @@ func (ssa *SQLStorageAuthority) AddPrecertificate(ctx context.Context, req *sapb
 			"isExpired":             false,
 			"issuerID":              req.IssuerID,
 		}
+		if features.Enabled(features.StoreCRLShard) && req.CrlShard != nil {
+			certStatusFields = append(certStatusFields, "crlShard")
+			fieldNames = append(fieldNames, ":crlShard")
+			args["crlShard"] = req.CrlShard.Idx
+		}
 		if len(args) > len(certStatusFields) {
 			return nil, fmt.Errorf("too many arguments inserting row into certificateStatus")
 		}
@@ -94,14 +100,14 @@ func (ssa *SQLStorageAuthority) AddPrecertificate(ctx context.Context, req *sapb
 		}
 
 		// NOTE(@cpu): When we collect up names to check if an FQDN set exists (e.g.
-		// that it is a renewal) we use just the DNSNames from the certificate and
+		// that it is a renewal) we use just the SANs from the certificate and
 		// ignore the Subject Common Name (if any). This is a safe assumption because
 		// if a certificate we issued were to have a Subj. CN not present as a SAN it
 		// would be a misissuance and miscalculating whether the cert is a renewal or
 		// not for the purpose of rate limiting is the least of our troubles.
 		isRenewal, err := ssa.checkFQDNSetExists(
 			txWithCtx.SelectOne,
-			parsed.DNSNames)
+			core.SANNames(parsed.DNSNames, parsed.IPAddresses))
 		if err != nil {
 			return nil, err
 		}
@@ -61,18 +61,19 @@ func (ssa *SQLStorageAuthority) addCertificatesPerName(
 
 // countCertificates returns, for a single domain, the count of
 // certificates issued in the given time range for that domain's eTLD+1 (aka
-// base domain). It uses the certificatesPerName table to make this lookup fast.
+// base domain), along with the time of the oldest counted issuance. It uses
+// the certificatesPerName table to make this lookup fast.
 func (ssa *SQLStorageAuthority) countCertificates(
 	dbMap db.Selector,
 	domain string,
 	earliest,
 	latest time.Time,
-) (int, error) {
+) (int, time.Time, error) {
 	base := baseDomain(domain)
-	var counts []int
+	var buckets []rateLimitBucket
 	_, err := dbMap.Select(
-		&counts,
-		`SELECT count FROM certificatesPerName
+		&buckets,
+		`SELECT time, count FROM certificatesPerName
 		 WHERE eTLDPlusOne = :baseDomain AND
 		 time > :earliest AND
 		 time <= :latest`,
@@ -83,15 +84,37 @@ func (ssa *SQLStorageAuthority) countCertificates(
 		})
 	if err != nil {
 		if db.IsNoRows(err) {
-			return 0, nil
+			return 0, time.Time{}, nil
 		}
-		return 0, err
+		return 0, time.Time{}, err
 	}
+	total, oldest := sumBuckets(buckets)
+	return total, oldest, nil
+}
+
+// rateLimitBucket is a single row of one of the time-bucketed rate limit
+// tables (certificatesPerName, newOrdersRL).
+type rateLimitBucket struct {
+	Time  time.Time `db:"time"`
+	Count int       `db:"count"`
+}
+
+// sumBuckets returns the total count across the provided buckets and the time
+// of the oldest bucket with a non-zero count. The returned time is the zero
+// value if there were no counted events.
+func sumBuckets(buckets []rateLimitBucket) (int, time.Time) {
 	var total int
-	for _, count := range counts {
-		total += count
+	var oldest time.Time
+	for _, b := range buckets {
+		if b.Count <= 0 {
+			continue
+		}
+		total += b.Count
+		if oldest.IsZero() || b.Time.Before(oldest) {
+			oldest = b.Time
+		}
 	}
-	return total, nil
+	return total, oldest
 }
 
 // addNewOrdersRateLimit adds 1 to the rate limit count for the provided ID,
@@ -112,12 +135,13 @@ func addNewOrdersRateLimit(ctx context.Context, dbMap db.SelectExecer, regID int
 }
 
 // countNewOrders returns the count of orders created in the given time range
-// for the given registration ID
-func countNewOrders(ctx context.Context, dbMap db.Selector, regID int64, earliest, latest time.Time) (int, error) {
-	var counts []int
+// for the given registration ID, along with the time of the oldest counted
+// order.
+func countNewOrders(ctx context.Context, dbMap db.Selector, regID int64, earliest, latest time.Time) (int, time.Time, error) {
+	var buckets []rateLimitBucket
 	_, err := dbMap.Select(
-		&counts,
-		`SELECT count FROM newOrdersRL
+		&buckets,
+		`SELECT time, count FROM newOrdersRL
 		WHERE regID = :regID AND
 		time > :earliest AND
 		time <= :latest`,
@@ -129,13 +153,10 @@ func countNewOrders(ctx context.Context, dbMap db.Selector, regID int64, earlies
 	)
 	if err != nil {
 		if db.IsNoRows(err) {
-			return 0, nil
+			return 0, time.Time{}, nil
 		}
-		return 0, err
-	}
-	var total int
-	for _, count := range counts {
-		total += count
+		return 0, time.Time{}, err
 	}
-	return total, nil
+	total, oldest := sumBuckets(buckets)
+	return total, oldest, nil
 }
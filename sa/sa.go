@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 	"net"
 	"strings"
@@ -29,7 +30,7 @@ import (
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 )
 
-type certCountFunc func(db db.Selector, domain string, earliest, latest time.Time) (int, error)
+type certCountFunc func(db db.Selector, domain string, earliest, latest time.Time) (int, time.Time, error)
 
 // SQLStorageAuthority defines a Storage Authority
 type SQLStorageAuthority struct {
@@ -107,7 +108,7 @@ func (ssa *SQLStorageAuthority) GetRegistration(ctx context.Context, id int64) (
 		return core.Registration{}, err
 	}
 
-	return modelToRegistration(model)
+	return ssa.modelToRegistrationWithBinding(ctx, model)
 }
 
 // GetRegistrationByKey obtains a Registration by JWK
@@ -128,7 +129,32 @@ func (ssa *SQLStorageAuthority) GetRegistrationByKey(ctx context.Context, key *j
 		return core.Registration{}, err
 	}
 
-	return modelToRegistration(model)
+	return ssa.modelToRegistrationWithBinding(ctx, model)
+}
+
+// modelToRegistrationWithBinding converts a registrations row to a
+// Registration, including the key ID of the external account binding it was
+// created with, if any, which is recorded on the key's externalAccountKeys
+// row.
+func (ssa *SQLStorageAuthority) modelToRegistrationWithBinding(ctx context.Context, model *regModel) (core.Registration, error) {
+	reg, err := modelToRegistration(model)
+	if err != nil {
+		return core.Registration{}, err
+	}
+	if !features.Enabled(features.ExternalAccountBindings) {
+		return reg, nil
+	}
+	var keyID string
+	err = ssa.dbMap.WithContext(ctx).SelectOne(
+		&keyID,
+		"SELECT keyID FROM externalAccountKeys WHERE registrationID = ?",
+		reg.ID,
+	)
+	if err != nil && !db.IsNoRows(err) {
+		return core.Registration{}, err
+	}
+	reg.ExternalAccountID = keyID
+	return reg, nil
 }
 
 // incrementIP returns a copy of `ip` incremented at a bit index `index`,
@@ -237,6 +263,7 @@ func (ssa *SQLStorageAuthority) CountCertificatesByNames(ctx context.Context, do
 	type result struct {
 		err    error
 		count  int
+		oldest time.Time
 		domain string
 	}
 	results := make(chan result, len(domains))
@@ -261,7 +288,7 @@ func (ssa *SQLStorageAuthority) CountCertificatesByNames(ctx context.Context, do
 					return
 				default:
 				}
-				currentCount, err := ssa.countCertificatesByName(
+				currentCount, oldest, err := ssa.countCertificatesByName(
 					ssa.dbMap.WithContext(ctx), domain, earliest, latest)
 				if err != nil {
 					results <- result{err: err}
@@ -271,6 +298,7 @@ func (ssa *SQLStorageAuthority) CountCertificatesByNames(ctx context.Context, do
 				}
 				results <- result{
 					count:  currentCount,
+					oldest: oldest,
 					domain: domain,
 				}
 			}
@@ -285,9 +313,14 @@ func (ssa *SQLStorageAuthority) CountCertificatesByNames(ctx context.Context, do
 		}
 		name := string(r.domain)
 		pbCount := int64(r.count)
+		var pbOldest int64
+		if !r.oldest.IsZero() {
+			pbOldest = r.oldest.UnixNano()
+		}
 		ret = append(ret, &sapb.CountByNames_MapElement{
-			Name:  name,
-			Count: pbCount,
+			Name:   name,
+			Count:  pbCount,
+			Oldest: pbOldest,
 		})
 	}
 	return ret, nil
@@ -336,6 +369,40 @@ func (ssa *SQLStorageAuthority) GetCertificateStatus(ctx context.Context, serial
 	return certStatus, nil
 }
 
+// GetCertificateAndStatus takes a serial number and returns the corresponding
+// certificate along with its certificateStatus row, or error if either does
+// not exist.
+func (ssa *SQLStorageAuthority) GetCertificateAndStatus(ctx context.Context, req *sapb.Serial) (*sapb.CertificateAndStatus, error) {
+	if req == nil || req.Serial == "" {
+		return nil, errIncompleteRequest
+	}
+	if !core.ValidSerial(req.Serial) {
+		return nil, fmt.Errorf("Invalid certificate serial %s", req.Serial)
+	}
+
+	dbMap := ssa.dbMap.WithContext(ctx)
+	cert, err := SelectCertificate(dbMap, req.Serial)
+	if db.IsNoRows(err) {
+		return nil, berrors.NotFoundError("certificate with serial %q not found", req.Serial)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	certStatus, err := SelectCertificateStatus(dbMap, req.Serial)
+	if db.IsNoRows(err) {
+		return nil, berrors.NotFoundError("status for certificate with serial %q not found", req.Serial)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &sapb.CertificateAndStatus{
+		Certificate: bgrpc.CertToPB(cert),
+		Status:      bgrpc.CertStatusToPB(certStatus),
+	}, nil
+}
+
 // NewRegistration stores a new Registration
 func (ssa *SQLStorageAuthority) NewRegistration(ctx context.Context, reg core.Registration) (core.Registration, error) {
 	reg.CreatedAt = ssa.clk.Now()
@@ -343,16 +410,53 @@ func (ssa *SQLStorageAuthority) NewRegistration(ctx context.Context, reg core.Re
 	if err != nil {
 		return reg, err
 	}
-	err = ssa.dbMap.WithContext(ctx).Insert(rm)
-	if err != nil {
-		if db.IsDuplicate(err) {
-			// duplicate entry error can only happen when jwk_sha256 collides, indicate
-			// to caller that the provided key is already in use
-			return reg, berrors.DuplicateError("key is already in use for a different account")
+	_, err = db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
+		err := txWithCtx.Insert(rm)
+		if err != nil {
+			if db.IsDuplicate(err) {
+				// duplicate entry error can only happen when jwk_sha256 collides, indicate
+				// to caller that the provided key is already in use
+				return nil, berrors.DuplicateError("key is already in use for a different account")
+			}
+			return nil, err
 		}
+		if reg.ExternalAccountID != "" {
+			// Bind the external account key to the new registration. Each key may
+			// only be used once, so the update is conditional on the key not
+			// having been bound or revoked in the meantime. The binding is only
+			// recorded here rather than also on the registrations row: the key
+			// row is the single source of truth and it spares the much larger
+			// registrations table a schema change.
+			result, err := txWithCtx.Exec(
+				`UPDATE externalAccountKeys SET registrationID = ?
+				WHERE keyID = ? AND registrationID IS NULL AND revokedAt IS NULL`,
+				rm.ID,
+				reg.ExternalAccountID,
+			)
+			if err != nil {
+				return nil, err
+			}
+			rows, err := result.RowsAffected()
+			if err != nil {
+				return nil, err
+			}
+			if rows != 1 {
+				return nil, berrors.UnauthorizedError(
+					"external account key %q is unknown, revoked, or already bound to an account",
+					reg.ExternalAccountID)
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return reg, err
+	}
+	created, err := modelToRegistration(rm)
+	if err != nil {
 		return reg, err
 	}
-	return modelToRegistration(rm)
+	created.ExternalAccountID = reg.ExternalAccountID
+	return created, nil
 }
 
 // UpdateRegistration stores an updated Registration
@@ -425,14 +529,14 @@ func (ssa *SQLStorageAuthority) AddCertificate(
 		}
 
 		// NOTE(@cpu): When we collect up names to check if an FQDN set exists (e.g.
-		// that it is a renewal) we use just the DNSNames from the certificate and
+		// that it is a renewal) we use just the SANs from the certificate and
 		// ignore the Subject Common Name (if any). This is a safe assumption because
 		// if a certificate we issued were to have a Subj. CN not present as a SAN it
 		// would be a misissuance and miscalculating whether the cert is a renewal or
 		// not for the purpose of rate limiting is the least of our troubles.
 		isRenewal, err := ssa.checkFQDNSetExists(
 			txWithCtx.SelectOne,
-			parsedCertificate.DNSNames)
+			core.SANNames(parsedCertificate.DNSNames, parsedCertificate.IPAddresses))
 		if err != nil {
 			return nil, err
 		}
@@ -463,7 +567,7 @@ func (ssa *SQLStorageAuthority) AddCertificate(
 		// don't count against the certificatesPerName limit.
 		if !isRenewal {
 			timeToTheHour := parsedCertificate.NotBefore.Round(time.Hour)
-			if err := ssa.addCertificatesPerName(ctx, txWithCtx, parsedCertificate.DNSNames, timeToTheHour); err != nil {
+			if err := ssa.addCertificatesPerName(ctx, txWithCtx, core.SANNames(parsedCertificate.DNSNames, parsedCertificate.IPAddresses), timeToTheHour); err != nil {
 				return nil, err
 			}
 		}
@@ -472,7 +576,7 @@ func (ssa *SQLStorageAuthority) AddCertificate(
 		// limits are calculated correctly.
 		if err := addFQDNSet(
 			txWithCtx,
-			parsedCertificate.DNSNames,
+			core.SANNames(parsedCertificate.DNSNames, parsedCertificate.IPAddresses),
 			core.SerialToString(parsedCertificate.SerialNumber),
 			parsedCertificate.NotBefore,
 			parsedCertificate.NotAfter,
@@ -492,14 +596,19 @@ func (ssa *SQLStorageAuthority) AddCertificate(
 	return digest, nil
 }
 
-func (ssa *SQLStorageAuthority) CountOrders(ctx context.Context, acctID int64, earliest, latest time.Time) (int, error) {
+// CountOrders returns the number of orders created by the given account in the
+// given time range, along with the creation time of the oldest of them.
+func (ssa *SQLStorageAuthority) CountOrders(ctx context.Context, acctID int64, earliest, latest time.Time) (int, time.Time, error) {
 	if features.Enabled(features.FasterNewOrdersRateLimit) {
 		return countNewOrders(ctx, ssa.dbMap, acctID, earliest, latest)
 	}
 
-	var count int
-	err := ssa.dbMap.WithContext(ctx).SelectOne(&count,
-		`SELECT count(1) FROM orders
+	var result struct {
+		Count  int        `db:"count"`
+		Oldest *time.Time `db:"oldest"`
+	}
+	err := ssa.dbMap.WithContext(ctx).SelectOne(&result,
+		`SELECT count(1) AS count, MIN(created) AS oldest FROM orders
 		WHERE registrationID = :acctID AND
 		created >= :windowLeft AND
 		created < :windowRight`,
@@ -509,9 +618,13 @@ func (ssa *SQLStorageAuthority) CountOrders(ctx context.Context, acctID int64, e
 			"windowRight": latest,
 		})
 	if err != nil {
-		return 0, err
+		return 0, time.Time{}, err
+	}
+	var oldest time.Time
+	if result.Oldest != nil {
+		oldest = *result.Oldest
 	}
-	return count, nil
+	return result.Count, oldest, nil
 }
 
 func hashNames(names []string) []byte {
@@ -576,12 +689,13 @@ func deleteOrderFQDNSet(
 }
 
 func addIssuedNames(db db.Execer, cert *x509.Certificate, isRenewal bool) error {
-	if len(cert.DNSNames) == 0 {
-		return berrors.InternalServerError("certificate has no DNSNames")
+	names := core.SANNames(cert.DNSNames, cert.IPAddresses)
+	if len(names) == 0 {
+		return berrors.InternalServerError("certificate has no DNSNames or IPAddresses")
 	}
 	var qmarks []string
 	var values []interface{}
-	for _, name := range cert.DNSNames {
+	for _, name := range names {
 		values = append(values,
 			ReverseName(name),
 			core.SerialToString(cert.SerialNumber),
@@ -848,7 +962,36 @@ func (ssa *SQLStorageAuthority) NewOrder(ctx context.Context, req *corepb.Order)
 			Created:        ssa.clk.Now(),
 		}
 
-		if err := txWithCtx.Insert(order); err != nil {
+		cols, qs := "registrationID, expires, created, certificateSerial, beganProcessing", "?, ?, ?, ?, ?"
+		vals := []interface{}{
+			order.RegistrationID,
+			order.Expires,
+			order.Created,
+			order.CertificateSerial,
+			order.BeganProcessing,
+		}
+		if features.Enabled(features.StoreCertificateProfileName) && req.CertificateProfileName != "" {
+			order.CertificateProfileName = req.CertificateProfileName
+			cols += ", certificateProfileName"
+			qs += ", ?"
+			vals = append(vals, order.CertificateProfileName)
+		}
+		if features.Enabled(features.StoreOrderValidity) && (req.NotBefore != 0 || req.NotAfter != 0) {
+			order.NotBefore = core.UnixNanoToTime(req.NotBefore)
+			order.NotAfter = core.UnixNanoToTime(req.NotAfter)
+			cols += ", notBefore, notAfter"
+			qs += ", ?, ?"
+			vals = append(vals, order.NotBefore, order.NotAfter)
+		}
+		result, err := txWithCtx.Exec(
+			fmt.Sprintf("INSERT INTO orders (%s) VALUES (%s)", cols, qs),
+			vals...,
+		)
+		if err != nil {
+			return nil, err
+		}
+		order.ID, err = result.LastInsertId()
+		if err != nil {
 			return nil, err
 		}
 
@@ -908,6 +1051,13 @@ func (ssa *SQLStorageAuthority) NewOrder(ctx context.Context, req *corepb.Order)
 		// A new order is never processing because it can't have been finalized yet.
 		BeganProcessing: false,
 	}
+	res.CertificateProfileName = order.CertificateProfileName
+	if order.NotBefore != nil {
+		res.NotBefore = req.NotBefore
+	}
+	if order.NotAfter != nil {
+		res.NotAfter = req.NotAfter
+	}
 
 	// Calculate the order status before returning it. Since it may have reused all
 	// valid authorizations the order may be "born" in a ready status.
@@ -1038,17 +1188,26 @@ func (ssa *SQLStorageAuthority) namesForOrder(ctx context.Context, orderID int64
 
 // GetOrder is used to retrieve an already existing order object
 func (ssa *SQLStorageAuthority) GetOrder(ctx context.Context, req *sapb.OrderRequest) (*corepb.Order, error) {
-	omObj, err := ssa.dbMap.WithContext(ctx).Get(orderModel{}, req.Id)
+	cols := orderFields
+	if features.Enabled(features.StoreCertificateProfileName) {
+		cols += ", certificateProfileName"
+	}
+	if features.Enabled(features.StoreOrderValidity) {
+		cols += ", notBefore, notAfter"
+	}
+	var om orderModel
+	err := ssa.dbMap.WithContext(ctx).SelectOne(
+		&om,
+		fmt.Sprintf("SELECT %s FROM orders WHERE id = ?", cols),
+		req.Id,
+	)
 	if err != nil {
 		if db.IsNoRows(err) {
 			return nil, berrors.NotFoundError("no order found for ID %d", req.Id)
 		}
 		return nil, err
 	}
-	if omObj == nil {
-		return nil, berrors.NotFoundError("no order found for ID %d", req.Id)
-	}
-	order, err := modelToOrder(omObj.(*orderModel))
+	order, err := modelToOrder(&om)
 	if err != nil {
 		return nil, err
 	}
@@ -1312,6 +1471,41 @@ func (ssa *SQLStorageAuthority) GetOrderForNames(
 	return order, nil
 }
 
+// GetOrdersForAccount returns the IDs of an account's unexpired orders, most
+// recent first. At most req.Limit IDs are returned; the remainder can be
+// retrieved by passing the last returned ID as the cursor of a further request.
+func (ssa *SQLStorageAuthority) GetOrdersForAccount(
+	ctx context.Context,
+	req *sapb.GetOrdersForAccountRequest) (*sapb.OrderIDs, error) {
+	if req == nil || req.AcctID == 0 || req.Limit <= 0 || req.Cursor < 0 {
+		return nil, errIncompleteRequest
+	}
+	cursor := req.Cursor
+	if cursor == 0 {
+		cursor = math.MaxInt64
+	}
+
+	var orderIDs []int64
+	_, err := ssa.dbMap.WithContext(ctx).Select(
+		&orderIDs,
+		`SELECT id
+		FROM orders
+		WHERE registrationID = ?
+		AND expires > ?
+		AND id < ?
+		ORDER BY id DESC
+		LIMIT ?`,
+		req.AcctID,
+		ssa.clk.Now(),
+		cursor,
+		req.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &sapb.OrderIDs{Ids: orderIDs}, nil
+}
+
 func AuthzMapToPB(m map[string]*core.Authorization) (*sapb.Authorizations, error) {
 	resp := &sapb.Authorizations{}
 	for k, v := range m {
@@ -1379,7 +1573,7 @@ func authzModelMapToPB(m map[string]authzModel) (*sapb.Authorizations, error) {
 // This method will look in both the v2 and v1 authorizations tables for authorizations but will
 // always prefer v2 authorizations. This method will only return authorizations created using the
 // WFE v2 API (in GetAuthorizations this feature was, now somewhat confusingly, called RequireV2Authzs).
-// This method is intended to deprecate GetAuthorizations. This method only supports DNS identifier types.
+// This method is intended to deprecate GetAuthorizations. This method supports DNS and IP identifier types.
 func (ssa *SQLStorageAuthority) GetAuthorizations2(ctx context.Context, req *sapb.GetAuthorizationsRequest) (*sapb.Authorizations, error) {
 	var authzModels []authzModel
 	params := []interface{}{
@@ -1388,6 +1582,7 @@ func (ssa *SQLStorageAuthority) GetAuthorizations2(ctx context.Context, req *sap
 		statusUint(core.StatusPending),
 		time.Unix(0, req.Now),
 		identifierTypeToUint[string(identifier.DNS)],
+		identifierTypeToUint[string(identifier.IP)],
 	}
 	qmarks := make([]string, len(req.Domains))
 	for i, n := range req.Domains {
@@ -1400,7 +1595,7 @@ func (ssa *SQLStorageAuthority) GetAuthorizations2(ctx context.Context, req *sap
 			WHERE registrationID = ? AND
 			status IN (?,?) AND
 			expires > ? AND
-			identifierType = ? AND
+			identifierType IN (?,?) AND
 			identifierValue IN (%s)`,
 		authzFields,
 		strings.Join(qmarks, ","),
@@ -1546,38 +1741,61 @@ func (ssa *SQLStorageAuthority) FinalizeAuthorization2(ctx context.Context, req
 }
 
 // RevokeCertificate stores revocation information about a certificate. It will only store this
-// information if the certificate is not already marked as revoked.
+// information if the certificate is not already marked as revoked. If the
+// RevocationEventQueue feature is enabled, a revocation event is queued for the
+// ocsp-updater in the same transaction. The OCSP response may then be omitted,
+// in which case the certificate's ocspLastUpdated is zeroed so that no
+// response is served for it until the ocsp-updater has signed one.
 func (ssa *SQLStorageAuthority) RevokeCertificate(ctx context.Context, req *sapb.RevokeCertificateRequest) error {
 	revokedDate := time.Unix(0, req.Date)
-	res, err := ssa.dbMap.Exec(
-		`UPDATE certificateStatus SET
-				status = ?,
-				revokedReason = ?,
-				revokedDate = ?,
-				ocspLastUpdated = ?,
-				ocspResponse = ?
-			WHERE serial = ? AND status != ?`,
-		string(core.OCSPStatusRevoked),
-		revocation.Reason(req.Reason),
-		revokedDate,
-		revokedDate,
-		req.Response,
-		req.Serial,
-		string(core.OCSPStatusRevoked),
-	)
-	if err != nil {
-		return err
+	ocspLastUpdated := revokedDate
+	if len(req.Response) == 0 {
+		ocspLastUpdated = time.Time{}
 	}
-	rows, err := res.RowsAffected()
-	if err != nil {
-		return err
-	}
-	if rows == 0 {
-		// InternalServerError because we expected this certificate status to exist and
-		// not be revoked.
-		return berrors.InternalServerError("no certificate with serial %s and status other than %s", req.Serial, string(core.OCSPStatusRevoked))
-	}
-	return nil
+	_, overallError := db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
+		res, err := txWithCtx.Exec(
+			`UPDATE certificateStatus SET
+					status = ?,
+					revokedReason = ?,
+					revokedDate = ?,
+					ocspLastUpdated = ?,
+					ocspResponse = ?
+				WHERE serial = ? AND status != ?`,
+			string(core.OCSPStatusRevoked),
+			revocation.Reason(req.Reason),
+			revokedDate,
+			ocspLastUpdated,
+			req.Response,
+			req.Serial,
+			string(core.OCSPStatusRevoked),
+		)
+		if err != nil {
+			return nil, err
+		}
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		if rows == 0 {
+			// InternalServerError because we expected this certificate status to exist and
+			// not be revoked.
+			return nil, berrors.InternalServerError("no certificate with serial %s and status other than %s", req.Serial, string(core.OCSPStatusRevoked))
+		}
+
+		if features.Enabled(features.RevocationEventQueue) {
+			_, err = txWithCtx.Exec(
+				"INSERT INTO revocationEvents (serial, created, nextAttempt) VALUES (?, ?, ?)",
+				req.Serial,
+				ssa.clk.Now(),
+				ssa.clk.Now(),
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+	return overallError
 }
 
 // GetPendingAuthorization2 returns the most recent Pending authorization with
@@ -1661,7 +1879,7 @@ func (ssa *SQLStorageAuthority) GetValidOrderAuthorizations2(ctx context.Context
 
 	byName := make(map[string]authzModel)
 	for _, am := range ams {
-		if uintToIdentifierType[am.IdentifierType] != string(identifier.DNS) {
+		if _, ok := uintToIdentifierType[am.IdentifierType]; !ok {
 			return nil, fmt.Errorf("unknown identifier type: %q on authz id %d", am.IdentifierType, am.ID)
 		}
 		existing, present := byName[am.IdentifierValue]
@@ -1704,8 +1922,8 @@ func (ssa *SQLStorageAuthority) CountInvalidAuthorizations2(ctx context.Context,
 
 // GetValidAuthorizations2 returns the latest authorization for all
 // domain names that the account has authorizations for. This method is
-// intended to deprecate GetValidAuthorizations. This method only supports
-// DNS identifier types.
+// intended to deprecate GetValidAuthorizations. This method supports DNS and
+// IP identifier types.
 func (ssa *SQLStorageAuthority) GetValidAuthorizations2(ctx context.Context, req *sapb.GetValidAuthorizationsRequest) (*sapb.Authorizations, error) {
 	var authzModels []authzModel
 	params := []interface{}{
@@ -1713,6 +1931,7 @@ func (ssa *SQLStorageAuthority) GetValidAuthorizations2(ctx context.Context, req
 		statusUint(core.StatusValid),
 		time.Unix(0, req.Now),
 		identifierTypeToUint[string(identifier.DNS)],
+		identifierTypeToUint[string(identifier.IP)],
 	}
 	qmarks := make([]string, len(req.Domains))
 	for i, n := range req.Domains {
@@ -1726,7 +1945,7 @@ func (ssa *SQLStorageAuthority) GetValidAuthorizations2(ctx context.Context, req
 			registrationID = ? AND
 			status = ? AND
 			expires > ? AND
-			identifierType = ? AND
+			identifierType IN (?,?) AND
 			identifierValue IN (%s)`,
 			authzFields,
 			strings.Join(qmarks, ","),
@@ -1739,8 +1958,8 @@ func (ssa *SQLStorageAuthority) GetValidAuthorizations2(ctx context.Context, req
 
 	authzMap := make(map[string]authzModel, len(authzModels))
 	for _, am := range authzModels {
-		// Only allow DNS identifiers
-		if uintToIdentifierType[am.IdentifierType] != string(identifier.DNS) {
+		// Only allow DNS and IP identifiers
+		if _, ok := uintToIdentifierType[am.IdentifierType]; !ok {
 			continue
 		}
 		// If there is an existing authorization in the map only replace it with one
@@ -1820,3 +2039,379 @@ func (ssa *SQLStorageAuthority) KeyBlocked(ctx context.Context, req *sapb.KeyBlo
 	exists = true
 	return &sapb.Exists{Exists: exists}, nil
 }
+
+// GetExternalAccountKey returns the external account binding key with the
+// given key identifier, or a NotFound error if there is none.
+func (ssa *SQLStorageAuthority) GetExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKeyID) (*sapb.ExternalAccountKey, error) {
+	if req == nil || req.KeyID == "" {
+		return nil, errIncompleteRequest
+	}
+	var model externalAccountKeyModel
+	err := ssa.dbMap.WithContext(ctx).SelectOne(
+		&model,
+		`SELECT id, keyID, hmacKey, createdAt, revokedAt, registrationID
+		FROM externalAccountKeys WHERE keyID = ?`,
+		req.KeyID,
+	)
+	if err != nil {
+		if db.IsNoRows(err) {
+			return nil, berrors.NotFoundError("external account key %q not found", req.KeyID)
+		}
+		return nil, err
+	}
+	return externalAccountKeyModelToPB(model), nil
+}
+
+// AddExternalAccountKey adds a new external account binding key to the
+// externalAccountKeys table.
+func (ssa *SQLStorageAuthority) AddExternalAccountKey(ctx context.Context, req *sapb.AddExternalAccountKeyRequest) (*corepb.Empty, error) {
+	if core.IsAnyNilOrZero(req, req.KeyID, req.HmacKey) {
+		return nil, errIncompleteRequest
+	}
+	err := ssa.dbMap.WithContext(ctx).Insert(&externalAccountKeyModel{
+		KeyID:     req.KeyID,
+		HMACKey:   req.HmacKey,
+		CreatedAt: ssa.clk.Now(),
+	})
+	if err != nil {
+		if db.IsDuplicate(err) {
+			return nil, berrors.DuplicateError("external account key %q already exists", req.KeyID)
+		}
+		return nil, err
+	}
+	return &corepb.Empty{}, nil
+}
+
+// RevokeExternalAccountKey marks an external account binding key as revoked,
+// preventing it from being used to create new registrations. Registrations
+// which have already been bound to the key are unaffected.
+func (ssa *SQLStorageAuthority) RevokeExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKeyID) (*corepb.Empty, error) {
+	if req == nil || req.KeyID == "" {
+		return nil, errIncompleteRequest
+	}
+	result, err := ssa.dbMap.WithContext(ctx).Exec(
+		"UPDATE externalAccountKeys SET revokedAt = ? WHERE keyID = ? AND revokedAt IS NULL",
+		ssa.clk.Now(),
+		req.KeyID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, berrors.NotFoundError("external account key %q not found or already revoked", req.KeyID)
+	}
+	return &corepb.Empty{}, nil
+}
+
+// UpdateOCSPResponse stores an OCSP response which was signed outside of the
+// ocsp-updater, such as by an ocsp-responder signing live. The response isn't
+// stored if the certificate's status has changed since it was signed, so that
+// a revoked certificate's response is never replaced by a "good" one, or if a
+// more recent response has already been stored.
+func (ssa *SQLStorageAuthority) UpdateOCSPResponse(ctx context.Context, req *sapb.UpdateOCSPResponseRequest) (*corepb.Empty, error) {
+	if core.IsAnyNilOrZero(req, req.Serial, req.Status, req.Response, req.ThisUpdate) {
+		return nil, errIncompleteRequest
+	}
+	thisUpdate := time.Unix(0, req.ThisUpdate)
+	_, err := ssa.dbMap.WithContext(ctx).Exec(
+		`UPDATE certificateStatus
+		SET ocspResponse = ?, ocspLastUpdated = ?
+		WHERE serial = ?
+		AND status = ?
+		AND ocspLastUpdated <= ?`,
+		req.Response,
+		thisUpdate,
+		req.Serial,
+		req.Status,
+		thisUpdate,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &corepb.Empty{}, nil
+}
+
+// GetRevokedCerts streams the serial numbers, revocation details, and expiry
+// of all revoked certificates from the given issuer which expire within the
+// requested window, or at any time after its start if it has no end. It is
+// used to build CRLs. If a shard is given, only the certificates recorded as
+// belonging to that CRL shard are returned.
+func (ssa *SQLStorageAuthority) GetRevokedCerts(req *sapb.GetRevokedCertsRequest, stream sapb.StorageAuthority_GetRevokedCertsServer) error {
+	if core.IsAnyNilOrZero(req, req.IssuerID, req.ExpiresAfter) {
+		return errIncompleteRequest
+	}
+	query := `SELECT serial, revokedReason, revokedDate, notAfter
+		FROM certificateStatus
+		WHERE notAfter >= ?
+		AND issuerID = ?
+		AND status = ?`
+	args := []interface{}{
+		time.Unix(0, req.ExpiresAfter),
+		req.IssuerID,
+		string(core.OCSPStatusRevoked),
+	}
+	if req.ExpiresBefore != 0 {
+		query += " AND notAfter < ?"
+		args = append(args, time.Unix(0, req.ExpiresBefore))
+	}
+	if req.Shard != nil {
+		query += " AND crlShard = ?"
+		args = append(args, req.Shard.Idx)
+	}
+	if req.RevokedBefore != 0 {
+		query += " AND revokedDate <= ?"
+		args = append(args, time.Unix(0, req.RevokedBefore))
+	}
+	// A shard can list a great many certificates, so each row is sent as soon
+	// as it's read rather than holding all of them in memory.
+	rows, err := ssa.dbMap.WithContext(stream.Context()).Query(query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var row revokedCertModel
+		err = rows.Scan(&row.Serial, &row.RevokedReason, &row.RevokedDate, &row.NotAfter)
+		if err != nil {
+			return err
+		}
+		err = stream.Send(&sapb.RevokedCert{
+			Serial:      row.Serial,
+			Reason:      int64(row.RevokedReason),
+			RevokedDate: row.RevokedDate.UnixNano(),
+			NotAfter:    row.NotAfter.UnixNano(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// AddSCT stores an SCT which a CT log returned for a certificate or
+// precertificate. Storing an SCT which is already stored is not an error.
+func (ssa *SQLStorageAuthority) AddSCT(ctx context.Context, req *sapb.SCT) (*corepb.Empty, error) {
+	if core.IsAnyNilOrZero(req, req.Serial, req.LogID, req.LogURL, req.Timestamp, req.Sct) {
+		return nil, errIncompleteRequest
+	}
+	err := ssa.dbMap.WithContext(ctx).Insert(&sctModel{
+		Serial:         req.Serial,
+		LogID:          req.LogID,
+		LogURL:         req.LogURL,
+		Timestamp:      time.Unix(0, req.Timestamp),
+		Precertificate: req.Precertificate,
+		SCT:            req.Sct,
+	})
+	if err != nil && !db.IsDuplicate(err) {
+		return nil, err
+	}
+	return &corepb.Empty{}, nil
+}
+
+// GetSCTs returns all of the stored SCTs for the certificate and
+// precertificate with the given serial, i.e. which CT logs have them.
+func (ssa *SQLStorageAuthority) GetSCTs(ctx context.Context, req *sapb.Serial) (*sapb.SCTs, error) {
+	if req == nil || req.Serial == "" {
+		return nil, errIncompleteRequest
+	}
+	var rows []sctModel
+	_, err := ssa.dbMap.WithContext(ctx).Select(
+		&rows,
+		`SELECT id, serial, logID, logURL, timestamp, precertificate, sct
+		FROM certificateSCTs
+		WHERE serial = ?
+		ORDER BY id`,
+		req.Serial,
+	)
+	if err != nil {
+		return nil, err
+	}
+	resp := &sapb.SCTs{}
+	for _, row := range rows {
+		resp.Scts = append(resp.Scts, sctModelToPB(row))
+	}
+	return resp, nil
+}
+
+// GetRecentSCTs returns up to the requested number of stored SCTs, in the
+// order they were stored, starting after the given ID and from the first SCT
+// with a timestamp at or after the given time. It lets a caller work through
+// every SCT received since some point by passing the ID of the last one it
+// saw.
+func (ssa *SQLStorageAuthority) GetRecentSCTs(ctx context.Context, req *sapb.GetRecentSCTsRequest) (*sapb.SCTs, error) {
+	if req == nil || req.Limit <= 0 {
+		return nil, errIncompleteRequest
+	}
+	afterID := req.AfterID
+	if req.Since != 0 {
+		// Filtering on timestamp while paging by id would scan every older
+		// row, so find the first SCT with a late enough timestamp using its
+		// index, and page by id from there. SCTs are stored in roughly
+		// timestamp order, so little is missed by starting at it.
+		var firstID int64
+		err := ssa.dbMap.WithContext(ctx).SelectOne(
+			&firstID,
+			`SELECT id
+			FROM certificateSCTs
+			WHERE timestamp >= ?
+			ORDER BY timestamp
+			LIMIT 1`,
+			time.Unix(0, req.Since),
+		)
+		if db.IsNoRows(err) {
+			return &sapb.SCTs{}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if firstID-1 > afterID {
+			afterID = firstID - 1
+		}
+	}
+	var rows []sctModel
+	_, err := ssa.dbMap.WithContext(ctx).Select(
+		&rows,
+		`SELECT id, serial, logID, logURL, timestamp, precertificate, sct
+		FROM certificateSCTs
+		WHERE id > ?
+		AND timestamp >= ?
+		ORDER BY id
+		LIMIT ?`,
+		afterID,
+		time.Unix(0, req.Since),
+		req.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	resp := &sapb.SCTs{}
+	for _, row := range rows {
+		resp.Scts = append(resp.Scts, sctModelToPB(row))
+	}
+	return resp, nil
+}
+
+// AddFinalCertSubmissions queues the submission of a final certificate to
+// each of the given CT logs. The publisher retries each submission until it
+// succeeds.
+func (ssa *SQLStorageAuthority) AddFinalCertSubmissions(ctx context.Context, req *sapb.AddFinalCertSubmissionsRequest) (*corepb.Empty, error) {
+	if req == nil || req.Serial == "" || len(req.Logs) == 0 {
+		return nil, errIncompleteRequest
+	}
+	now := ssa.clk.Now()
+	_, overallError := db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
+		for _, ctLog := range req.Logs {
+			if ctLog == nil || ctLog.Url == "" || ctLog.PublicKey == "" {
+				return nil, errIncompleteRequest
+			}
+			err := txWithCtx.Insert(&finalCertSubmissionModel{
+				Serial:       req.Serial,
+				LogURL:       ctLog.Url,
+				LogPublicKey: ctLog.PublicKey,
+				Created:      now,
+				NextAttempt:  now,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+	if overallError != nil {
+		return nil, overallError
+	}
+	return &corepb.Empty{}, nil
+}
+
+// finalCertBacklogCap is the most queued final certificate submissions which
+// GetFinalCertSubmissions counts, so that a large backlog doesn't make every
+// poll of the queue scan all of it.
+const finalCertBacklogCap = 10000
+
+// GetFinalCertSubmissions returns up to the requested number of queued final
+// certificate submissions which are due to be attempted, along with the size,
+// up to finalCertBacklogCap, and age of the whole queue.
+func (ssa *SQLStorageAuthority) GetFinalCertSubmissions(ctx context.Context, req *sapb.GetFinalCertSubmissionsRequest) (*sapb.FinalCertSubmissions, error) {
+	if req == nil || req.Limit <= 0 {
+		return nil, errIncompleteRequest
+	}
+	var rows []finalCertSubmissionModel
+	_, err := ssa.dbMap.WithContext(ctx).Select(
+		&rows,
+		`SELECT id, serial, logURL, logPublicKey, attempts, created, nextAttempt
+		FROM finalCertSubmissions
+		WHERE nextAttempt <= ?
+		ORDER BY nextAttempt
+		LIMIT ?`,
+		ssa.clk.Now(),
+		req.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	backlog, err := ssa.dbMap.WithContext(ctx).SelectInt(
+		"SELECT COUNT(*) FROM (SELECT 1 FROM finalCertSubmissions LIMIT ?) AS capped",
+		finalCertBacklogCap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	resp := &sapb.FinalCertSubmissions{Backlog: backlog}
+	// created_idx lets MIN read the first entry of the index rather than the
+	// whole table.
+	var oldest struct {
+		Created *time.Time `db:"oldest"`
+	}
+	err = ssa.dbMap.WithContext(ctx).SelectOne(
+		&oldest,
+		"SELECT MIN(created) AS oldest FROM finalCertSubmissions",
+	)
+	if err != nil {
+		return nil, err
+	}
+	if oldest.Created != nil {
+		resp.OldestCreated = oldest.Created.UnixNano()
+	}
+	for _, row := range rows {
+		resp.Submissions = append(resp.Submissions, finalCertSubmissionModelToPB(row))
+	}
+	return resp, nil
+}
+
+// RescheduleFinalCertSubmission records a failed attempt at a queued final
+// certificate submission, and when it should next be attempted.
+func (ssa *SQLStorageAuthority) RescheduleFinalCertSubmission(ctx context.Context, req *sapb.FinalCertSubmission) (*corepb.Empty, error) {
+	if core.IsAnyNilOrZero(req, req.Id, req.Attempts, req.NextAttempt) {
+		return nil, errIncompleteRequest
+	}
+	_, err := ssa.dbMap.WithContext(ctx).Exec(
+		"UPDATE finalCertSubmissions SET attempts = ?, nextAttempt = ? WHERE id = ?",
+		req.Attempts,
+		time.Unix(0, req.NextAttempt),
+		req.Id,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &corepb.Empty{}, nil
+}
+
+// RemoveFinalCertSubmission removes a final certificate submission from the
+// queue once it has succeeded.
+func (ssa *SQLStorageAuthority) RemoveFinalCertSubmission(ctx context.Context, req *sapb.FinalCertSubmissionID) (*corepb.Empty, error) {
+	if req == nil || req.Id == 0 {
+		return nil, errIncompleteRequest
+	}
+	_, err := ssa.dbMap.WithContext(ctx).Exec(
+		"DELETE FROM finalCertSubmissions WHERE id = ?",
+		req.Id,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &corepb.Empty{}, nil
+}
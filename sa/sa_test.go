@@ -1,6 +1,7 @@
 package sa
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"crypto/rsa"
@@ -12,6 +13,7 @@ import (
 	"math/big"
 	"math/bits"
 	"net"
+	"os"
 	"reflect"
 	"sync"
 	"testing"
@@ -33,6 +35,7 @@ import (
 	"github.com/letsencrypt/boulder/sa/satest"
 	"github.com/letsencrypt/boulder/test"
 	"github.com/letsencrypt/boulder/test/vars"
+	"google.golang.org/grpc"
 	jose "gopkg.in/square/go-jose.v2"
 )
 
@@ -183,6 +186,104 @@ func TestNoSuchRegistrationErrors(t *testing.T) {
 	test.AssertErrorIs(t, err, berrors.NotFound)
 }
 
+func TestExternalAccountKeys(t *testing.T) {
+	if os.Getenv("BOULDER_CONFIG_DIR") != "test/config-next" {
+		t.Skip("the externalAccountKeys table only exists in the config-next schema")
+	}
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	_, err := sa.AddExternalAccountKey(ctx, &sapb.AddExternalAccountKeyRequest{KeyID: "kid-1"})
+	test.AssertError(t, err, "AddExternalAccountKey accepted an incomplete request")
+	_, err = sa.GetExternalAccountKey(ctx, &sapb.ExternalAccountKeyID{})
+	test.AssertError(t, err, "GetExternalAccountKey accepted an incomplete request")
+
+	_, err = sa.GetExternalAccountKey(ctx, &sapb.ExternalAccountKeyID{KeyID: "kid-1"})
+	test.AssertErrorIs(t, err, berrors.NotFound)
+
+	hmacKey := []byte("an hmac key which is long enough")
+	_, err = sa.AddExternalAccountKey(ctx, &sapb.AddExternalAccountKeyRequest{KeyID: "kid-1", HmacKey: hmacKey})
+	test.AssertNotError(t, err, "AddExternalAccountKey failed")
+	_, err = sa.AddExternalAccountKey(ctx, &sapb.AddExternalAccountKeyRequest{KeyID: "kid-1", HmacKey: hmacKey})
+	test.AssertErrorIs(t, err, berrors.Duplicate)
+
+	key, err := sa.GetExternalAccountKey(ctx, &sapb.ExternalAccountKeyID{KeyID: "kid-1"})
+	test.AssertNotError(t, err, "GetExternalAccountKey failed")
+	test.AssertEquals(t, key.KeyID, "kid-1")
+	test.AssertByteEquals(t, key.HmacKey, hmacKey)
+	test.AssertEquals(t, key.CreatedAt, fc.Now().UnixNano())
+	test.AssertEquals(t, key.RevokedAt, int64(0))
+	test.AssertEquals(t, key.RegistrationID, int64(0))
+
+	fc.Add(time.Hour)
+	_, err = sa.RevokeExternalAccountKey(ctx, &sapb.ExternalAccountKeyID{KeyID: "kid-1"})
+	test.AssertNotError(t, err, "RevokeExternalAccountKey failed")
+	key, err = sa.GetExternalAccountKey(ctx, &sapb.ExternalAccountKeyID{KeyID: "kid-1"})
+	test.AssertNotError(t, err, "GetExternalAccountKey failed")
+	test.AssertEquals(t, key.RevokedAt, fc.Now().UnixNano())
+
+	// Revoking an already revoked or unknown key is an error.
+	_, err = sa.RevokeExternalAccountKey(ctx, &sapb.ExternalAccountKeyID{KeyID: "kid-1"})
+	test.AssertErrorIs(t, err, berrors.NotFound)
+	_, err = sa.RevokeExternalAccountKey(ctx, &sapb.ExternalAccountKeyID{KeyID: "kid-2"})
+	test.AssertErrorIs(t, err, berrors.NotFound)
+}
+
+func TestNewRegistrationExternalAccountBinding(t *testing.T) {
+	if os.Getenv("BOULDER_CONFIG_DIR") != "test/config-next" {
+		t.Skip("the externalAccountKeys table only exists in the config-next schema")
+	}
+	sa, _, cleanUp := initSA(t)
+	defer cleanUp()
+
+	hmacKey := []byte("an hmac key which is long enough")
+	for _, kid := range []string{"kid-1", "kid-2"} {
+		_, err := sa.AddExternalAccountKey(ctx, &sapb.AddExternalAccountKeyRequest{KeyID: kid, HmacKey: hmacKey})
+		test.AssertNotError(t, err, "AddExternalAccountKey failed")
+	}
+	_, err := sa.RevokeExternalAccountKey(ctx, &sapb.ExternalAccountKeyID{KeyID: "kid-2"})
+	test.AssertNotError(t, err, "RevokeExternalAccountKey failed")
+
+	reg, err := sa.NewRegistration(ctx, core.Registration{
+		Key:               satest.GoodJWK(),
+		InitialIP:         net.ParseIP("43.34.43.34"),
+		ExternalAccountID: "kid-1",
+	})
+	test.AssertNotError(t, err, "NewRegistration failed")
+	test.AssertEquals(t, reg.ExternalAccountID, "kid-1")
+	key, err := sa.GetExternalAccountKey(ctx, &sapb.ExternalAccountKeyID{KeyID: "kid-1"})
+	test.AssertNotError(t, err, "GetExternalAccountKey failed")
+	test.AssertEquals(t, key.RegistrationID, reg.ID)
+
+	// The binding is returned with the registration once it's stored.
+	err = features.Set(map[string]bool{"ExternalAccountBindings": true})
+	test.AssertNotError(t, err, "failed to set features")
+	defer features.Reset()
+	got, err := sa.GetRegistration(ctx, reg.ID)
+	test.AssertNotError(t, err, "GetRegistration failed")
+	test.AssertEquals(t, got.ExternalAccountID, "kid-1")
+	got, err = sa.GetRegistrationByKey(ctx, satest.GoodJWK())
+	test.AssertNotError(t, err, "GetRegistrationByKey failed")
+	test.AssertEquals(t, got.ExternalAccountID, "kid-1")
+
+	var anotherJWK jose.JSONWebKey
+	err = json.Unmarshal([]byte(anotherKey), &anotherJWK)
+	test.AssertNotError(t, err, "couldn't unmarshal anotherJWK")
+
+	// A key may only be bound once, must not be revoked and must exist. In
+	// each case the registration must not be created.
+	for _, kid := range []string{"kid-1", "kid-2", "kid-3"} {
+		_, err = sa.NewRegistration(ctx, core.Registration{
+			Key:               &anotherJWK,
+			InitialIP:         net.ParseIP("43.34.43.34"),
+			ExternalAccountID: kid,
+		})
+		test.AssertErrorIs(t, err, berrors.Unauthorized)
+		_, err = sa.GetRegistrationByKey(ctx, &anotherJWK)
+		test.AssertErrorIs(t, err, berrors.NotFound)
+	}
+}
+
 func TestAddCertificate(t *testing.T) {
 	sa, clk, cleanUp := initSA(t)
 	defer cleanUp()
@@ -233,6 +334,53 @@ func TestAddCertificate(t *testing.T) {
 	test.AssertNotError(t, err, "Couldn't add test-cert2.der")
 }
 
+func TestGetCertificateAndStatus(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	reg := satest.CreateWorkingRegistration(t, sa)
+	certDER, err := ioutil.ReadFile("www.eff.org.der")
+	test.AssertNotError(t, err, "Couldn't read example cert DER")
+	serial := "000000000000000000000000000000021bd4"
+
+	// The precertificate creates the certificateStatus row and the final
+	// certificate creates the certificates row.
+	issued := fc.Now()
+	_, err = sa.AddPrecertificate(ctx, &sapb.AddCertificateRequest{
+		Der:      certDER,
+		RegID:    reg.ID,
+		Ocsp:     []byte{1, 2, 3},
+		Issued:   issued.UnixNano(),
+		IssuerID: 1,
+	})
+	test.AssertNotError(t, err, "Couldn't add precertificate")
+	_, err = sa.AddCertificate(ctx, certDER, reg.ID, nil, &issued)
+	test.AssertNotError(t, err, "Couldn't add certificate")
+
+	resp, err := sa.GetCertificateAndStatus(ctx, &sapb.Serial{Serial: serial})
+	test.AssertNotError(t, err, "GetCertificateAndStatus failed")
+	cert, err := bgrpc.PBToCert(resp.Certificate)
+	test.AssertNotError(t, err, "PBToCert failed")
+	test.AssertEquals(t, cert.Serial, serial)
+	test.AssertEquals(t, cert.RegistrationID, reg.ID)
+	test.AssertByteEquals(t, cert.DER, certDER)
+	test.AssertEquals(t, cert.Issued, issued)
+	status, err := bgrpc.PBToCertStatus(resp.Status)
+	test.AssertNotError(t, err, "PBToCertStatus failed")
+	test.AssertEquals(t, status.Serial, serial)
+	test.AssertEquals(t, status.Status, core.OCSPStatusGood)
+	test.AssertDeepEquals(t, status.OCSPResponse, []byte{1, 2, 3})
+	test.AssertEquals(t, status.OCSPLastUpdated, issued)
+	test.AssertEquals(t, status.IsExpired, false)
+
+	_, err = sa.GetCertificateAndStatus(ctx, &sapb.Serial{Serial: "000000000000000000000000000000000bad"})
+	test.AssertError(t, err, "GetCertificateAndStatus should fail for an unknown serial")
+	test.AssertErrorIs(t, err, berrors.NotFound)
+
+	_, err = sa.GetCertificateAndStatus(ctx, &sapb.Serial{})
+	test.AssertError(t, err, "GetCertificateAndStatus should fail for an incomplete request")
+}
+
 func TestCountCertificatesByNames(t *testing.T) {
 	sa, clk, cleanUp := initSA(t)
 	defer cleanUp()
@@ -298,7 +446,7 @@ func TestCountCertificatesByNames(t *testing.T) {
 	interlocker.Add(len(names))
 	sa.parallelismPerRPC = len(names)
 	oldCertCountFunc := sa.countCertificatesByName
-	sa.countCertificatesByName = func(sel db.Selector, domain string, earliest, latest time.Time) (int, error) {
+	sa.countCertificatesByName = func(sel db.Selector, domain string, earliest, latest time.Time) (int, time.Time, error) {
 		interlocker.Done()
 		interlocker.Wait()
 		return oldCertCountFunc(sel, domain, earliest, latest)
@@ -949,6 +1097,79 @@ func TestNewOrder(t *testing.T) {
 	test.AssertDeepEquals(t, names, []string{"com.example", "com.example.another.just"})
 }
 
+func TestNewOrderCertificateProfileName(t *testing.T) {
+	if os.Getenv("BOULDER_CONFIG_DIR") != "test/config-next" {
+		t.Skip("the certificateProfileName column only exists in the config-next schema")
+	}
+	sa, fc, cleanup := initSA(t)
+	defer cleanup()
+
+	err := features.Set(map[string]bool{"StoreCertificateProfileName": true})
+	test.AssertNotError(t, err, "failed to set features")
+	defer features.Reset()
+
+	reg, err := sa.NewRegistration(ctx, core.Registration{
+		Key:       &jose.JSONWebKey{Key: &rsa.PublicKey{N: big.NewInt(1), E: 1}},
+		InitialIP: net.ParseIP("42.42.42.42"),
+	})
+	test.AssertNotError(t, err, "Couldn't create test registration")
+
+	expires := fc.Now().Add(time.Hour)
+	authzID := createFinalizedAuthorization(t, sa, "example.com", expires, "valid", fc.Now())
+
+	order, err := sa.NewOrder(context.Background(), &corepb.Order{
+		RegistrationID:         reg.ID,
+		Expires:                expires.UnixNano(),
+		Names:                  []string{"example.com"},
+		V2Authorizations:       []int64{authzID},
+		CertificateProfileName: "short-lived",
+	})
+	test.AssertNotError(t, err, "sa.NewOrder failed")
+	test.AssertEquals(t, order.CertificateProfileName, "short-lived")
+
+	storedOrder, err := sa.GetOrder(context.Background(), &sapb.OrderRequest{Id: order.Id})
+	test.AssertNotError(t, err, "sa.GetOrder failed")
+	test.AssertEquals(t, storedOrder.CertificateProfileName, "short-lived")
+}
+
+func TestNewOrderValidity(t *testing.T) {
+	if os.Getenv("BOULDER_CONFIG_DIR") != "test/config-next" {
+		t.Skip("the notBefore and notAfter columns only exist in the config-next schema")
+	}
+	sa, fc, cleanup := initSA(t)
+	defer cleanup()
+
+	err := features.Set(map[string]bool{"StoreOrderValidity": true})
+	test.AssertNotError(t, err, "failed to set features")
+	defer features.Reset()
+
+	reg, err := sa.NewRegistration(ctx, core.Registration{
+		Key:       &jose.JSONWebKey{Key: &rsa.PublicKey{N: big.NewInt(1), E: 1}},
+		InitialIP: net.ParseIP("42.42.42.42"),
+	})
+	test.AssertNotError(t, err, "Couldn't create test registration")
+
+	expires := fc.Now().Add(time.Hour)
+	authzID := createFinalizedAuthorization(t, sa, "example.com", expires, "valid", fc.Now())
+
+	// The database doesn't store sub-second values.
+	notAfter := fc.Now().Add(6 * time.Hour).Truncate(time.Second).UnixNano()
+	order, err := sa.NewOrder(context.Background(), &corepb.Order{
+		RegistrationID:   reg.ID,
+		Expires:          expires.UnixNano(),
+		Names:            []string{"example.com"},
+		V2Authorizations: []int64{authzID},
+		NotAfter:         notAfter,
+	})
+	test.AssertNotError(t, err, "sa.NewOrder failed")
+	test.AssertEquals(t, order.NotAfter, notAfter)
+
+	storedOrder, err := sa.GetOrder(context.Background(), &sapb.OrderRequest{Id: order.Id})
+	test.AssertNotError(t, err, "sa.GetOrder failed")
+	test.AssertEquals(t, storedOrder.NotBefore, int64(0))
+	test.AssertEquals(t, storedOrder.NotAfter, notAfter)
+}
+
 func TestSetOrderProcessing(t *testing.T) {
 	sa, fc, cleanup := initSA(t)
 	defer cleanup()
@@ -1185,7 +1406,7 @@ func TestCountOrders(t *testing.T) {
 	latest := now.Add(time.Second)
 
 	// Counting new orders for a reg ID that doesn't exist should return 0
-	count, err := sa.CountOrders(ctx, 12345, earliest, latest)
+	count, _, err := sa.CountOrders(ctx, 12345, earliest, latest)
 	test.AssertNotError(t, err, "Couldn't count new orders for fake reg ID")
 	test.AssertEquals(t, count, 0)
 
@@ -1202,20 +1423,82 @@ func TestCountOrders(t *testing.T) {
 	})
 	test.AssertNotError(t, err, "Couldn't create new pending order")
 
-	// Counting new orders for the reg ID should now yield 1
-	count, err = sa.CountOrders(ctx, reg.ID, earliest, latest)
+	// Counting new orders for the reg ID should now yield 1, with the order's
+	// creation time as the oldest counted event
+	count, oldest, err := sa.CountOrders(ctx, reg.ID, earliest, latest)
 	test.AssertNotError(t, err, "Couldn't count new orders for reg ID")
 	test.AssertEquals(t, count, 1)
+	test.AssertEquals(t, oldest.UnixNano(), order.Created)
 
 	// Moving the count window to after the order was created should return the
 	// count to 0
 	earliest = time.Unix(0, order.Created).Add(time.Minute)
 	latest = earliest.Add(time.Hour)
-	count, err = sa.CountOrders(ctx, reg.ID, earliest, latest)
+	count, _, err = sa.CountOrders(ctx, reg.ID, earliest, latest)
 	test.AssertNotError(t, err, "Couldn't count new orders for reg ID")
 	test.AssertEquals(t, count, 0)
 }
 
+func TestGetOrdersForAccount(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	reg := satest.CreateWorkingRegistration(t, sa)
+	otherReg, err := sa.NewRegistration(ctx, core.Registration{
+		Key:       &jose.JSONWebKey{Key: &rsa.PublicKey{N: big.NewInt(1), E: 1}},
+		InitialIP: net.ParseIP("42.42.42.42"),
+	})
+	test.AssertNotError(t, err, "Couldn't create test registration")
+	authzID := createPendingAuthorization(t, sa, "example.com", fc.Now().Add(24*time.Hour))
+
+	newOrder := func(regID int64, expires time.Time) int64 {
+		order, err := sa.NewOrder(ctx, &corepb.Order{
+			RegistrationID:   regID,
+			Expires:          expires.UnixNano(),
+			Names:            []string{"example.com"},
+			V2Authorizations: []int64{authzID},
+		})
+		test.AssertNotError(t, err, "Couldn't create new order")
+		return order.Id
+	}
+
+	// One expired order and three unexpired orders for the account, plus an
+	// unexpired order belonging to another account.
+	_ = newOrder(reg.ID, fc.Now().Add(-time.Hour))
+	first := newOrder(reg.ID, fc.Now().Add(time.Hour))
+	second := newOrder(reg.ID, fc.Now().Add(time.Hour))
+	_ = newOrder(otherReg.ID, fc.Now().Add(time.Hour))
+	third := newOrder(reg.ID, fc.Now().Add(time.Hour))
+
+	_, err = sa.GetOrdersForAccount(ctx, &sapb.GetOrdersForAccountRequest{AcctID: reg.ID})
+	test.AssertError(t, err, "GetOrdersForAccount didn't fail without a limit")
+
+	// Without a cursor the most recent orders are returned first.
+	resp, err := sa.GetOrdersForAccount(ctx, &sapb.GetOrdersForAccountRequest{
+		AcctID: reg.ID,
+		Limit:  2,
+	})
+	test.AssertNotError(t, err, "GetOrdersForAccount failed")
+	test.AssertDeepEquals(t, resp.Ids, []int64{third, second})
+
+	// The cursor picks up where the previous page left off, and expired orders
+	// are never returned.
+	resp, err = sa.GetOrdersForAccount(ctx, &sapb.GetOrdersForAccountRequest{
+		AcctID: reg.ID,
+		Cursor: second,
+		Limit:  2,
+	})
+	test.AssertNotError(t, err, "GetOrdersForAccount failed")
+	test.AssertDeepEquals(t, resp.Ids, []int64{first})
+
+	resp, err = sa.GetOrdersForAccount(ctx, &sapb.GetOrdersForAccountRequest{
+		AcctID: 12345,
+		Limit:  2,
+	})
+	test.AssertNotError(t, err, "GetOrdersForAccount failed")
+	test.AssertEquals(t, len(resp.Ids), 0)
+}
+
 func TestFasterGetOrderForNames(t *testing.T) {
 	sa, fc, cleanUp := initSA(t)
 	defer cleanUp()
@@ -1557,6 +1840,72 @@ func TestUpdateChallengesDeleteUnused(t *testing.T) {
 	}
 }
 
+func TestUpdateOCSPResponse(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	reg := satest.CreateWorkingRegistration(t, sa)
+	certDER, err := ioutil.ReadFile("www.eff.org.der")
+	test.AssertNotError(t, err, "Couldn't read example cert DER")
+	_, err = sa.AddPrecertificate(ctx, &sapb.AddCertificateRequest{
+		Der:      certDER,
+		RegID:    reg.ID,
+		Issued:   sa.clk.Now().UnixNano(),
+		IssuerID: 1,
+	})
+	test.AssertNotError(t, err, "Couldn't add www.eff.org.der")
+	serial := "000000000000000000000000000000021bd4"
+
+	fc.Add(time.Hour)
+	thisUpdate := fc.Now()
+	_, err = sa.UpdateOCSPResponse(ctx, &sapb.UpdateOCSPResponseRequest{
+		Serial:     serial,
+		Status:     string(core.OCSPStatusGood),
+		Response:   []byte{1, 2, 3},
+		ThisUpdate: thisUpdate.UnixNano(),
+	})
+	test.AssertNotError(t, err, "UpdateOCSPResponse failed")
+	status, err := sa.GetCertificateStatus(ctx, serial)
+	test.AssertNotError(t, err, "GetCertificateStatus failed")
+	test.AssertDeepEquals(t, status.OCSPResponse, []byte{1, 2, 3})
+	test.AssertEquals(t, status.OCSPLastUpdated, thisUpdate)
+
+	// An older response must not replace a newer one.
+	_, err = sa.UpdateOCSPResponse(ctx, &sapb.UpdateOCSPResponseRequest{
+		Serial:     serial,
+		Status:     string(core.OCSPStatusGood),
+		Response:   []byte{4, 5, 6},
+		ThisUpdate: thisUpdate.Add(-time.Minute).UnixNano(),
+	})
+	test.AssertNotError(t, err, "UpdateOCSPResponse failed")
+	status, err = sa.GetCertificateStatus(ctx, serial)
+	test.AssertNotError(t, err, "GetCertificateStatus failed")
+	test.AssertDeepEquals(t, status.OCSPResponse, []byte{1, 2, 3})
+
+	// Nor may a "good" response replace a revoked one.
+	fc.Add(time.Hour)
+	err = sa.RevokeCertificate(ctx, &sapb.RevokeCertificateRequest{
+		Serial:   serial,
+		Date:     fc.Now().UnixNano(),
+		Reason:   1,
+		Response: []byte{7, 8, 9},
+	})
+	test.AssertNotError(t, err, "RevokeCertificate failed")
+	_, err = sa.UpdateOCSPResponse(ctx, &sapb.UpdateOCSPResponseRequest{
+		Serial:     serial,
+		Status:     string(core.OCSPStatusGood),
+		Response:   []byte{4, 5, 6},
+		ThisUpdate: fc.Now().Add(time.Minute).UnixNano(),
+	})
+	test.AssertNotError(t, err, "UpdateOCSPResponse failed")
+	status, err = sa.GetCertificateStatus(ctx, serial)
+	test.AssertNotError(t, err, "GetCertificateStatus failed")
+	test.AssertDeepEquals(t, status.OCSPResponse, []byte{7, 8, 9})
+
+	_, err = sa.UpdateOCSPResponse(ctx, &sapb.UpdateOCSPResponseRequest{Serial: serial})
+	test.AssertError(t, err, "UpdateOCSPResponse should fail for an incomplete request")
+}
+
 func TestRevokeCertificate(t *testing.T) {
 	sa, fc, cleanUp := initSA(t)
 	defer cleanUp()
@@ -1611,6 +1960,62 @@ func TestRevokeCertificate(t *testing.T) {
 	test.AssertError(t, err, "RevokeCertificate should've failed when certificate already revoked")
 }
 
+func TestRevokeCertificateQueuesEvent(t *testing.T) {
+	if os.Getenv("BOULDER_CONFIG_DIR") != "test/config-next" {
+		t.Skip("the revocationEvents table only exists in the config-next schema")
+	}
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	err := features.Set(map[string]bool{"RevocationEventQueue": true})
+	test.AssertNotError(t, err, "failed to set features")
+	defer features.Reset()
+
+	reg := satest.CreateWorkingRegistration(t, sa)
+	certDER, err := ioutil.ReadFile("www.eff.org.der")
+	test.AssertNotError(t, err, "Couldn't read example cert DER")
+	_, err = sa.AddPrecertificate(ctx, &sapb.AddCertificateRequest{
+		Der:      certDER,
+		RegID:    reg.ID,
+		Ocsp:     nil,
+		Issued:   sa.clk.Now().UnixNano(),
+		IssuerID: 1,
+	})
+	test.AssertNotError(t, err, "Couldn't add www.eff.org.der")
+
+	serial := "000000000000000000000000000000021bd4"
+	fc.Add(1 * time.Hour)
+
+	// Revoking without a response leaves it to the ocsp-updater to sign one.
+	err = sa.RevokeCertificate(context.Background(), &sapb.RevokeCertificateRequest{
+		Serial: serial,
+		Date:   fc.Now().UnixNano(),
+		Reason: 1,
+	})
+	test.AssertNotError(t, err, "RevokeCertificate failed")
+
+	status, err := sa.GetCertificateStatus(ctx, serial)
+	test.AssertNotError(t, err, "GetCertificateStatus failed")
+	test.AssertEquals(t, status.Status, core.OCSPStatusRevoked)
+	test.Assert(t, status.OCSPLastUpdated.IsZero(), "ocspLastUpdated should be zeroed when no response is stored")
+
+	var serials []string
+	_, err = sa.dbMap.Select(&serials, "SELECT serial FROM revocationEvents")
+	test.AssertNotError(t, err, "Failed to select revocation events")
+	test.AssertDeepEquals(t, serials, []string{serial})
+
+	// A failed revocation doesn't queue another event.
+	err = sa.RevokeCertificate(context.Background(), &sapb.RevokeCertificateRequest{
+		Serial: serial,
+		Date:   fc.Now().UnixNano(),
+		Reason: 1,
+	})
+	test.AssertError(t, err, "RevokeCertificate should've failed when certificate already revoked")
+	count, err := sa.dbMap.SelectInt("SELECT COUNT(*) FROM revocationEvents")
+	test.AssertNotError(t, err, "Failed to count revocation events")
+	test.AssertEquals(t, count, int64(1))
+}
+
 func TestAddCertificateRenewalBit(t *testing.T) {
 	sa, fc, cleanUp := initSA(t)
 	defer cleanUp()
@@ -2306,3 +2711,313 @@ func TestBlockedKeyRevokedBy(t *testing.T) {
 	})
 	test.AssertNotError(t, err, "AddBlockedKey failed")
 }
+
+// fakeRevokedCertsStream collects the RevokedCerts sent by GetRevokedCerts.
+type fakeRevokedCertsStream struct {
+	grpc.ServerStream
+	sent []*sapb.RevokedCert
+}
+
+func (s *fakeRevokedCertsStream) Context() context.Context {
+	return ctx
+}
+
+func (s *fakeRevokedCertsStream) Send(rc *sapb.RevokedCert) error {
+	s.sent = append(s.sent, rc)
+	return nil
+}
+
+func TestGetRevokedCerts(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	reg := satest.CreateWorkingRegistration(t, sa)
+	certDER, err := ioutil.ReadFile("www.eff.org.der")
+	test.AssertNotError(t, err, "Couldn't read example cert DER")
+	cert, err := x509.ParseCertificate(certDER)
+	test.AssertNotError(t, err, "Couldn't parse example cert DER")
+	_, err = sa.AddPrecertificate(ctx, &sapb.AddCertificateRequest{
+		Der:      certDER,
+		RegID:    reg.ID,
+		Issued:   sa.clk.Now().UnixNano(),
+		IssuerID: 1,
+	})
+	test.AssertNotError(t, err, "Couldn't add www.eff.org.der")
+	serial := core.SerialToString(cert.SerialNumber)
+
+	request := &sapb.GetRevokedCertsRequest{
+		IssuerID:      1,
+		ExpiresAfter:  cert.NotAfter.Add(-time.Hour).UnixNano(),
+		ExpiresBefore: cert.NotAfter.Add(time.Hour).UnixNano(),
+	}
+
+	// The certificate isn't revoked yet, so nothing should be returned.
+	stream := &fakeRevokedCertsStream{}
+	err = sa.GetRevokedCerts(request, stream)
+	test.AssertNotError(t, err, "GetRevokedCerts failed")
+	test.AssertEquals(t, len(stream.sent), 0)
+
+	revokedAt := fc.Now()
+	err = sa.RevokeCertificate(ctx, &sapb.RevokeCertificateRequest{
+		Serial: serial,
+		Date:   revokedAt.UnixNano(),
+		Reason: 1,
+	})
+	test.AssertNotError(t, err, "RevokeCertificate failed")
+
+	stream = &fakeRevokedCertsStream{}
+	err = sa.GetRevokedCerts(request, stream)
+	test.AssertNotError(t, err, "GetRevokedCerts failed")
+	test.AssertEquals(t, len(stream.sent), 1)
+	test.AssertEquals(t, stream.sent[0].Serial, serial)
+	test.AssertEquals(t, stream.sent[0].Reason, int64(1))
+	test.AssertEquals(t, stream.sent[0].RevokedDate, revokedAt.UnixNano())
+	test.AssertEquals(t, stream.sent[0].NotAfter, cert.NotAfter.UnixNano())
+
+	// Certificates revoked after the given time must not be returned.
+	stream = &fakeRevokedCertsStream{}
+	err = sa.GetRevokedCerts(&sapb.GetRevokedCertsRequest{
+		IssuerID:      1,
+		ExpiresAfter:  request.ExpiresAfter,
+		ExpiresBefore: request.ExpiresBefore,
+		RevokedBefore: revokedAt.Add(-time.Second).UnixNano(),
+	}, stream)
+	test.AssertNotError(t, err, "GetRevokedCerts failed")
+	test.AssertEquals(t, len(stream.sent), 0)
+	stream = &fakeRevokedCertsStream{}
+	err = sa.GetRevokedCerts(&sapb.GetRevokedCertsRequest{
+		IssuerID:      1,
+		ExpiresAfter:  request.ExpiresAfter,
+		ExpiresBefore: request.ExpiresBefore,
+		RevokedBefore: revokedAt.UnixNano(),
+	}, stream)
+	test.AssertNotError(t, err, "GetRevokedCerts failed")
+	test.AssertEquals(t, len(stream.sent), 1)
+
+	// Certificates from other issuers or expiring outside of the window must
+	// not be returned.
+	stream = &fakeRevokedCertsStream{}
+	err = sa.GetRevokedCerts(&sapb.GetRevokedCertsRequest{
+		IssuerID:      2,
+		ExpiresAfter:  request.ExpiresAfter,
+		ExpiresBefore: request.ExpiresBefore,
+	}, stream)
+	test.AssertNotError(t, err, "GetRevokedCerts failed")
+	test.AssertEquals(t, len(stream.sent), 0)
+
+	stream = &fakeRevokedCertsStream{}
+	err = sa.GetRevokedCerts(&sapb.GetRevokedCertsRequest{
+		IssuerID:      1,
+		ExpiresAfter:  cert.NotAfter.Add(time.Second).UnixNano(),
+		ExpiresBefore: request.ExpiresBefore,
+	}, stream)
+	test.AssertNotError(t, err, "GetRevokedCerts failed")
+	test.AssertEquals(t, len(stream.sent), 0)
+
+	stream = &fakeRevokedCertsStream{}
+	err = sa.GetRevokedCerts(&sapb.GetRevokedCertsRequest{
+		IssuerID:      1,
+		ExpiresAfter:  request.ExpiresAfter,
+		ExpiresBefore: cert.NotAfter.UnixNano(),
+	}, stream)
+	test.AssertNotError(t, err, "GetRevokedCerts failed")
+	test.AssertEquals(t, len(stream.sent), 0)
+
+	// Without an end to the window, every later expiry is returned.
+	stream = &fakeRevokedCertsStream{}
+	err = sa.GetRevokedCerts(&sapb.GetRevokedCertsRequest{
+		IssuerID:     1,
+		ExpiresAfter: request.ExpiresAfter,
+	}, stream)
+	test.AssertNotError(t, err, "GetRevokedCerts failed")
+	test.AssertEquals(t, len(stream.sent), 1)
+
+	err = sa.GetRevokedCerts(&sapb.GetRevokedCertsRequest{IssuerID: 1}, &fakeRevokedCertsStream{})
+	test.AssertError(t, err, "GetRevokedCerts should fail without the start of an expiry window")
+}
+
+func TestGetRevokedCertsByShard(t *testing.T) {
+	if os.Getenv("BOULDER_CONFIG_DIR") != "test/config-next" {
+		t.Skip("the crlShard column only exists in the config-next schema")
+	}
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	err := features.Set(map[string]bool{"StoreCRLShard": true})
+	test.AssertNotError(t, err, "failed to set features")
+	defer features.Reset()
+
+	reg := satest.CreateWorkingRegistration(t, sa)
+	certDER, err := ioutil.ReadFile("www.eff.org.der")
+	test.AssertNotError(t, err, "Couldn't read example cert DER")
+	cert, err := x509.ParseCertificate(certDER)
+	test.AssertNotError(t, err, "Couldn't parse example cert DER")
+	_, err = sa.AddPrecertificate(ctx, &sapb.AddCertificateRequest{
+		Der:      certDER,
+		RegID:    reg.ID,
+		Issued:   sa.clk.Now().UnixNano(),
+		IssuerID: 1,
+		CrlShard: &sapb.CRLShard{Idx: 3},
+	})
+	test.AssertNotError(t, err, "Couldn't add www.eff.org.der")
+	serial := core.SerialToString(cert.SerialNumber)
+
+	err = sa.RevokeCertificate(ctx, &sapb.RevokeCertificateRequest{
+		Serial: serial,
+		Date:   fc.Now().UnixNano(),
+		Reason: 1,
+	})
+	test.AssertNotError(t, err, "RevokeCertificate failed")
+
+	request := &sapb.GetRevokedCertsRequest{
+		IssuerID:      1,
+		ExpiresAfter:  cert.NotAfter.Add(-time.Hour).UnixNano(),
+		ExpiresBefore: cert.NotAfter.Add(time.Hour).UnixNano(),
+		Shard:         &sapb.CRLShard{Idx: 3},
+	}
+	stream := &fakeRevokedCertsStream{}
+	err = sa.GetRevokedCerts(request, stream)
+	test.AssertNotError(t, err, "GetRevokedCerts failed")
+	test.AssertEquals(t, len(stream.sent), 1)
+	test.AssertEquals(t, stream.sent[0].Serial, serial)
+
+	// Shard zero is distinct from no shard at all.
+	request.Shard = &sapb.CRLShard{Idx: 0}
+	stream = &fakeRevokedCertsStream{}
+	err = sa.GetRevokedCerts(request, stream)
+	test.AssertNotError(t, err, "GetRevokedCerts failed")
+	test.AssertEquals(t, len(stream.sent), 0)
+}
+
+func TestAddAndGetSCTs(t *testing.T) {
+	if os.Getenv("BOULDER_CONFIG_DIR") != "test/config-next" {
+		t.Skip("the certificateSCTs table only exists in the config-next schema")
+	}
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	_, err := sa.AddSCT(ctx, &sapb.SCT{Serial: "1234"})
+	test.AssertError(t, err, "AddSCT accepted an incomplete request")
+
+	serial := "000000000000000000000000000000021bd4"
+	// SCT timestamps have millisecond precision, which must be kept.
+	timestamp := fc.Now().Truncate(time.Second).Add(123 * time.Millisecond)
+	logA := &sapb.SCT{
+		Serial:         serial,
+		LogID:          bytes.Repeat([]byte{1}, 32),
+		LogURL:         "https://a.example.com/",
+		Timestamp:      timestamp.UnixNano(),
+		Precertificate: true,
+		Sct:            []byte{1, 2, 3},
+	}
+	logB := &sapb.SCT{
+		Serial:         serial,
+		LogID:          bytes.Repeat([]byte{2}, 32),
+		LogURL:         "https://b.example.com/",
+		Timestamp:      timestamp.UnixNano(),
+		Precertificate: true,
+		Sct:            []byte{4, 5, 6},
+	}
+	for _, sct := range []*sapb.SCT{logA, logB, logA} {
+		_, err = sa.AddSCT(ctx, sct)
+		test.AssertNotError(t, err, "AddSCT failed")
+	}
+
+	scts, err := sa.GetSCTs(ctx, &sapb.Serial{Serial: serial})
+	test.AssertNotError(t, err, "GetSCTs failed")
+	test.AssertEquals(t, len(scts.Scts), 2)
+	test.AssertEquals(t, scts.Scts[0].LogURL, logA.LogURL)
+	test.AssertByteEquals(t, scts.Scts[0].LogID, logA.LogID)
+	test.AssertEquals(t, scts.Scts[0].Timestamp, logA.Timestamp)
+	test.Assert(t, scts.Scts[0].Precertificate, "SCT should be for a precertificate")
+	test.AssertByteEquals(t, scts.Scts[0].Sct, logA.Sct)
+	test.AssertEquals(t, scts.Scts[1].LogURL, logB.LogURL)
+
+	scts, err = sa.GetSCTs(ctx, &sapb.Serial{Serial: "nope"})
+	test.AssertNotError(t, err, "GetSCTs failed")
+	test.AssertEquals(t, len(scts.Scts), 0)
+
+	_, err = sa.GetRecentSCTs(ctx, &sapb.GetRecentSCTsRequest{})
+	test.AssertError(t, err, "GetRecentSCTs accepted a request with no limit")
+
+	recent, err := sa.GetRecentSCTs(ctx, &sapb.GetRecentSCTsRequest{Since: timestamp.UnixNano(), Limit: 1})
+	test.AssertNotError(t, err, "GetRecentSCTs failed")
+	test.AssertEquals(t, len(recent.Scts), 1)
+	test.AssertEquals(t, recent.Scts[0].LogURL, logA.LogURL)
+
+	recent, err = sa.GetRecentSCTs(ctx, &sapb.GetRecentSCTsRequest{AfterID: recent.Scts[0].Id, Since: timestamp.UnixNano(), Limit: 10})
+	test.AssertNotError(t, err, "GetRecentSCTs failed")
+	test.AssertEquals(t, len(recent.Scts), 1)
+	test.AssertEquals(t, recent.Scts[0].LogURL, logB.LogURL)
+
+	recent, err = sa.GetRecentSCTs(ctx, &sapb.GetRecentSCTsRequest{Since: timestamp.Add(time.Second).UnixNano(), Limit: 10})
+	test.AssertNotError(t, err, "GetRecentSCTs failed")
+	test.AssertEquals(t, len(recent.Scts), 0)
+
+	// Paging starts from the first SCT at or after the given time.
+	logC := &sapb.SCT{
+		Serial:         serial,
+		LogID:          bytes.Repeat([]byte{3}, 32),
+		LogURL:         "https://c.example.com/",
+		Timestamp:      timestamp.Add(time.Minute).UnixNano(),
+		Precertificate: true,
+		Sct:            []byte{7, 8, 9},
+	}
+	_, err = sa.AddSCT(ctx, logC)
+	test.AssertNotError(t, err, "AddSCT failed")
+	recent, err = sa.GetRecentSCTs(ctx, &sapb.GetRecentSCTsRequest{Since: timestamp.Add(time.Second).UnixNano(), Limit: 10})
+	test.AssertNotError(t, err, "GetRecentSCTs failed")
+	test.AssertEquals(t, len(recent.Scts), 1)
+	test.AssertEquals(t, recent.Scts[0].LogURL, logC.LogURL)
+}
+
+func TestFinalCertSubmissionQueue(t *testing.T) {
+	if os.Getenv("BOULDER_CONFIG_DIR") != "test/config-next" {
+		t.Skip("the finalCertSubmissions table only exists in the config-next schema")
+	}
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	_, err := sa.AddFinalCertSubmissions(ctx, &sapb.AddFinalCertSubmissionsRequest{Serial: "1234"})
+	test.AssertError(t, err, "AddFinalCertSubmissions accepted a request with no logs")
+
+	serial := "000000000000000000000000000000021bd4"
+	_, err = sa.AddFinalCertSubmissions(ctx, &sapb.AddFinalCertSubmissionsRequest{
+		Serial: serial,
+		Logs: []*sapb.CTLog{
+			{Url: "https://a.example.com/", PublicKey: "a"},
+			{Url: "https://b.example.com/", PublicKey: "b"},
+		},
+	})
+	test.AssertNotError(t, err, "AddFinalCertSubmissions failed")
+
+	batch, err := sa.GetFinalCertSubmissions(ctx, &sapb.GetFinalCertSubmissionsRequest{Limit: 10})
+	test.AssertNotError(t, err, "GetFinalCertSubmissions failed")
+	test.AssertEquals(t, len(batch.Submissions), 2)
+	test.AssertEquals(t, batch.Backlog, int64(2))
+	test.AssertEquals(t, batch.OldestCreated, fc.Now().Truncate(time.Second).UnixNano())
+	test.AssertEquals(t, batch.Submissions[0].Serial, serial)
+
+	// A rescheduled submission isn't due again until its next attempt.
+	first, second := batch.Submissions[0], batch.Submissions[1]
+	_, err = sa.RescheduleFinalCertSubmission(ctx, &sapb.FinalCertSubmission{
+		Id:          first.Id,
+		Attempts:    1,
+		NextAttempt: fc.Now().Add(time.Hour).UnixNano(),
+	})
+	test.AssertNotError(t, err, "RescheduleFinalCertSubmission failed")
+	_, err = sa.RemoveFinalCertSubmission(ctx, &sapb.FinalCertSubmissionID{Id: second.Id})
+	test.AssertNotError(t, err, "RemoveFinalCertSubmission failed")
+
+	batch, err = sa.GetFinalCertSubmissions(ctx, &sapb.GetFinalCertSubmissionsRequest{Limit: 10})
+	test.AssertNotError(t, err, "GetFinalCertSubmissions failed")
+	test.AssertEquals(t, len(batch.Submissions), 0)
+	test.AssertEquals(t, batch.Backlog, int64(1))
+
+	fc.Add(2 * time.Hour)
+	batch, err = sa.GetFinalCertSubmissions(ctx, &sapb.GetFinalCertSubmissionsRequest{Limit: 10})
+	test.AssertNotError(t, err, "GetFinalCertSubmissions failed")
+	test.AssertEquals(t, len(batch.Submissions), 1)
+	test.AssertEquals(t, batch.Submissions[0].Id, first.Id)
+	test.AssertEquals(t, batch.Submissions[0].Attempts, int64(1))
+}
@@ -0,0 +1,235 @@
+// Package fakelog is an in-memory stand-in for a CT log's Merkle tree. It
+// merges the entries it's given into a tree as soon as they're added, and
+// serves the RFC 6962 get-sth and get-proof-by-hash endpoints for that tree.
+// It backs ct-test-srv, and lets tests exercise CT auditing without a real
+// log.
+package fakelog
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	ct "github.com/google/certificate-transparency-go"
+	cttls "github.com/google/certificate-transparency-go/tls"
+	"github.com/jmhodges/clock"
+)
+
+// Log is a CT log's Merkle tree of leaf hashes, along with the key the log
+// signs its tree heads with.
+type Log struct {
+	sync.Mutex
+	key *ecdsa.PrivateKey
+	clk clock.Clock
+	// leaves are the leaf hashes of the merged entries, in order.
+	leaves [][sha256.Size]byte
+	// index maps each leaf hash to its position in leaves.
+	index map[[sha256.Size]byte]int
+	// withhold, if set, makes the log drop entries instead of merging them,
+	// as a log which breaks its MMD would.
+	withhold bool
+}
+
+// New returns an empty Log which signs its tree heads with the given key.
+func New(key *ecdsa.PrivateKey, clk clock.Clock) *Log {
+	return &Log{
+		key:   key,
+		clk:   clk,
+		index: make(map[[sha256.Size]byte]int),
+	}
+}
+
+// Withhold sets whether entries added from now on are dropped rather than
+// merged into the tree.
+func (l *Log) Withhold(withhold bool) {
+	l.Lock()
+	defer l.Unlock()
+	l.withhold = withhold
+}
+
+// Add merges the entry for a certificate or precertificate chain, as
+// submitted to add-chain or add-pre-chain, into the tree. The timestamp is
+// that of the SCT the log issued for it, in milliseconds.
+func (l *Log) Add(chain []ct.ASN1Cert, precert bool, timestamp uint64) error {
+	etype := ct.X509LogEntryType
+	if precert {
+		etype = ct.PrecertLogEntryType
+	}
+	leaf, err := ct.MerkleTreeLeafFromRawChain(chain, etype, timestamp)
+	if err != nil {
+		return err
+	}
+	hash, err := ct.LeafHashForLeaf(leaf)
+	if err != nil {
+		return err
+	}
+	l.AddLeafHash(hash)
+	return nil
+}
+
+// AddLeafHash merges a leaf hash into the tree. Adding a leaf which is
+// already in the tree has no effect, as with a real log.
+func (l *Log) AddLeafHash(hash [sha256.Size]byte) {
+	l.Lock()
+	defer l.Unlock()
+	if l.withhold {
+		return
+	}
+	if _, ok := l.index[hash]; ok {
+		return
+	}
+	l.index[hash] = len(l.leaves)
+	l.leaves = append(l.leaves, hash)
+}
+
+// STH returns a signed tree head for the current tree.
+func (l *Log) STH() (*ct.SignedTreeHead, error) {
+	l.Lock()
+	defer l.Unlock()
+	sth := &ct.SignedTreeHead{
+		Version:        ct.V1,
+		TreeSize:       uint64(len(l.leaves)),
+		Timestamp:      uint64(l.clk.Now().UnixNano() / int64(time.Millisecond)),
+		SHA256RootHash: ct.SHA256Hash(rootHash(l.leaves)),
+	}
+	input, err := ct.SerializeSTHSignatureInput(*sth)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := cttls.CreateSignature(*l.key, cttls.SHA256, input)
+	if err != nil {
+		return nil, err
+	}
+	sth.TreeHeadSignature = ct.DigitallySigned(sig)
+	return sth, nil
+}
+
+// InclusionProof returns the index of the given leaf hash, and the audit path
+// proving its inclusion in the tree of the given size. It returns an error if
+// the leaf isn't among the first treeSize leaves.
+func (l *Log) InclusionProof(hash [sha256.Size]byte, treeSize uint64) (int, [][sha256.Size]byte, error) {
+	l.Lock()
+	defer l.Unlock()
+	if treeSize > uint64(len(l.leaves)) {
+		return 0, nil, fmt.Errorf("tree size %d is larger than the tree (%d)", treeSize, len(l.leaves))
+	}
+	idx, ok := l.index[hash]
+	if !ok || uint64(idx) >= treeSize {
+		return 0, nil, fmt.Errorf("leaf hash %x not found in tree of size %d", hash, treeSize)
+	}
+	return idx, auditPath(idx, l.leaves[:treeSize]), nil
+}
+
+// GetSTH serves get-sth.
+func (l *Log) GetSTH(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		http.NotFound(w, r)
+		return
+	}
+	sth, err := l.STH()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sig, err := cttls.Marshal(sth.TreeHeadSignature)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, ct.GetSTHResponse{
+		TreeSize:          sth.TreeSize,
+		Timestamp:         sth.Timestamp,
+		SHA256RootHash:    sth.SHA256RootHash[:],
+		TreeHeadSignature: sig,
+	})
+}
+
+// GetProofByHash serves get-proof-by-hash. Like a real log, it responds 404
+// Not Found for a leaf hash which isn't in the requested tree.
+func (l *Log) GetProofByHash(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		http.NotFound(w, r)
+		return
+	}
+	hashBytes, err := base64.StdEncoding.DecodeString(r.URL.Query().Get("hash"))
+	if err != nil || len(hashBytes) != sha256.Size {
+		http.Error(w, "invalid hash", http.StatusBadRequest)
+		return
+	}
+	treeSize, err := strconv.ParseUint(r.URL.Query().Get("tree_size"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid tree_size", http.StatusBadRequest)
+		return
+	}
+	var hash [sha256.Size]byte
+	copy(hash[:], hashBytes)
+	idx, path, err := l.InclusionProof(hash, treeSize)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	resp := ct.GetProofByHashResponse{LeafIndex: int64(idx)}
+	for _, node := range path {
+		node := node
+		resp.AuditPath = append(resp.AuditPath, node[:])
+	}
+	writeJSON(w, resp)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// hashChildren returns the hash of an interior node with the given children.
+func hashChildren(left, right [sha256.Size]byte) [sha256.Size]byte {
+	return sha256.Sum256(append(append([]byte{1}, left[:]...), right[:]...))
+}
+
+// split returns the largest power of two smaller than n, which must be at
+// least 2.
+func split(n int) int {
+	k := 1
+	for k<<1 < n {
+		k <<= 1
+	}
+	return k
+}
+
+// rootHash computes the Merkle tree hash of the leaves, as defined in RFC
+// 6962 section 2.1.
+func rootHash(leaves [][sha256.Size]byte) [sha256.Size]byte {
+	switch len(leaves) {
+	case 0:
+		return sha256.Sum256(nil)
+	case 1:
+		return leaves[0]
+	}
+	k := split(len(leaves))
+	return hashChildren(rootHash(leaves[:k]), rootHash(leaves[k:]))
+}
+
+// auditPath computes the audit path of the leaf at index m, as defined in RFC
+// 6962 section 2.1.1.
+func auditPath(m int, leaves [][sha256.Size]byte) [][sha256.Size]byte {
+	if len(leaves) <= 1 {
+		return nil
+	}
+	k := split(len(leaves))
+	if m < k {
+		return append(auditPath(m, leaves[:k]), rootHash(leaves[k:]))
+	}
+	return append(auditPath(m-k, leaves[k:]), rootHash(leaves[:k]))
+}
@@ -1,6 +1,6 @@
 // This is a test server that implements the subset of RFC6962 APIs needed to
-// run Boulder's CT log submission code. Currently it only implements add-chain.
-// This is used by startservers.py.
+// run Boulder's CT log submission and auditing code: add-chain, add-pre-chain,
+// get-sth and get-proof-by-hash. This is used by startservers.py.
 package main
 
 import (
@@ -17,8 +17,12 @@ import (
 	"sync"
 	"time"
 
+	ct "github.com/google/certificate-transparency-go"
+	"github.com/jmhodges/clock"
+
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/publisher"
+	"github.com/letsencrypt/boulder/test/ct-test-srv/fakelog"
 )
 
 type ctSubmissionRequest struct {
@@ -36,6 +40,8 @@ type integrationSrv struct {
 	key             *ecdsa.PrivateKey
 	latencySchedule []float64
 	latencyItem     int
+	// log is the Merkle tree which every accepted submission is merged into.
+	log *fakelog.Log
 }
 
 func readJSON(w http.ResponseWriter, r *http.Request, output interface{}) error {
@@ -147,6 +153,16 @@ func (is *integrationSrv) addChainOrPre(w http.ResponseWriter, r *http.Request,
 	}
 	hostnames := strings.Join(cert.DNSNames, ",")
 
+	chain := make([]ct.ASN1Cert, len(addChainReq.Chain))
+	for i, str := range addChainReq.Chain {
+		der, err := base64.StdEncoding.DecodeString(str)
+		if err != nil {
+			w.WriteHeader(400)
+			return
+		}
+		chain[i] = ct.ASN1Cert{Data: der}
+	}
+
 	for _, h := range cert.DNSNames {
 		if is.shouldReject(h, addChainReq.Chain[0]) {
 			w.WriteHeader(400)
@@ -165,8 +181,14 @@ func (is *integrationSrv) addChainOrPre(w http.ResponseWriter, r *http.Request,
 		is.Unlock()
 		time.Sleep(sleepTime)
 	}
+	timestamp := time.Now()
+	err = is.log.Add(chain, precert, uint64(timestamp.UnixNano()/int64(time.Millisecond)))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 	w.WriteHeader(http.StatusOK)
-	w.Write(publisher.CreateTestingSignedSCT(addChainReq.Chain, is.key, precert, time.Now()))
+	w.Write(publisher.CreateTestingSignedSCT(addChainReq.Chain, is.key, precert, timestamp))
 }
 
 func (is *integrationSrv) getSubmissions(w http.ResponseWriter, r *http.Request) {
@@ -218,11 +240,14 @@ func runPersonality(p Personality) {
 		latencySchedule: p.LatencySchedule,
 		submissions:     make(map[string]int64),
 		rejectHosts:     make(map[string]bool),
+		log:             fakelog.New(key, clock.New()),
 	}
 	m := http.NewServeMux()
 	m.HandleFunc("/submissions", is.getSubmissions)
 	m.HandleFunc("/ct/v1/add-pre-chain", is.addPreChain)
 	m.HandleFunc("/ct/v1/add-chain", is.addChain)
+	m.HandleFunc("/ct/v1/get-sth", is.log.GetSTH)
+	m.HandleFunc("/ct/v1/get-proof-by-hash", is.log.GetProofByHash)
 	m.HandleFunc("/add-reject-host", is.addRejectHost)
 	m.HandleFunc("/get-rejections", is.getRejections)
 	srv := &http.Server{
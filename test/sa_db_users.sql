@@ -32,6 +32,10 @@ GRANT SELECT,INSERT ON precertificates TO 'sa'@'localhost';
 GRANT SELECT,INSERT ON keyHashToSerial TO 'sa'@'localhost';
 GRANT SELECT,INSERT ON blockedKeys TO 'sa'@'localhost';
 GRANT SELECT,INSERT,UPDATE ON newOrdersRL TO 'sa'@'localhost';
+GRANT SELECT,INSERT,UPDATE ON externalAccountKeys TO 'sa'@'localhost';
+GRANT INSERT ON revocationEvents TO 'sa'@'localhost';
+GRANT SELECT,INSERT ON certificateSCTs TO 'sa'@'localhost';
+GRANT SELECT,INSERT,UPDATE,DELETE ON finalCertSubmissions TO 'sa'@'localhost';
 
 -- OCSP Responder
 GRANT SELECT ON certificateStatus TO 'ocsp_resp'@'localhost';
@@ -40,6 +44,7 @@ GRANT SELECT ON certificateStatus TO 'ocsp_resp'@'localhost';
 GRANT SELECT ON certificates TO 'ocsp_update'@'localhost';
 GRANT SELECT,UPDATE ON certificateStatus TO 'ocsp_update'@'localhost';
 GRANT SELECT ON precertificates TO 'ocsp_update'@'localhost';
+GRANT SELECT,UPDATE,DELETE ON revocationEvents TO 'ocsp_update'@'localhost';
 
 -- Revoker Tool
 GRANT SELECT ON registrations TO 'revoker'@'localhost';
@@ -41,11 +41,11 @@ SERVICES = (
     Service('boulder-publisher-1',
         8009, 'publisher1.boulder:9091',
         ('./bin/boulder-publisher', '--config', os.path.join(config_dir, 'publisher.json'), '--addr', 'publisher1.boulder:9091', '--debug-addr', ':8009'),
-        ('sd-test-srv',)),
+        ('sd-test-srv', 'boulder-sa-1', 'boulder-sa-2')),
     Service('boulder-publisher-2',
         8109, 'publisher2.boulder:9091',
         ('./bin/boulder-publisher', '--config', os.path.join(config_dir, 'publisher.json'), '--addr', 'publisher2.boulder:9091', '--debug-addr', ':8109'),
-        ('sd-test-srv',)),
+        ('sd-test-srv', 'boulder-sa-1', 'boulder-sa-2')),
     Service('mail-test-srv',
         9380, None,
         ('./bin/mail-test-srv', '--closeFirst', '5', '--cert', 'test/mail-test-srv/localhost/cert.pem', '--key', 'test/mail-test-srv/localhost/key.pem'),
@@ -64,11 +64,11 @@ SERVICES = (
         ('sd-test-srv', 'boulder-remoteva-a', 'boulder-remoteva-b')),
     Service('boulder-ca-a',
         8001, 'ca1.boulder:9093',
-        ('./bin/boulder-ca', '--config', os.path.join(config_dir, 'ca-a.json'), '--ca-addr', 'ca1.boulder:9093', '--ocsp-addr', 'ca1.boulder:9096', '--debug-addr', ':8001'),
+        ('./bin/boulder-ca', '--config', os.path.join(config_dir, 'ca-a.json'), '--ca-addr', 'ca1.boulder:9093', '--ocsp-addr', 'ca1.boulder:9096', '--crl-addr', 'ca1.boulder:9106', '--debug-addr', ':8001'),
         ('sd-test-srv', 'boulder-sa-1', 'boulder-sa-2')),
     Service('boulder-ca-b',
         8101, 'ca2.boulder:9093',
-        ('./bin/boulder-ca', '--config', os.path.join(config_dir, 'ca-b.json'), '--ca-addr', 'ca2.boulder:9093', '--ocsp-addr', 'ca2.boulder:9096', '--debug-addr', ':8101'),
+        ('./bin/boulder-ca', '--config', os.path.join(config_dir, 'ca-b.json'), '--ca-addr', 'ca2.boulder:9093', '--ocsp-addr', 'ca2.boulder:9096', '--crl-addr', 'ca2.boulder:9106', '--debug-addr', ':8101'),
         ('sd-test-srv', 'boulder-sa-1', 'boulder-sa-2')),
     Service('akamai-test-srv',
         6789, None,
@@ -81,7 +81,7 @@ SERVICES = (
     Service('ocsp-updater',
         8006, None,
         ('./bin/ocsp-updater', '--config', os.path.join(config_dir, 'ocsp-updater.json')),
-        ('boulder-ca-a', 'boulder-ca-b')),
+        ('boulder-ca-a', 'boulder-ca-b', 'akamai-purger')),
     Service('boulder-ra-1',
         8002, 'ra1.boulder:9094',
         ('./bin/boulder-ra', '--config', os.path.join(config_dir, 'ra.json'), '--addr', 'ra1.boulder:9094', '--debug-addr', ':8002'),
@@ -116,6 +116,14 @@ SERVICES = (
         None),
 )
 
+if CONFIG_NEXT:
+    SERVICES += (
+        Service('crl-updater',
+            8021, None,
+            ('./bin/crl-updater', '--config', os.path.join(config_dir, 'crl-updater.json')),
+            ('boulder-ca-a', 'boulder-ca-b', 'boulder-sa-1', 'boulder-sa-2')),
+    )
+
 def _service_toposort(services):
     """Yields Service objects in topologically sorted order.
 
@@ -139,6 +139,18 @@ def test_tls_alpn_challenge_dns_err():
     """
     check_challenge_dns_err("tls-alpn-01")
 
+def test_dnssec_bogus():
+    """
+    test_dnssec_bogus tests that the VA refuses to validate a domain whose DNS
+    answers fail DNSSEC validation. config-next's VAs have a trust anchor for
+    dnssec-bogus.xyz, which the challenge test server doesn't sign.
+    """
+    if not CONFIG_NEXT:
+        return
+    d = "rand.%x.dnssec-bogus.xyz" % random.randrange(2**32)
+    chisel2.expect_problem("urn:ietf:params:acme:error:dns",
+        lambda: chisel2.auth_and_issue([d], chall_type="dns-01"))
+
 def test_http_challenge_broken_redirect():
     """
     test_http_challenge_broken_redirect tests that a common webserver
@@ -1295,6 +1307,37 @@ def test_blocked_key_cert():
     if testPass is False:
         raise(Exception("expected cert creation to fail with Error when using blocked key"))
 
+def test_crl_updater():
+    # Only config-next runs the crl-updater.
+    if not CONFIG_NEXT:
+        return
+
+    issuers = []
+    for path in ("/tmp/intermediate-cert-rsa-a.pem", "/tmp/intermediate-cert-rsa-b.pem"):
+        with open(path, "rb") as f:
+            issuers.append(x509.load_pem_x509_certificate(f.read(), default_backend()))
+
+    # The crl-updater generates every shard of every issuer when it starts,
+    # and each CA serves the CRLs of both issuers.
+    expected = len(issuers) * 10
+    for _ in range(40):
+        paths = [os.path.join(d, f) for d, _, files in os.walk("/tmp/crls")
+                 for f in files if f.endswith(".crl")]
+        if len(paths) >= expected:
+            break
+        time.sleep(0.25)
+    else:
+        raise(Exception("timed out waiting for %d CRLs, found %d" % (expected, len(paths))))
+
+    for path in paths:
+        with open(path, "rb") as f:
+            crl = x509.load_der_x509_crl(f.read(), default_backend())
+        signers = [i for i in issuers if i.subject == crl.issuer]
+        if len(signers) != 1:
+            raise(Exception("CRL %s has unknown issuer %s" % (path, crl.issuer)))
+        if not crl.is_signature_valid(signers[0].public_key()):
+            raise(Exception("CRL %s has an invalid signature" % path))
+
 def test_expiration_mailer():
     email_addr = "integration.%x@letsencrypt.org" % random.randrange(2**16)
     order = chisel2.auth_and_issue([random_domain()], email=email_addr)
@@ -20,10 +20,7 @@ type caaParams struct {
 }
 
 func (va *ValidationAuthorityImpl) IsCAAValid(ctx context.Context, req *vapb.IsCAAValidRequest) (*vapb.IsCAAValidResponse, error) {
-	acmeID := identifier.ACMEIdentifier{
-		Type:  identifier.DNS,
-		Value: req.Domain,
-	}
+	acmeID := identifier.FromString(req.Domain)
 	params := &caaParams{
 		accountURIID:     req.AccountURIID,
 		validationMethod: req.ValidationMethod,
@@ -43,9 +40,14 @@ func (va *ValidationAuthorityImpl) IsCAAValid(ctx context.Context, req *vapb.IsC
 // the CAA lookup & validation fail a problem is returned.
 func (va *ValidationAuthorityImpl) checkCAA(
 	ctx context.Context,
-	identifier identifier.ACMEIdentifier,
+	ident identifier.ACMEIdentifier,
 	params *caaParams) *probs.ProblemDetails {
-	present, valid, response, err := va.checkCAARecords(ctx, identifier, params)
+	// CAA records live in the DNS, so there is nothing to check for an IP
+	// address identifier.
+	if ident.Type == identifier.IP {
+		return nil
+	}
+	present, valid, response, err := va.checkCAARecords(ctx, ident, params)
 	if err != nil {
 		return probs.DNS(err.Error())
 	}
@@ -59,9 +61,9 @@ func (va *ValidationAuthorityImpl) checkCAA(
 	}
 
 	va.log.AuditInfof("Checked CAA records for %s, [Present: %t, Account ID: %s, Challenge: %s, Valid for issuance: %t] Response=%q",
-		identifier.Value, present, accountID, validationMethod, valid, response)
+		ident.Value, present, accountID, validationMethod, valid, response)
 	if !valid {
-		return probs.CAA(fmt.Sprintf("CAA record for %s prevents issuance", identifier.Value))
+		return probs.CAA(fmt.Sprintf("CAA record for %s prevents issuance", ident.Value))
 	}
 	return nil
 }
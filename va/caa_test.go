@@ -11,6 +11,7 @@ import (
 
 	"github.com/miekg/dns"
 
+	"github.com/letsencrypt/boulder/bdns"
 	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/features"
 	"github.com/letsencrypt/boulder/identifier"
@@ -25,13 +26,13 @@ import (
 // answers for CAA queries.
 type caaMockDNS struct{}
 
-func (mock caaMockDNS) LookupTXT(_ context.Context, hostname string) ([]string, error) {
-	return nil, nil
+func (mock caaMockDNS) LookupTXT(_ context.Context, hostname string) ([]string, bdns.Security, error) {
+	return nil, bdns.Unvalidated, nil
 }
 
-func (mock caaMockDNS) LookupHost(_ context.Context, hostname string) ([]net.IP, error) {
+func (mock caaMockDNS) LookupHost(_ context.Context, hostname string) ([]net.IP, bdns.Security, error) {
 	ip := net.ParseIP("127.0.0.1")
-	return []net.IP{ip}, nil
+	return []net.IP{ip}, bdns.Unvalidated, nil
 }
 
 func (mock caaMockDNS) LookupCAA(_ context.Context, domain string) ([]*dns.CAA, string, error) {
@@ -577,6 +578,20 @@ func TestIsCAAValidErrMessage(t *testing.T) {
 	test.AssertEquals(t, resp.Problem.Detail, fmt.Sprintf("While processing CAA for %s: error", domain))
 }
 
+// TestIsCAAValidIP tests that IP address identifiers are not subject to CAA
+// checking, since CAA records only exist for domain names.
+func TestIsCAAValidIP(t *testing.T) {
+	va, _ := setup(nil, 0, "", nil)
+	va.dnsClient = caaMockDNS{}
+
+	resp, err := va.IsCAAValid(ctx, &vapb.IsCAAValidRequest{
+		Domain: "93.184.216.34",
+	})
+	test.AssertNotError(t, err, "Unexpected error calling IsCAAValidRequest")
+	test.AssertNotNil(t, resp, "Response to IsCAAValidRequest was nil")
+	test.Assert(t, resp.Problem == nil, "Unexpected CAA problem for IP address")
+}
+
 func TestCAAFailure(t *testing.T) {
 	chall := createChallenge(core.ChallengeTypeHTTP01)
 	hs := httpSrv(t, chall.Token)
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net"
 
+	"github.com/letsencrypt/boulder/bdns"
 	"github.com/letsencrypt/boulder/core"
 	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/identifier"
@@ -17,20 +18,21 @@ import (
 // getAddr will query for all A/AAAA records associated with hostname and return
 // the preferred address, the first net.IP in the addrs slice, and all addresses
 // resolved. This is the same choice made by the Go internal resolution library
-// used by net/http. If there is an error resolving the hostname, or if no
-// usable IP addresses are available then a berrors.DNSError instance is
-// returned with a nil net.IP slice.
-func (va ValidationAuthorityImpl) getAddrs(ctx context.Context, hostname string) ([]net.IP, error) {
-	addrs, err := va.dnsClient.LookupHost(ctx, hostname)
+// used by net/http. The DNSSEC status of the lookup is returned alongside. If
+// there is an error resolving the hostname, or if no usable IP addresses are
+// available then a berrors.DNSError instance is returned with a nil net.IP
+// slice.
+func (va ValidationAuthorityImpl) getAddrs(ctx context.Context, hostname string) ([]net.IP, bdns.Security, error) {
+	addrs, security, err := va.dnsClient.LookupHost(ctx, hostname)
 	if err != nil {
-		return nil, berrors.DNSError("%v", err)
+		return nil, "", berrors.DNSError("%v", err)
 	}
 
 	if len(addrs) == 0 {
-		return nil, berrors.DNSError("No valid IP addresses found for %s", hostname)
+		return nil, "", berrors.DNSError("No valid IP addresses found for %s", hostname)
 	}
 	va.log.Debugf("Resolved addresses for %s: %s", hostname, addrs)
-	return addrs, nil
+	return addrs, security, nil
 }
 
 // availableAddresses takes a ValidationRecord and splits the AddressesResolved
@@ -59,7 +61,7 @@ func (va *ValidationAuthorityImpl) validateDNS01(ctx context.Context, ident iden
 
 	// Look for the required record in the DNS
 	challengeSubdomain := fmt.Sprintf("%s.%s", core.DNSPrefix, ident.Value)
-	txts, err := va.dnsClient.LookupTXT(ctx, challengeSubdomain)
+	txts, security, err := va.dnsClient.LookupTXT(ctx, challengeSubdomain)
 	if err != nil {
 		return nil, probs.DNS(err.Error())
 	}
@@ -74,7 +76,7 @@ func (va *ValidationAuthorityImpl) validateDNS01(ctx context.Context, ident iden
 	for _, element := range txts {
 		if subtle.ConstantTimeCompare([]byte(element), []byte(authorizedKeysDigest)) == 1 {
 			// Successful challenge validation
-			return []core.ValidationRecord{{Hostname: ident.Value}}, nil
+			return []core.ValidationRecord{{Hostname: ident.Value, DNSSEC: string(security)}}, nil
 		}
 	}
 
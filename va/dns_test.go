@@ -142,6 +142,7 @@ func TestDNSValidationNoServer(t *testing.T) {
 	va.dnsClient = bdns.NewTest(
 		time.Second*5,
 		nil,
+		nil,
 		metrics.NoopRegisterer,
 		clock.New(),
 		1,
@@ -160,6 +161,27 @@ func TestDNSValidationOK(t *testing.T) {
 	test.Assert(t, prob == nil, "Should be valid.")
 }
 
+// dnssecMockDNS is a mock which reports all TXT lookups as DNSSEC secure
+type dnssecMockDNS struct {
+	bdns.MockClient
+}
+
+func (mock *dnssecMockDNS) LookupTXT(ctx context.Context, hostname string) ([]string, bdns.Security, error) {
+	txts, _, err := mock.MockClient.LookupTXT(ctx, hostname)
+	return txts, bdns.Secure, err
+}
+
+func TestDNSValidationDNSSECStatus(t *testing.T) {
+	va, _ := setup(nil, 0, "", nil)
+	va.dnsClient = &dnssecMockDNS{}
+
+	records, prob := va.validateChallenge(ctx, dnsi("good-dns01.com"), dnsChallenge())
+
+	test.Assert(t, prob == nil, "Should be valid.")
+	test.AssertEquals(t, len(records), 1)
+	test.AssertEquals(t, records[0].DNSSEC, string(bdns.Secure))
+}
+
 func TestDNSValidationNoAuthorityOK(t *testing.T) {
 	va, _ := setup(nil, 0, "", nil)
 
@@ -14,6 +14,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/letsencrypt/boulder/bdns"
 	"github.com/letsencrypt/boulder/core"
 	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/iana"
@@ -173,6 +174,8 @@ type httpValidationTarget struct {
 	query string
 	// all of the IP addresses available for the host
 	available []net.IP
+	// the DNSSEC status of the lookup for available
+	dnssec bdns.Security
 	// the IP addresses that were tried for validation previously that were cycled
 	// out of cur by calls to nextIP()
 	tried []net.IP
@@ -205,7 +208,8 @@ func (vt *httpValidationTarget) ip() net.IP {
 }
 
 // newHTTPValidationTarget creates a httpValidationTarget for the given host,
-// port, and path. This involves querying DNS for the IP addresses for the host.
+// port, and path. This involves querying DNS for the IP addresses for the host,
+// unless the host is itself an IP address, in which case it is used directly.
 // An error is returned if there are no usable IP addresses or if the DNS
 // lookups fail.
 func (va *ValidationAuthorityImpl) newHTTPValidationTarget(
@@ -214,10 +218,19 @@ func (va *ValidationAuthorityImpl) newHTTPValidationTarget(
 	port int,
 	path string,
 	query string) (*httpValidationTarget, error) {
-	// Resolve IP addresses for the hostname
-	addrs, err := va.getAddrs(ctx, host)
-	if err != nil {
-		return nil, err
+	var addrs []net.IP
+	var security bdns.Security
+	if ip := net.ParseIP(host); ip != nil {
+		// IP address identifiers are validated by connecting straight to the
+		// address (RFC 8738, Section 7).
+		addrs = []net.IP{ip}
+	} else {
+		// Resolve IP addresses for the hostname
+		var err error
+		addrs, security, err = va.getAddrs(ctx, host)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	target := &httpValidationTarget{
@@ -226,6 +239,7 @@ func (va *ValidationAuthorityImpl) newHTTPValidationTarget(
 		path:      path,
 		query:     query,
 		available: addrs,
+		dnssec:    security,
 	}
 
 	// Separate the addresses into the available v4 and v6 addresses
@@ -369,6 +383,7 @@ func (va *ValidationAuthorityImpl) setupHTTPValidation(
 		Port:              strconv.Itoa(target.port),
 		AddressesResolved: target.available,
 		URL:               reqURL,
+		DNSSEC:            string(target.dnssec),
 	}
 
 	// Get the target IP to build a preresolved dialer with
@@ -436,10 +451,15 @@ func (va *ValidationAuthorityImpl) processHTTPValidation(
 		return nil, nil, err
 	}
 
-	// Create an initial GET Request
+	// Create an initial GET Request. IPv6 address hosts must be bracketed in
+	// the URL.
+	urlHost := host
+	if ip := net.ParseIP(host); ip != nil && ip.To4() == nil {
+		urlHost = "[" + host + "]"
+	}
 	initialURL := url.URL{
 		Scheme: "http",
-		Host:   host,
+		Host:   urlHost,
 		Path:   path,
 	}
 	initialReq, err := http.NewRequest("GET", initialURL.String(), nil)
@@ -626,9 +646,9 @@ func (va *ValidationAuthorityImpl) processHTTPValidation(
 }
 
 func (va *ValidationAuthorityImpl) validateHTTP01(ctx context.Context, ident identifier.ACMEIdentifier, challenge core.Challenge) ([]core.ValidationRecord, *probs.ProblemDetails) {
-	if ident.Type != identifier.DNS {
-		va.log.Infof("Got non-DNS identifier for HTTP validation: %s", ident)
-		return nil, probs.Malformed("Identifier type for HTTP validation was not DNS")
+	if ident.Type != identifier.DNS && ident.Type != identifier.IP {
+		va.log.Infof("Got non-DNS/IP identifier for HTTP validation: %s", ident)
+		return nil, probs.Malformed("Identifier type for HTTP validation was not DNS or IP")
 	}
 
 	// Perform the fetch
@@ -1243,13 +1243,24 @@ func TestHTTP(t *testing.T) {
 	test.AssertEquals(t, len(matchedValidRedirect), 1)
 	test.AssertEquals(t, len(matchedMovedRedirect), 1)
 
-	ipIdentifier := identifier.ACMEIdentifier{Type: identifier.IdentifierType("ip"), Value: "127.0.0.1"}
-	_, prob = va.validateHTTP01(ctx, ipIdentifier, chall)
+	unknownIdentifier := identifier.ACMEIdentifier{Type: identifier.IdentifierType("nickname"), Value: "cpu"}
+	_, prob = va.validateHTTP01(ctx, unknownIdentifier, chall)
 	if prob == nil {
-		t.Fatalf("IdentifierType IP shouldn't have worked.")
+		t.Fatalf("Unknown IdentifierType shouldn't have worked.")
 	}
 	test.AssertEquals(t, prob.Type, probs.MalformedProblem)
 
+	// IP identifiers are validated by connecting straight to the address,
+	// without any DNS lookups.
+	setChallengeToken(&chall, expectedToken)
+	records, prob := va.validateHTTP01(ctx, identifier.IPIdentifier(net.ParseIP("127.0.0.1")), chall)
+	if prob != nil {
+		t.Fatalf("Unexpected failure in HTTP validation for IP identifier: %s", prob)
+	}
+	test.AssertEquals(t, len(records), 1)
+	test.AssertEquals(t, records[0].Hostname, "127.0.0.1")
+	test.AssertEquals(t, records[0].AddressUsed.String(), "127.0.0.1")
+
 	_, prob = va.validateHTTP01(ctx, identifier.ACMEIdentifier{Type: identifier.DNS, Value: "always.invalid"}, chall)
 	if prob == nil {
 		t.Fatalf("Domain name is invalid.")
@@ -1302,8 +1313,8 @@ type dnsMockReturnsUnroutable struct {
 	*bdns.MockClient
 }
 
-func (mock dnsMockReturnsUnroutable) LookupHost(_ context.Context, hostname string) ([]net.IP, error) {
-	return []net.IP{net.ParseIP("198.51.100.1")}, nil
+func (mock dnsMockReturnsUnroutable) LookupHost(_ context.Context, hostname string) ([]net.IP, bdns.Security, error) {
+	return []net.IP{net.ParseIP("198.51.100.1")}, bdns.Unvalidated, nil
 }
 
 // TestHTTPDialTimeout tests that we give the proper "Timeout during connect"
@@ -13,9 +13,11 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/letsencrypt/boulder/bdns"
 	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/identifier"
 	"github.com/letsencrypt/boulder/probs"
+	"github.com/miekg/dns"
 )
 
 const (
@@ -47,6 +49,9 @@ func certNames(cert *x509.Certificate) []string {
 		names = append(names, cert.Subject.CommonName)
 	}
 	names = append(names, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		names = append(names, ip.String())
+	}
 	names = core.UniqueLowerNames(names)
 	// TODO(#5321): This for loop can be deleted after new builds of boulder use
 	// golang 1.16. In 1.16, code was added to crypto/x509 to not allow
@@ -58,16 +63,39 @@ func certNames(cert *x509.Certificate) []string {
 	return names
 }
 
+// tlsALPNCertMatches returns true if the given TLS-ALPN-01 challenge
+// certificate has exactly one subject alternative name, and that name is the
+// DNS name or IP address of the identifier being validated.
+func tlsALPNCertMatches(cert *x509.Certificate, ident identifier.ACMEIdentifier) bool {
+	if len(cert.DNSNames)+len(cert.IPAddresses) != 1 {
+		return false
+	}
+	if ident.Type == identifier.IP {
+		return len(cert.IPAddresses) == 1 && cert.IPAddresses[0].Equal(net.ParseIP(ident.Value))
+	}
+	return len(cert.DNSNames) == 1 && strings.EqualFold(cert.DNSNames[0], ident.Value)
+}
+
 func (va *ValidationAuthorityImpl) tryGetTLSCerts(ctx context.Context,
-	identifier identifier.ACMEIdentifier, challenge core.Challenge,
+	ident identifier.ACMEIdentifier, challenge core.Challenge,
 	tlsConfig *tls.Config) ([]*x509.Certificate, *tls.ConnectionState, []core.ValidationRecord, *probs.ProblemDetails) {
 
-	allAddrs, err := va.getAddrs(ctx, identifier.Value)
+	var allAddrs []net.IP
+	var security bdns.Security
+	var err error
+	if ident.Type == identifier.IP {
+		// IP address identifiers are validated by connecting straight to the
+		// address (RFC 8738, Section 6).
+		allAddrs = []net.IP{net.ParseIP(ident.Value)}
+	} else {
+		allAddrs, security, err = va.getAddrs(ctx, ident.Value)
+	}
 	validationRecords := []core.ValidationRecord{
 		{
-			Hostname:          identifier.Value,
+			Hostname:          ident.Value,
 			AddressesResolved: allAddrs,
 			Port:              strconv.Itoa(va.tlsPort),
+			DNSSEC:            string(security),
 		},
 	}
 	if err != nil {
@@ -81,7 +109,7 @@ func (va *ValidationAuthorityImpl) tryGetTLSCerts(ctx context.Context,
 
 	// This shouldn't happen, but be defensive about it anyway
 	if len(addresses) < 1 {
-		return nil, nil, validationRecords, probs.Malformed("no IP addresses found for %q", identifier.Value)
+		return nil, nil, validationRecords, probs.Malformed("no IP addresses found for %q", ident.Value)
 	}
 
 	// If there is at least one IPv6 address then try it first
@@ -89,7 +117,7 @@ func (va *ValidationAuthorityImpl) tryGetTLSCerts(ctx context.Context,
 		address := net.JoinHostPort(v6[0].String(), thisRecord.Port)
 		thisRecord.AddressUsed = v6[0]
 
-		certs, cs, prob := va.getTLSCerts(ctx, address, identifier, challenge, tlsConfig)
+		certs, cs, prob := va.getTLSCerts(ctx, address, ident, challenge, tlsConfig)
 
 		// If there is no problem, return immediately
 		if err == nil {
@@ -116,7 +144,7 @@ func (va *ValidationAuthorityImpl) tryGetTLSCerts(ctx context.Context,
 	// talking to the first IPv6 address, try the first IPv4 address
 	thisRecord.AddressUsed = v4[0]
 	certs, cs, prob := va.getTLSCerts(ctx, net.JoinHostPort(v4[0].String(), thisRecord.Port),
-		identifier, challenge, tlsConfig)
+		ident, challenge, tlsConfig)
 	return certs, cs, validationRecords, prob
 }
 
@@ -178,15 +206,31 @@ func (va *ValidationAuthorityImpl) tlsDial(ctx context.Context, hostPort string,
 	return conn, nil
 }
 
-func (va *ValidationAuthorityImpl) validateTLSALPN01(ctx context.Context, identifier identifier.ACMEIdentifier, challenge core.Challenge) ([]core.ValidationRecord, *probs.ProblemDetails) {
-	if identifier.Type != "dns" {
-		va.log.Info(fmt.Sprintf("Identifier type for TLS-ALPN-01 was not DNS: %s", identifier))
-		return nil, probs.Malformed("Identifier type for TLS-ALPN-01 was not DNS")
+func (va *ValidationAuthorityImpl) validateTLSALPN01(ctx context.Context, ident identifier.ACMEIdentifier, challenge core.Challenge) ([]core.ValidationRecord, *probs.ProblemDetails) {
+	var serverName string
+	switch ident.Type {
+	case identifier.DNS:
+		serverName = ident.Value
+	case identifier.IP:
+		// An IP address can't be sent as SNI, so RFC 8738 Section 6 has us send
+		// the reverse-DNS name of the address instead.
+		ip := net.ParseIP(ident.Value)
+		if ip == nil {
+			return nil, probs.Malformed("Identifier value for TLS-ALPN-01 was not a valid IP address")
+		}
+		reverseName, err := dns.ReverseAddr(ip.String())
+		if err != nil {
+			return nil, probs.Malformed("Identifier value for TLS-ALPN-01 was not a valid IP address")
+		}
+		serverName = strings.TrimSuffix(reverseName, ".")
+	default:
+		va.log.Info(fmt.Sprintf("Identifier type for TLS-ALPN-01 was not DNS or IP: %s", ident))
+		return nil, probs.Malformed("Identifier type for TLS-ALPN-01 was not DNS or IP")
 	}
 
-	certs, cs, validationRecords, problem := va.tryGetTLSCerts(ctx, identifier, challenge, &tls.Config{
+	certs, cs, validationRecords, problem := va.tryGetTLSCerts(ctx, ident, challenge, &tls.Config{
 		NextProtos: []string{ACMETLS1Protocol},
-		ServerName: identifier.Value,
+		ServerName: serverName,
 	})
 	if problem != nil {
 		return validationRecords, problem
@@ -203,15 +247,15 @@ func (va *ValidationAuthorityImpl) validateTLSALPN01(ctx context.Context, identi
 
 	leafCert := certs[0]
 
-	// Verify SNI - certificate returned must be issued only for the domain we are verifying.
-	if len(leafCert.DNSNames) != 1 || !strings.EqualFold(leafCert.DNSNames[0], identifier.Value) {
+	// Verify SNI - certificate returned must be issued only for the identifier we are verifying.
+	if !tlsALPNCertMatches(leafCert, ident) {
 		hostPort := net.JoinHostPort(validationRecords[0].AddressUsed.String(), validationRecords[0].Port)
 		names := certNames(leafCert)
 		errText := fmt.Sprintf(
 			"Incorrect validation certificate for %s challenge. "+
 				"Requested %s from %s. Received %d certificate(s), "+
 				"first certificate had names %q",
-			challenge.Type, identifier.Value, hostPort, len(certs), strings.Join(names, ", "))
+			challenge.Type, ident.Value, hostPort, len(certs), strings.Join(names, ", "))
 		return validationRecords, probs.Unauthorized(errText)
 	}
 
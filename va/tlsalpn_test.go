@@ -408,6 +408,71 @@ func TestTLSALPN01Success(t *testing.T) {
 	test.AssertEquals(t, test.CountCounterVec("oid", IdPeAcmeIdentifierV1Obsolete.String(), va.metrics.tlsALPNOIDCounter), 1)
 }
 
+func TestTLSALPN01SuccessIP(t *testing.T) {
+	chall := tlsalpnChallenge()
+
+	template := tlsCertTemplate(nil)
+	template.IPAddresses = []net.IP{net.ParseIP("127.0.0.1")}
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, template, &TheKey.PublicKey, &TheKey)
+	test.AssertNotError(t, err, "Error creating certificate")
+	cert := &tls.Certificate{
+		Certificate: [][]byte{certBytes},
+		PrivateKey:  &TheKey,
+	}
+
+	shasum := sha256.Sum256([]byte(chall.ProvidedKeyAuthorization))
+	encHash, err := asn1.Marshal(shasum[:])
+	test.AssertNotError(t, err, "Error marshalling key authorization hash")
+	template.ExtraExtensions = []pkix.Extension{{
+		Id:       IdPeAcmeIdentifier,
+		Critical: true,
+		Value:    encHash,
+	}}
+	certBytes, err = x509.CreateCertificate(rand.Reader, template, template, &TheKey.PublicKey, &TheKey)
+	test.AssertNotError(t, err, "Error creating certificate")
+	acmeCert := &tls.Certificate{
+		Certificate: [][]byte{certBytes},
+		PrivateKey:  &TheKey,
+	}
+
+	// The server only answers when the SNI is the reverse-DNS name of the IP.
+	hs := tlsalpn01SrvWithCert(t, chall, IdPeAcmeIdentifier, []string{"1.0.0.127.in-addr.arpa"}, cert, acmeCert, 0)
+	defer hs.Close()
+
+	va, _ := setup(hs, 0, "", nil)
+
+	records, prob := va.validateChallenge(ctx, identifier.IPIdentifier(net.ParseIP("127.0.0.1")), chall)
+	if prob != nil {
+		t.Fatalf("Validation failed: %v", prob)
+	}
+	test.AssertEquals(t, len(records), 1)
+	test.AssertEquals(t, records[0].AddressUsed.String(), "127.0.0.1")
+}
+
+func TestTLSALPNCertMatches(t *testing.T) {
+	ip := net.ParseIP("127.0.0.1")
+	testCases := []struct {
+		name    string
+		cert    *x509.Certificate
+		ident   identifier.ACMEIdentifier
+		matches bool
+	}{
+		{"DNS name", &x509.Certificate{DNSNames: []string{"Example.com"}}, dnsi("example.com"), true},
+		{"wrong DNS name", &x509.Certificate{DNSNames: []string{"example.net"}}, dnsi("example.com"), false},
+		{"extra DNS name", &x509.Certificate{DNSNames: []string{"example.com", "example.net"}}, dnsi("example.com"), false},
+		{"DNS name with IP", &x509.Certificate{DNSNames: []string{"example.com"}, IPAddresses: []net.IP{ip}}, dnsi("example.com"), false},
+		{"IP address", &x509.Certificate{IPAddresses: []net.IP{ip}}, identifier.IPIdentifier(ip), true},
+		{"wrong IP address", &x509.Certificate{IPAddresses: []net.IP{net.ParseIP("127.0.0.2")}}, identifier.IPIdentifier(ip), false},
+		{"IP address as DNS name", &x509.Certificate{DNSNames: []string{"127.0.0.1"}}, identifier.IPIdentifier(ip), false},
+		{"no names", &x509.Certificate{}, identifier.IPIdentifier(ip), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			test.AssertEquals(t, tlsALPNCertMatches(tc.cert, tc.ident), tc.matches)
+		})
+	}
+}
+
 func TestValidateTLSALPN01BadChallenge(t *testing.T) {
 	chall := tlsalpnChallenge()
 	chall2 := chall
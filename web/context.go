@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rsa"
 	"encoding/json"
 	"fmt"
@@ -169,6 +170,8 @@ func KeyTypeToString(pub crypto.PublicKey) string {
 		return fmt.Sprintf("RSA %d", pk.N.BitLen())
 	case *ecdsa.PublicKey:
 		return fmt.Sprintf("ECDSA %s", pk.Params().Name)
+	case ed25519.PublicKey:
+		return "Ed25519"
 	}
 	return "unknown"
 }
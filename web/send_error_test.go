@@ -65,6 +65,61 @@ func TestSendErrorSubProblemNamespace(t *testing.T) {
 	  }`)
 }
 
+func TestSendErrorSubProblemTypes(t *testing.T) {
+	rw := httptest.NewRecorder()
+	prob := ProblemDetailsForError((&berrors.BoulderError{
+		Type:   berrors.Unauthorized,
+		Detail: "bad",
+	}).WithSubErrors(
+		[]berrors.SubBoulderError{
+			{
+				Identifier: identifier.DNSIdentifier("example.com"),
+				BoulderError: &berrors.BoulderError{
+					Type:   berrors.Unauthorized,
+					Detail: "nop",
+				},
+			},
+			{
+				Identifier: identifier.DNSIdentifier("example.net"),
+				BoulderError: &berrors.BoulderError{
+					Type:   berrors.CAA,
+					Detail: "nah",
+				},
+			},
+		}),
+		"dfoop",
+	)
+	SendError(log.NewMock(), "namespace:test:", rw, &RequestEvent{}, prob, errors.New("it bad"))
+
+	// Each subproblem keeps its own type, namespaced like the top level type.
+	body := rw.Body.String()
+	test.AssertUnmarshaledEquals(t, body, `{
+		"type": "namespace:test:unauthorized",
+		"detail": "dfoop :: bad",
+		"status": 403,
+		"subproblems": [
+		  {
+			"type": "namespace:test:unauthorized",
+			"detail": "dfoop :: nop",
+			"status": 403,
+			"identifier": {
+			  "type": "dns",
+			  "value": "example.com"
+			}
+		  },
+		  {
+			"type": "namespace:test:caa",
+			"detail": "dfoop :: nah",
+			"status": 403,
+			"identifier": {
+			  "type": "dns",
+			  "value": "example.net"
+			}
+		  }
+		]
+	  }`)
+}
+
 func TestSendErrorSubProbLogging(t *testing.T) {
 	rw := httptest.NewRecorder()
 	prob := ProblemDetailsForError((&berrors.BoulderError{
@@ -254,6 +254,10 @@ func (ra *MockRegistrationAuthority) FinalizeOrder(ctx context.Context, _ *rapb.
 	return nil, nil
 }
 
+func (ra *MockRegistrationAuthority) NewPreAuthorization(ctx context.Context, _ *rapb.NewPreAuthorizationRequest) (*corepb.Authorization, error) {
+	return nil, nil
+}
+
 type mockPA struct{}
 
 func (pa *mockPA) ChallengesFor(identifier identifier.ACMEIdentifier) (challenges []core.Challenge, err error) {
@@ -0,0 +1,159 @@
+package wfe2
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/reloader"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	oidSHA1   = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	oidSHA256 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+)
+
+// certID is the ASN.1 CertID structure from RFC 6960 Section 4.1.1, which
+// clients use to identify the certificate whose renewal information they are
+// requesting.
+type certID struct {
+	HashAlgorithm  pkix.AlgorithmIdentifier
+	IssuerNameHash []byte
+	IssuerKeyHash  []byte
+	SerialNumber   *big.Int
+}
+
+// hashFunc returns the hash function identified by the certID's hash
+// algorithm, or an error if it is not one we support.
+func (id certID) hashFunc() (crypto.Hash, error) {
+	switch {
+	case id.HashAlgorithm.Algorithm.Equal(oidSHA256):
+		return crypto.SHA256, nil
+	case id.HashAlgorithm.Algorithm.Equal(oidSHA1):
+		return crypto.SHA1, nil
+	default:
+		return 0, fmt.Errorf("unsupported hash algorithm %q", id.HashAlgorithm.Algorithm)
+	}
+}
+
+// matchesIssuer returns true if the certID's issuerNameHash and issuerKeyHash
+// were computed over the given issuer's subject and public key.
+func (id certID) matchesIssuer(issuer *x509.Certificate) (bool, error) {
+	hashFunc, err := id.hashFunc()
+	if err != nil {
+		return false, err
+	}
+
+	// The issuerKeyHash is computed over the value of the subjectPublicKey BIT
+	// STRING, excluding the tag, length, and number of unused bits.
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	_, err = asn1.Unmarshal(issuer.RawSubjectPublicKeyInfo, &spki)
+	if err != nil {
+		return false, err
+	}
+
+	var nameHash, keyHash []byte
+	switch hashFunc {
+	case crypto.SHA256:
+		n := sha256.Sum256(issuer.RawSubject)
+		k := sha256.Sum256(spki.PublicKey.RightAlign())
+		nameHash, keyHash = n[:], k[:]
+	case crypto.SHA1:
+		n := sha1.Sum(issuer.RawSubject)
+		k := sha1.Sum(spki.PublicKey.RightAlign())
+		nameHash, keyHash = n[:], k[:]
+	}
+	return bytes.Equal(nameHash, id.IssuerNameHash) && bytes.Equal(keyHash, id.IssuerKeyHash), nil
+}
+
+// renewalInfoOverride pulls the suggested renewal window of the listed serials
+// to the given Start and End times.
+type renewalInfoOverride struct {
+	Serials []string  `yaml:"serials"`
+	Start   time.Time `yaml:"start"`
+	End     time.Time `yaml:"end"`
+}
+
+// renewalInfoOverridesConfig is the YAML structure of the file configured by
+// the WFE's RenewalInfoOverridesFile.
+type renewalInfoOverridesConfig struct {
+	Overrides []renewalInfoOverride `yaml:"overrides"`
+}
+
+// renewalInfoOverrides holds the operator-configured suggested windows for
+// individual serials, protected by a mutex since the file it is loaded from
+// may be reloaded at any time.
+type renewalInfoOverrides struct {
+	sync.RWMutex
+	windows map[string]core.SuggestedWindow
+}
+
+// get returns the overridden suggested window for the given serial, if any.
+func (o *renewalInfoOverrides) get(serial string) (core.SuggestedWindow, bool) {
+	o.RLock()
+	defer o.RUnlock()
+	window, ok := o.windows[serial]
+	return window, ok
+}
+
+// load is a callback suitable for use with reloader.New() that will unmarshal
+// a YAML list of renewal info overrides and replace any previously loaded
+// overrides with them.
+func (o *renewalInfoOverrides) load(contents []byte) error {
+	var config renewalInfoOverridesConfig
+	err := yaml.Unmarshal(contents, &config)
+	if err != nil {
+		return err
+	}
+	windows := make(map[string]core.SuggestedWindow)
+	for i, override := range config.Overrides {
+		if override.Start.IsZero() || override.End.IsZero() {
+			return fmt.Errorf("override %d must have both a start and an end", i)
+		}
+		if !override.End.After(override.Start) {
+			return fmt.Errorf("override %d must end after it starts", i)
+		}
+		if len(override.Serials) == 0 {
+			return fmt.Errorf("override %d has no serials", i)
+		}
+		for _, serial := range override.Serials {
+			if !core.ValidSerial(serial) {
+				return fmt.Errorf("override %d has invalid serial %q", i, serial)
+			}
+			windows[serial] = core.SuggestedWindow{
+				Start: override.Start,
+				End:   override.End,
+			}
+		}
+	}
+
+	o.Lock()
+	defer o.Unlock()
+	o.windows = windows
+	return nil
+}
+
+// SetRenewalInfoOverridesFile will load the given renewal info overrides file,
+// returning error if it fails. It will also start a reloader in case the file
+// changes.
+func (wfe *WebFrontEndImpl) SetRenewalInfoOverridesFile(filename string) error {
+	_, err := reloader.New(filename, wfe.renewalInfoOverrides.load, wfe.renewalInfoOverridesLoadError)
+	return err
+}
+
+func (wfe *WebFrontEndImpl) renewalInfoOverridesLoadError(err error) {
+	wfe.log.AuditErrf("error loading renewal info overrides: %s", err)
+}
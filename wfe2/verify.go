@@ -3,6 +3,7 @@ package wfe2
 import (
 	"context"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rsa"
 	"encoding/json"
 	"errors"
@@ -20,6 +21,7 @@ import (
 	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/nonce"
 	"github.com/letsencrypt/boulder/probs"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
 	"github.com/letsencrypt/boulder/web"
 )
 
@@ -43,8 +45,10 @@ func sigAlgorithmForKey(key *jose.JSONWebKey) (jose.SignatureAlgorithm, error) {
 		case "P-521":
 			return jose.ES512, nil
 		}
+	case ed25519.PublicKey:
+		return jose.EdDSA, nil
 	}
-	return "", errors.New("JWK contains unsupported key type (expected RSA, or ECDSA P-256, P-384, or P-521")
+	return "", errors.New("JWK contains unsupported key type (expected RSA, ECDSA P-256, P-384, or P-521, or Ed25519")
 }
 
 var supportedAlgs = map[string]bool{
@@ -52,6 +56,7 @@ var supportedAlgs = map[string]bool{
 	string(jose.ES256): true,
 	string(jose.ES384): true,
 	string(jose.ES512): true,
+	string(jose.EdDSA): true,
 }
 
 // Check that (1) there is a suitable algorithm for the provided key based on its
@@ -63,7 +68,7 @@ func checkAlgorithm(key *jose.JSONWebKey, parsedJWS *jose.JSONWebSignature) erro
 	sigHeaderAlg := parsedJWS.Signatures[0].Header.Algorithm
 	if !supportedAlgs[sigHeaderAlg] {
 		return fmt.Errorf(
-			"JWS signature header contains unsupported algorithm %q, expected one of RS256, ES256, ES384 or ES512",
+			"JWS signature header contains unsupported algorithm %q, expected one of RS256, ES256, ES384, ES512 or EdDSA",
 			parsedJWS.Signatures[0].Header.Algorithm,
 		)
 	}
@@ -776,3 +781,92 @@ func (wfe *WebFrontEndImpl) validKeyRollover(
 		NewKey: *jwk,
 	}, nil
 }
+
+// eabAlgs are the MAC algorithms accepted for external account binding JWS.
+var eabAlgs = map[string]bool{
+	string(jose.HS256): true,
+	string(jose.HS384): true,
+	string(jose.HS512): true,
+}
+
+// validExternalAccountBinding checks that the provided externalAccountBinding
+// field of a new-account request is a valid external account binding per RFC
+// 8555 Section 7.3.4. This function checks that:
+// 1) the binding is a well formed JWS with a single MAC signature
+// 2) the binding has no nonce and the same "url" header as the request
+// 3) the binding's "kid" header identifies a known, unrevoked and unbound key
+// 4) the binding's MAC verifies with that key
+// 5) the binding's payload is the account key which signed the request
+//
+// If the binding is valid its key identifier is returned, otherwise a problem
+// is returned.
+func (wfe *WebFrontEndImpl) validExternalAccountBinding(
+	ctx context.Context,
+	binding []byte,
+	accountKey *jose.JSONWebKey,
+	request *http.Request) (string, *probs.ProblemDetails) {
+	eabJWS, err := jose.ParseSigned(string(binding))
+	if err != nil {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABUnmarshalFailed"}).Inc()
+		return "", probs.Malformed("externalAccountBinding is not a valid JWS")
+	}
+	if len(eabJWS.Signatures) != 1 {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABWrongSignatureCount"}).Inc()
+		return "", probs.Malformed("externalAccountBinding JWS must have exactly one signature")
+	}
+	header := eabJWS.Signatures[0].Header
+	if !eabAlgs[header.Algorithm] {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABAlgorithmInvalid"}).Inc()
+		return "", probs.BadSignatureAlgorithm(
+			"externalAccountBinding JWS contains unsupported algorithm %q, expected one of HS256, HS384 or HS512",
+			header.Algorithm)
+	}
+	if header.Nonce != "" {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABNonceIncluded"}).Inc()
+		return "", probs.Malformed("externalAccountBinding JWS must not contain a nonce")
+	}
+	if header.KeyID == "" {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABMissingKeyID"}).Inc()
+		return "", probs.Malformed("externalAccountBinding JWS header parameter 'kid' required")
+	}
+	if prob := wfe.validPOSTURL(request, eabJWS); prob != nil {
+		return "", prob
+	}
+
+	eabKey, err := wfe.SA.GetExternalAccountKey(ctx, &sapb.ExternalAccountKeyID{KeyID: header.KeyID})
+	if err != nil {
+		if errors.Is(err, berrors.NotFound) {
+			wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABUnknownKeyID"}).Inc()
+			return "", probs.Unauthorized("externalAccountBinding key identifier is not recognized")
+		}
+		return "", probs.ServerInternal("Error retrieving externalAccountBinding key")
+	}
+	if eabKey.RevokedAt != 0 {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABKeyRevoked"}).Inc()
+		return "", probs.Unauthorized("externalAccountBinding key has been revoked")
+	}
+	if eabKey.RegistrationID != 0 {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABKeyAlreadyBound"}).Inc()
+		return "", probs.Unauthorized("externalAccountBinding key is already bound to an account")
+	}
+
+	payload, err := eabJWS.Verify(eabKey.HmacKey)
+	if err != nil {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABVerifyFailed"}).Inc()
+		return "", probs.Unauthorized("externalAccountBinding JWS does not verify with the identified key")
+	}
+
+	var boundKey jose.JSONWebKey
+	if err := boundKey.UnmarshalJSON(payload); err != nil {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABPayloadInvalid"}).Inc()
+		return "", probs.Malformed("externalAccountBinding JWS payload is not a JWK")
+	}
+	if keysEqual, err := core.PublicKeysEqual(boundKey.Key, accountKey.Key); err != nil {
+		return "", probs.Malformed("Unable to compare externalAccountBinding and account keys: %s", err.Error())
+	} else if !keysEqual {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABWrongKey"}).Inc()
+		return "", probs.Malformed("externalAccountBinding JWS payload does not match the account key")
+	}
+
+	return header.KeyID, nil
+}
@@ -5,7 +5,9 @@ import (
 	"crypto"
 	"crypto/dsa"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/rsa"
 	"fmt"
 	"net/http"
@@ -63,6 +65,8 @@ func pubKeyForKey(t *testing.T, privKey interface{}) interface{} {
 		return k.PublicKey
 	case *ecdsa.PrivateKey:
 		return k.PublicKey
+	case ed25519.PrivateKey:
+		return k.Public()
 	}
 	t.Fatalf("Unable to get public key for private key %#v", privKey)
 	return nil
@@ -187,7 +191,7 @@ func TestRejectsNone(t *testing.T) {
 	if err == nil {
 		t.Fatalf("checkAlgorithm did not reject JWS with alg: 'none'")
 	}
-	if err.Error() != "JWS signature header contains unsupported algorithm \"none\", expected one of RS256, ES256, ES384 or ES512" {
+	if err.Error() != "JWS signature header contains unsupported algorithm \"none\", expected one of RS256, ES256, ES384, ES512 or EdDSA" {
 		t.Fatalf("checkAlgorithm rejected JWS with alg: 'none', but for wrong reason: %#v", err)
 	}
 }
@@ -218,7 +222,7 @@ func TestRejectsHS256(t *testing.T) {
 	if err == nil {
 		t.Fatalf("checkAlgorithm did not reject JWS with alg: 'HS256'")
 	}
-	expected := "JWS signature header contains unsupported algorithm \"HS256\", expected one of RS256, ES256, ES384 or ES512"
+	expected := "JWS signature header contains unsupported algorithm \"HS256\", expected one of RS256, ES256, ES384, ES512 or EdDSA"
 	if err.Error() != expected {
 		t.Fatalf("checkAlgorithm rejected JWS with alg: 'none', but for wrong reason: got %q, wanted %q", err.Error(), expected)
 	}
@@ -241,7 +245,7 @@ func TestCheckAlgorithm(t *testing.T) {
 					},
 				},
 			},
-			"JWS signature header contains unsupported algorithm \"HS256\", expected one of RS256, ES256, ES384 or ES512",
+			"JWS signature header contains unsupported algorithm \"HS256\", expected one of RS256, ES256, ES384, ES512 or EdDSA",
 		},
 		{
 			jose.JSONWebKey{
@@ -256,7 +260,7 @@ func TestCheckAlgorithm(t *testing.T) {
 					},
 				},
 			},
-			"JWK contains unsupported key type (expected RSA, or ECDSA P-256, P-384, or P-521",
+			"JWK contains unsupported key type (expected RSA, ECDSA P-256, P-384, or P-521, or Ed25519",
 		},
 		{
 			jose.JSONWebKey{
@@ -364,6 +368,22 @@ func TestCheckAlgorithmSuccess(t *testing.T) {
 	if err != nil {
 		t.Errorf("ES256 key: Expected nil error, got '%s'", err)
 	}
+
+	err = checkAlgorithm(&jose.JSONWebKey{
+		Algorithm: "EdDSA",
+		Key:       ed25519.PublicKey{},
+	}, &jose.JSONWebSignature{
+		Signatures: []jose.Signature{
+			{
+				Header: jose.Header{
+					Algorithm: "EdDSA",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("EdDSA key: Expected nil error, got '%s'", err)
+	}
 }
 
 func TestValidPOSTRequest(t *testing.T) {
@@ -1180,7 +1200,7 @@ func TestValidJWSForKey(t *testing.T) {
 			JWK:  goodJWK,
 			ExpectedProblem: &probs.ProblemDetails{
 				Type:       probs.BadSignatureAlgorithmProblem,
-				Detail:     "JWS signature header contains unsupported algorithm \"HS256\", expected one of RS256, ES256, ES384 or ES512",
+				Detail:     "JWS signature header contains unsupported algorithm \"HS256\", expected one of RS256, ES256, ES384, ES512 or EdDSA",
 				HTTPStatus: http.StatusBadRequest,
 			},
 			ErrorStatType: "JWSAlgorithmCheckFailed",
@@ -1513,6 +1533,30 @@ func TestValidSelfAuthenticatedPOST(t *testing.T) {
 	}
 }
 
+func TestValidSelfAuthenticatedPOSTEd25519(t *testing.T) {
+	wfe, _ := setupWFE(t)
+
+	_, privKey, err := ed25519.GenerateKey(rand.Reader)
+	test.AssertNotError(t, err, "Error generating Ed25519 key")
+	_, validKey, validJWSBody := signRequestEmbed(t, privKey, "http://localhost/test", `{"test":"passed"}`, wfe.nonceService)
+
+	// With the default key policy Ed25519 account keys are rejected.
+	_, _, prob := wfe.validSelfAuthenticatedPOST(context.Background(), makePostRequestWithPath("test", validJWSBody), newRequestEvent())
+	test.AssertNotNil(t, prob, "Expected a problem for a disallowed Ed25519 key")
+	test.AssertEquals(t, prob.Type, probs.BadPublicKeyProblem)
+
+	wfe.keyPolicy.AllowEd25519 = true
+	_, _, validJWSBody = signRequestEmbed(t, privKey, "http://localhost/test", `{"test":"passed"}`, wfe.nonceService)
+	outPayload, jwk, prob := wfe.validSelfAuthenticatedPOST(context.Background(), makePostRequestWithPath("test", validJWSBody), newRequestEvent())
+	if prob != nil {
+		t.Fatalf("Expected nil problem, got %#v\n", prob)
+	}
+	inThumb, _ := validKey.Thumbprint(crypto.SHA256)
+	outThumb, _ := jwk.Thumbprint(crypto.SHA256)
+	test.AssertDeepEquals(t, inThumb, outThumb)
+	test.AssertEquals(t, string(outPayload), `{"test":"passed"}`)
+}
+
 func TestMatchJWSURLs(t *testing.T) {
 	wfe, _ := setupWFE(t)
 
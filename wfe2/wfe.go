@@ -3,6 +3,8 @@ package wfe2
 import (
 	"context"
 	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"encoding/pem"
@@ -54,8 +56,11 @@ const (
 	rolloverPath      = "/acme/key-change"
 	newNoncePath      = "/acme/new-nonce"
 	newOrderPath      = "/acme/new-order"
+	newAuthzPath      = "/acme/new-authz"
 	orderPath         = "/acme/order/"
 	finalizeOrderPath = "/acme/finalize/"
+	renewalInfoPath   = "/acme/renewal-info/"
+	ordersPath        = "/acme/orders/"
 
 	getAPIPrefix     = "/get/"
 	getOrderPath     = getAPIPrefix + "order/"
@@ -64,6 +69,10 @@ const (
 	getCertPath      = getAPIPrefix + "cert/"
 )
 
+// defaultOrdersPageSize is the number of order URLs returned in each page of
+// an account's orders list.
+const defaultOrdersPageSize = 100
+
 // WebFrontEndImpl provides all the logic for Boulder's web-facing interface,
 // i.e., ACME.  Its members configure the paths for various ACME functions,
 // plus a few other data items used in ACME.  Its methods are primarily handlers
@@ -98,6 +107,11 @@ type WebFrontEndImpl struct {
 	// "website" field.
 	DirectoryWebsite string
 
+	// ExternalAccountRequired, if true, rejects new-account requests which do
+	// not include an external account binding. It is also exposed in the
+	// /directory response's "meta" element's "externalAccountRequired" field.
+	ExternalAccountRequired bool
+
 	// Allowed prefix for legacy accounts used by verify.go's `lookupJWK`.
 	// See `cmd/boulder-wfe2/main.go`'s comment on the configuration field
 	// `LegacyKeyIDPrefix` for more information.
@@ -129,6 +143,15 @@ type WebFrontEndImpl struct {
 	// match the ones used by the RA.
 	authorizationLifetime        time.Duration
 	pendingAuthorizationLifetime time.Duration
+
+	// renewalInfoOverrides holds suggested renewal windows which operators have
+	// configured for specific serials, taking precedence over the windows
+	// computed from each certificate's validity period and revocation status.
+	renewalInfoOverrides *renewalInfoOverrides
+
+	// ordersPageSize is the maximum number of order URLs returned in a single
+	// page of an account's orders list.
+	ordersPageSize int64
 }
 
 // NewWebFrontEndImpl constructs a web service for Boulder
@@ -157,6 +180,8 @@ func NewWebFrontEndImpl(
 		staleTimeout:                 staleTimeout,
 		authorizationLifetime:        authorizationLifetime,
 		pendingAuthorizationLifetime: pendingAuthorizationLifetime,
+		renewalInfoOverrides:         &renewalInfoOverrides{},
+		ordersPageSize:               defaultOrdersPageSize,
 	}
 
 	if wfe.remoteNonceService == nil {
@@ -373,12 +398,23 @@ func (wfe *WebFrontEndImpl) Handler(stats prometheus.Registerer) http.Handler {
 	wfe.HandleFunc(m, authzPath, wfe.Authorization, "GET", "POST")
 	wfe.HandleFunc(m, challengePath, wfe.Challenge, "GET", "POST")
 	wfe.HandleFunc(m, certPath, wfe.Certificate, "GET", "POST")
+	wfe.HandleFunc(m, ordersPath, wfe.AccountOrders, "POST")
 	// Boulder-specific GET-able resource endpoints
 	wfe.HandleFunc(m, getOrderPath, wfe.GetOrder, "GET")
 	wfe.HandleFunc(m, getAuthzPath, wfe.Authorization, "GET")
 	wfe.HandleFunc(m, getChallengePath, wfe.Challenge, "GET")
 	wfe.HandleFunc(m, getCertPath, wfe.Certificate, "GET")
 
+	// Endpoint for draft-aaron-ari
+	if features.Enabled(features.ServeRenewalInfo) {
+		wfe.HandleFunc(m, renewalInfoPath, wfe.RenewalInfo, "GET")
+	}
+
+	// Endpoint for RFC 8555 Section 7.4.1 pre-authorization
+	if features.Enabled(features.PreAuthorization) {
+		wfe.HandleFunc(m, newAuthzPath, wfe.NewAuthorization, "POST")
+	}
+
 	// We don't use our special HandleFunc for "/" because it matches everything,
 	// meaning we can wind up returning 405 when we mean to return 404. See
 	// https://github.com/letsencrypt/boulder/issues/717
@@ -444,6 +480,14 @@ func (wfe *WebFrontEndImpl) Directory(
 		"keyChange":  rolloverPath,
 	}
 
+	if features.Enabled(features.ServeRenewalInfo) {
+		directoryEndpoints["renewalInfo"] = renewalInfoPath
+	}
+
+	if features.Enabled(features.PreAuthorization) {
+		directoryEndpoints["newAuthz"] = newAuthzPath
+	}
+
 	if request.Method == http.MethodPost {
 		acct, prob := wfe.validPOSTAsGETForAccount(request, ctx, logEvent)
 		if prob != nil {
@@ -478,6 +522,11 @@ func (wfe *WebFrontEndImpl) Directory(
 	if wfe.DirectoryWebsite != "" {
 		metaMap["website"] = wfe.DirectoryWebsite
 	}
+	// The "meta" directory entry may also include a bool indicating that new
+	// accounts must be bound to an external account
+	if wfe.ExternalAccountRequired {
+		metaMap["externalAccountRequired"] = true
+	}
 	directoryEndpoints["meta"] = metaMap
 
 	response.Header().Set("Content-Type", "application/json")
@@ -526,6 +575,17 @@ func (wfe *WebFrontEndImpl) Nonce(
 // sendError wraps web.SendError
 func (wfe *WebFrontEndImpl) sendError(response http.ResponseWriter, logEvent *web.RequestEvent, prob *probs.ProblemDetails, ierr error) {
 	wfe.stats.httpErrorCount.With(prometheus.Labels{"type": string(prob.Type)}).Inc()
+	// If the RA told us when a rate limit will reset, pass that along to the
+	// client both as a Retry-After header and in the problem document.
+	var berr *berrors.BoulderError
+	if prob.Type == probs.RateLimitedProblem && errors.As(ierr, &berr) && berr.RetryAfter > 0 {
+		// Round up so that a client waiting exactly the advertised number of
+		// seconds doesn't arrive before the limit has reset.
+		retryAfter := (berr.RetryAfter + time.Second - 1).Truncate(time.Second)
+		response.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())))
+		resetTime := wfe.clk.Now().Add(retryAfter).UTC()
+		prob.ResetTime = &resetTime
+	}
 	web.SendError(wfe.log, probs.V2ErrorNS, response, logEvent, prob, ierr)
 }
 
@@ -551,9 +611,10 @@ func (wfe *WebFrontEndImpl) NewAccount(
 	}
 
 	var accountCreateRequest struct {
-		Contact              *[]string `json:"contact"`
-		TermsOfServiceAgreed bool      `json:"termsOfServiceAgreed"`
-		OnlyReturnExisting   bool      `json:"onlyReturnExisting"`
+		Contact                *[]string       `json:"contact"`
+		TermsOfServiceAgreed   bool            `json:"termsOfServiceAgreed"`
+		OnlyReturnExisting     bool            `json:"onlyReturnExisting"`
+		ExternalAccountBinding json.RawMessage `json:"externalAccountBinding"`
 	}
 
 	err := json.Unmarshal(body, &accountCreateRequest)
@@ -575,7 +636,7 @@ func (wfe *WebFrontEndImpl) NewAccount(
 			web.RelativeEndpoint(request, fmt.Sprintf("%s%d", acctPath, acct.ID)))
 		logEvent.Requester = acct.ID
 
-		prepAccountForDisplay(&acct)
+		prepAccountForDisplay(request, &acct)
 
 		err = wfe.writeJsonResponse(response, logEvent, http.StatusOK, acct)
 		if err != nil {
@@ -609,6 +670,23 @@ func (wfe *WebFrontEndImpl) NewAccount(
 		return
 	}
 
+	var externalAccountID string
+	// A binding of null is treated the same as one which is absent, rather
+	// than being rejected as a malformed JWS.
+	binding := accountCreateRequest.ExternalAccountBinding
+	if len(binding) > 0 && string(binding) != "null" {
+		externalAccountID, prob = wfe.validExternalAccountBinding(ctx, binding, key, request)
+		if prob != nil {
+			wfe.sendError(response, logEvent, prob, nil)
+			return
+		}
+		logEvent.Extra["ExternalAccountID"] = externalAccountID
+	} else if wfe.ExternalAccountRequired {
+		wfe.sendError(response, logEvent, probs.ExternalAccountRequired(
+			"New accounts must include an externalAccountBinding"), nil)
+		return
+	}
+
 	ip, err := extractRequesterIP(request)
 	if err != nil {
 		wfe.sendError(
@@ -621,10 +699,11 @@ func (wfe *WebFrontEndImpl) NewAccount(
 	}
 
 	acct, err := wfe.RA.NewRegistration(ctx, core.Registration{
-		Contact:   accountCreateRequest.Contact,
-		Agreement: wfe.SubscriberAgreementURL,
-		Key:       key,
-		InitialIP: ip,
+		Contact:           accountCreateRequest.Contact,
+		Agreement:         wfe.SubscriberAgreementURL,
+		Key:               key,
+		InitialIP:         ip,
+		ExternalAccountID: externalAccountID,
 	})
 	if err != nil {
 		if errors.Is(err, berrors.Duplicate) {
@@ -655,7 +734,7 @@ func (wfe *WebFrontEndImpl) NewAccount(
 		response.Header().Add("Link", link(wfe.SubscriberAgreementURL, "terms-of-service"))
 	}
 
-	prepAccountForDisplay(&acct)
+	prepAccountForDisplay(request, &acct)
 
 	err = wfe.writeJsonResponse(response, logEvent, http.StatusCreated, acct)
 	if err != nil {
@@ -869,7 +948,7 @@ func (wfe *WebFrontEndImpl) revokeCertByKeyID(
 			return nil
 		}
 		// Otherwise check if the account, while not the owner, has equivalent authorizations
-		valid, err := wfe.acctHoldsAuthorizations(ctx, acct.ID, parsedCertificate.DNSNames)
+		valid, err := wfe.acctHoldsAuthorizations(ctx, acct.ID, core.SANNames(parsedCertificate.DNSNames, parsedCertificate.IPAddresses))
 		if err != nil {
 			return probs.ServerInternal("Failed to retrieve authorizations for names in certificate")
 		}
@@ -1058,7 +1137,11 @@ func (wfe *WebFrontEndImpl) Challenge(
 // for display in a JSON response. Primarily it papers over legacy ACME v1
 // features or non-standard details internal to Boulder we don't want clients to
 // rely on.
-func prepAccountForDisplay(acct *core.Registration) {
+func prepAccountForDisplay(request *http.Request, acct *core.Registration) {
+	// Point the client at the list of the account's orders, which is keyed by
+	// the account ID and so must be computed before the ID is zeroed below.
+	acct.Orders = web.RelativeEndpoint(request, fmt.Sprintf("%s%d", ordersPath, acct.ID))
+
 	// Zero out the account ID so that it isn't marshalled. RFC 8555 specifies
 	// using the Location header for learning the account ID.
 	acct.ID = 0
@@ -1286,7 +1369,7 @@ func (wfe *WebFrontEndImpl) Account(
 		response.Header().Add("Link", link(wfe.SubscriberAgreementURL, "terms-of-service"))
 	}
 
-	prepAccountForDisplay(currAcct)
+	prepAccountForDisplay(request, currAcct)
 
 	err = wfe.writeJsonResponse(response, logEvent, http.StatusOK, currAcct)
 	if err != nil {
@@ -1829,7 +1912,7 @@ func (wfe *WebFrontEndImpl) KeyRollover(
 		return
 	}
 
-	prepAccountForDisplay(&updatedAcct)
+	prepAccountForDisplay(request, &updatedAcct)
 
 	err = wfe.writeJsonResponse(response, logEvent, http.StatusOK, updatedAcct)
 	if err != nil {
@@ -1845,16 +1928,58 @@ type orderJSON struct {
 	Finalize       string                      `json:"finalize"`
 	Certificate    string                      `json:"certificate,omitempty"`
 	Error          *probs.ProblemDetails       `json:"error,omitempty"`
+	Profile        string                      `json:"profile,omitempty"`
+	NotBefore      *time.Time                  `json:"notBefore,omitempty"`
+	NotAfter       *time.Time                  `json:"notAfter,omitempty"`
+}
+
+// parseOrderTime parses an RFC 3339 timestamp from the named field of a new
+// order request and returns it as Unix nanoseconds. An empty value means the
+// field wasn't requested and is returned as zero.
+func parseOrderTime(field, value string) (int64, *probs.ProblemDetails) {
+	if value == "" {
+		return 0, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return 0, probs.Malformed("NewOrder request %s %q is not a valid RFC 3339 timestamp", field, value)
+	}
+	return t.UnixNano(), nil
+}
+
+// checkIdentifierType returns a problem if the given identifier from a request
+// of the named kind is not a DNS or IP type identifier, or if its value doesn't
+// match its type. Orders only carry identifier values, and later layers infer
+// the type from the value, so a DNS identifier must not look like an IP
+// address.
+func checkIdentifierType(requestName string, ident identifier.ACMEIdentifier) *probs.ProblemDetails {
+	isIP := net.ParseIP(ident.Value) != nil
+	switch ident.Type {
+	case identifier.DNS:
+		if isIP {
+			return probs.Malformed("%s request included IP address %q as a DNS type identifier",
+				requestName, ident.Value)
+		}
+	case identifier.IP:
+		if !isIP {
+			return probs.Malformed("%s request included invalid IP type identifier value %q",
+				requestName, ident.Value)
+		}
+	default:
+		return probs.Malformed("%s request included invalid non-DNS type identifier: type %q, value %q",
+			requestName, ident.Type, ident.Value)
+	}
+	return nil
 }
 
 // orderToOrderJSON converts a *corepb.Order instance into an orderJSON struct
 // that is returned in HTTP API responses. It will convert the order names to
-// DNS type identifiers and additionally create absolute URLs for the finalize
-// URL and the ceritificate URL as appropriate.
+// DNS or IP type identifiers and additionally create absolute URLs for the
+// finalize URL and the ceritificate URL as appropriate.
 func (wfe *WebFrontEndImpl) orderToOrderJSON(request *http.Request, order *corepb.Order) orderJSON {
 	idents := make([]identifier.ACMEIdentifier, len(order.Names))
 	for i, name := range order.Names {
-		idents[i] = identifier.ACMEIdentifier{Type: identifier.DNS, Value: name}
+		idents[i] = identifier.FromString(name)
 	}
 	finalizeURL := web.RelativeEndpoint(request,
 		fmt.Sprintf("%s%d/%d", finalizeOrderPath, order.RegistrationID, order.Id))
@@ -1863,6 +1988,9 @@ func (wfe *WebFrontEndImpl) orderToOrderJSON(request *http.Request, order *corep
 		Expires:     time.Unix(0, order.Expires).UTC(),
 		Identifiers: idents,
 		Finalize:    finalizeURL,
+		Profile:     order.CertificateProfileName,
+		NotBefore:   core.UnixNanoToTime(order.NotBefore),
+		NotAfter:    core.UnixNanoToTime(order.NotAfter),
 	}
 	// If there is an order error, prefix its type with the V2 namespace
 	if order.Error != nil {
@@ -1899,12 +2027,15 @@ func (wfe *WebFrontEndImpl) NewOrder(
 		return
 	}
 
-	// We only allow specifying Identifiers in a new order request - if the
-	// `notBefore` and/or `notAfter` fields described in Section 7.4 of acme-08
-	// are sent we return a probs.Malformed as we do not support them
+	// A new order request specifies Identifiers and optionally the `notBefore`
+	// and `notAfter` fields described in Section 7.4 of RFC 8555 and a
+	// certificate profile. Whether the requested validity period is acceptable
+	// and whether the account may use the requested profile is up to the RA.
 	var newOrderRequest struct {
-		Identifiers         []identifier.ACMEIdentifier `json:"identifiers"`
-		NotBefore, NotAfter string
+		Identifiers []identifier.ACMEIdentifier `json:"identifiers"`
+		NotBefore   string                      `json:"notBefore"`
+		NotAfter    string                      `json:"notAfter"`
+		Profile     string                      `json:"profile"`
 	}
 	err := json.Unmarshal(body, &newOrderRequest)
 	if err != nil {
@@ -1918,32 +2049,53 @@ func (wfe *WebFrontEndImpl) NewOrder(
 			probs.Malformed("NewOrder request did not specify any identifiers"), nil)
 		return
 	}
-	if newOrderRequest.NotBefore != "" || newOrderRequest.NotAfter != "" {
-		wfe.sendError(response, logEvent, probs.Malformed("NotBefore and NotAfter are not supported"), nil)
+	notBefore, prob := parseOrderTime("notBefore", newOrderRequest.NotBefore)
+	if prob != nil {
+		wfe.sendError(response, logEvent, prob, nil)
+		return
+	}
+	notAfter, prob := parseOrderTime("notAfter", newOrderRequest.NotAfter)
+	if prob != nil {
+		wfe.sendError(response, logEvent, prob, nil)
 		return
 	}
 
-	// Collect up all of the DNS identifier values into a []string for subsequent
-	// layers to process. We reject anything with a non-DNS type identifier here.
+	// Collect up all of the DNS and IP identifier values into a []string for
+	// subsequent layers to process. We reject any other identifier type here,
+	// reporting every rejected identifier rather than just the first.
 	names := make([]string, len(newOrderRequest.Identifiers))
+	var subProbs []probs.SubProblemDetails
 	for i, ident := range newOrderRequest.Identifiers {
-		if ident.Type != identifier.DNS {
-			wfe.sendError(response, logEvent,
-				probs.Malformed("NewOrder request included invalid non-DNS type identifier: type %q, value %q",
-					ident.Type, ident.Value),
-				nil)
-			return
+		prob := checkIdentifierType("NewOrder", ident)
+		if prob == nil && ident.Value == "" {
+			prob = probs.Malformed("NewOrder request included empty domain name")
 		}
-		if ident.Value == "" {
-			wfe.sendError(response, logEvent, probs.Malformed("NewOrder request included empty domain name"), nil)
-			return
+		if prob != nil {
+			subProbs = append(subProbs, probs.SubProblemDetails{
+				ProblemDetails: *prob,
+				Identifier:     ident,
+			})
+			continue
 		}
 		names[i] = ident.Value
 	}
+	if len(subProbs) == 1 {
+		wfe.sendError(response, logEvent, &subProbs[0].ProblemDetails, nil)
+		return
+	} else if len(subProbs) > 1 {
+		prob := probs.Malformed(
+			"NewOrder request included %d invalid identifiers. Refer to sub-problems for more information",
+			len(subProbs))
+		wfe.sendError(response, logEvent, prob.WithSubProblems(subProbs), nil)
+		return
+	}
 
 	order, err := wfe.RA.NewOrder(ctx, &rapb.NewOrderRequest{
-		RegistrationID: acct.ID,
-		Names:          names,
+		RegistrationID:         acct.ID,
+		Names:                  names,
+		CertificateProfileName: newOrderRequest.Profile,
+		NotBefore:              notBefore,
+		NotAfter:               notAfter,
 	})
 	if err != nil {
 		wfe.sendError(response, logEvent, web.ProblemDetailsForError(err, "Error creating new order"), err)
@@ -1963,6 +2115,159 @@ func (wfe *WebFrontEndImpl) NewOrder(
 	}
 }
 
+// ordersListJSON is the RFC 8555 Section 7.1.2.1 representation of a page of
+// an account's orders list.
+type ordersListJSON struct {
+	Orders []string `json:"orders"`
+}
+
+// AccountOrders is used by clients to list the URLs of their account's orders,
+// as described in RFC 8555 Section 7.1.2.1. Only unexpired orders are listed,
+// most recent first. Lists longer than the page size are split into pages
+// linked by a "next" Link header, with the path of each subsequent page
+// carrying the ID of the last order on the previous page as a cursor.
+func (wfe *WebFrontEndImpl) AccountOrders(
+	ctx context.Context,
+	logEvent *web.RequestEvent,
+	response http.ResponseWriter,
+	request *http.Request) {
+	acct, prob := wfe.validPOSTAsGETForAccount(request, ctx, logEvent)
+	addRequesterHeader(response, logEvent.Requester)
+	if prob != nil {
+		// validPOSTAsGETForAccount handles its own setting of logEvent.Errors
+		wfe.sendError(response, logEvent, prob, nil)
+		return
+	}
+
+	// Path prefix is stripped, so this should be like "<account ID>" or
+	// "<account ID>/<cursor>"
+	fields := strings.SplitN(request.URL.Path, "/", 2)
+	acctID, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.Malformed("Invalid account ID"), err)
+		return
+	}
+	var cursor int64
+	if len(fields) == 2 {
+		cursor, err = strconv.ParseInt(fields[1], 10, 64)
+		if err != nil || cursor <= 0 {
+			wfe.sendError(response, logEvent, probs.Malformed("Invalid orders list cursor"), err)
+			return
+		}
+	}
+
+	if acct.ID != acctID {
+		wfe.sendError(response, logEvent, probs.Unauthorized("Request signing key did not match account key"), nil)
+		return
+	}
+
+	// Ask for one more order than fits on a page to learn whether there is a
+	// next page without a separate query.
+	resp, err := wfe.SA.GetOrdersForAccount(ctx, &sapb.GetOrdersForAccountRequest{
+		AcctID: acct.ID,
+		Cursor: cursor,
+		Limit:  wfe.ordersPageSize + 1,
+	})
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.ServerInternal("Unable to retrieve orders for account"), err)
+		return
+	}
+
+	orderIDs := resp.Ids
+	if int64(len(orderIDs)) > wfe.ordersPageSize {
+		orderIDs = orderIDs[:wfe.ordersPageSize]
+		nextURL := web.RelativeEndpoint(request,
+			fmt.Sprintf("%s%d/%d", ordersPath, acct.ID, orderIDs[len(orderIDs)-1]))
+		response.Header().Add("Link", link(nextURL, "next"))
+	}
+
+	respObj := ordersListJSON{Orders: make([]string, len(orderIDs))}
+	for i, orderID := range orderIDs {
+		respObj.Orders[i] = web.RelativeEndpoint(request,
+			fmt.Sprintf("%s%d/%d", orderPath, acct.ID, orderID))
+	}
+
+	err = wfe.writeJsonResponse(response, logEvent, http.StatusOK, respObj)
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.ServerInternal("Error marshaling orders list"), err)
+		return
+	}
+}
+
+// NewAuthorization is used by clients to pre-authorize an identifier ahead of
+// placing an order for it, as described in RFC 8555 Section 7.4.1.
+func (wfe *WebFrontEndImpl) NewAuthorization(
+	ctx context.Context,
+	logEvent *web.RequestEvent,
+	response http.ResponseWriter,
+	request *http.Request) {
+	body, _, acct, prob := wfe.validPOSTForAccount(request, ctx, logEvent)
+	addRequesterHeader(response, logEvent.Requester)
+	if prob != nil {
+		// validPOSTForAccount handles its own setting of logEvent.Errors
+		wfe.sendError(response, logEvent, prob, nil)
+		return
+	}
+
+	var newAuthzRequest struct {
+		Identifier identifier.ACMEIdentifier `json:"identifier"`
+	}
+	err := json.Unmarshal(body, &newAuthzRequest)
+	if err != nil {
+		wfe.sendError(response, logEvent,
+			probs.Malformed("Unable to unmarshal NewAuthorization request body"), err)
+		return
+	}
+
+	ident := newAuthzRequest.Identifier
+	if prob := checkIdentifierType("NewAuthorization", ident); prob != nil {
+		wfe.sendError(response, logEvent, prob, nil)
+		return
+	}
+	if ident.Value == "" {
+		wfe.sendError(response, logEvent, probs.Malformed("NewAuthorization request included empty domain name"), nil)
+		return
+	}
+	// Authorization identifiers can't contain a wildcard, so a pre-authorization
+	// can't be used to authorize a wildcard name. Clients wanting a wildcard
+	// certificate must place an order for it instead.
+	if strings.HasPrefix(ident.Value, "*.") {
+		wfe.sendError(response, logEvent,
+			probs.RejectedIdentifier("Wildcard domain names cannot be pre-authorized, use newOrder instead"), nil)
+		return
+	}
+	logEvent.DNSName = ident.Value
+
+	authzPB, err := wfe.RA.NewPreAuthorization(ctx, &rapb.NewPreAuthorizationRequest{
+		RegistrationID:  acct.ID,
+		IdentifierType:  string(ident.Type),
+		IdentifierValue: ident.Value,
+	})
+	if err != nil {
+		wfe.sendError(response, logEvent, web.ProblemDetailsForError(err, "Error creating new authz"), err)
+		return
+	}
+	authz, err := bgrpc.PBToAuthz(authzPB)
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.ServerInternal("Error creating new authz"), err)
+		return
+	}
+	logEvent.Created = authz.ID
+	logEvent.Status = string(authz.Status)
+
+	authzURL := web.RelativeEndpoint(request, fmt.Sprintf("%s%s", authzPath, authz.ID))
+	response.Header().Set("Location", authzURL)
+
+	wfe.prepAuthorizationForDisplay(request, &authz)
+
+	err = wfe.writeJsonResponse(response, logEvent, http.StatusCreated, authz)
+	if err != nil {
+		// ServerInternal because we generated the authz, it should be OK
+		wfe.sendError(response, logEvent, probs.ServerInternal("Error marshaling authz"), err)
+		return
+	}
+}
+
 // GetOrder is used to retrieve a existing order object
 func (wfe *WebFrontEndImpl) GetOrder(ctx context.Context, logEvent *web.RequestEvent, response http.ResponseWriter, request *http.Request) {
 	if features.Enabled(features.MandatoryPOSTAsGET) && request.Method != http.MethodPost && !requiredStale(request, logEvent) {
@@ -2171,3 +2476,96 @@ func extractRequesterIP(req *http.Request) (net.IP, error) {
 func urlForAuthz(authz core.Authorization, request *http.Request) string {
 	return web.RelativeEndpoint(request, authzPath+string(authz.ID))
 }
+
+// RenewalInfo is used to get information about the suggested renewal window
+// for the given certificate. It only accepts unauthenticated GET requests.
+func (wfe *WebFrontEndImpl) RenewalInfo(ctx context.Context, logEvent *web.RequestEvent, response http.ResponseWriter, request *http.Request) {
+	if !features.Enabled(features.ServeRenewalInfo) {
+		wfe.sendError(response, logEvent, probs.NotFound("Feature not enabled"), nil)
+		return
+	}
+
+	if len(request.URL.Path) == 0 {
+		wfe.sendError(response, logEvent, probs.NotFound("Must specify a request path"), nil)
+		return
+	}
+
+	// The path must be a base64url encoded (without padding) DER CertID, as
+	// defined in RFC 6960 Section 4.1.1.
+	der, err := base64.RawURLEncoding.DecodeString(request.URL.Path)
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.Malformed("Path was not base64url-encoded"), nil)
+		return
+	}
+	var id certID
+	rest, err := asn1.Unmarshal(der, &id)
+	if err != nil || len(rest) != 0 || id.SerialNumber == nil {
+		wfe.sendError(response, logEvent, probs.Malformed("Path was not a DER-encoded CertID sequence"), nil)
+		return
+	}
+	_, err = id.hashFunc()
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.Malformed("Request used unsupported hash algorithm"), err)
+		return
+	}
+
+	// Find the issuer whose name and key hashes match those in the CertID. We
+	// have no way to look up any other certificate.
+	var issuer *issuance.Certificate
+	for _, ic := range wfe.issuerCertificates {
+		match, err := id.matchesIssuer(ic.Certificate)
+		if err != nil {
+			wfe.sendError(response, logEvent, probs.ServerInternal("Failed to hash issuer"), err)
+			return
+		}
+		if match {
+			issuer = ic
+			break
+		}
+	}
+	if issuer == nil {
+		wfe.sendError(response, logEvent, probs.NotFound("Issuer not found"), nil)
+		return
+	}
+
+	serial := core.SerialToString(id.SerialNumber)
+	logEvent.Extra["RequestedSerial"] = serial
+
+	certAndStatus, err := wfe.SA.GetCertificateAndStatus(ctx, &sapb.Serial{Serial: serial})
+	if err != nil {
+		if errors.Is(err, berrors.NotFound) {
+			wfe.sendError(response, logEvent, probs.NotFound("Certificate not found"), nil)
+		} else {
+			wfe.sendError(response, logEvent, probs.ServerInternal("Failed to retrieve certificate"), err)
+		}
+		return
+	}
+	cert, err := x509.ParseCertificate(certAndStatus.Certificate.Der)
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.ServerInternal("Failed to parse certificate"), err)
+		return
+	}
+	if issuance.GetIssuerNameID(cert) != issuer.NameID() {
+		wfe.sendError(response, logEvent, probs.NotFound("Certificate not found"), nil)
+		return
+	}
+
+	var ri core.RenewalInfo
+	if window, ok := wfe.renewalInfoOverrides.get(serial); ok {
+		logEvent.Extra["RenewalInfoOverride"] = true
+		ri = core.RenewalInfo{SuggestedWindow: window}
+	} else if core.OCSPStatus(certAndStatus.Status.Status) == core.OCSPStatusRevoked {
+		ri = core.RenewalInfoImmediate(wfe.clk.Now())
+	} else {
+		ri = core.RenewalInfoSimple(cert.NotBefore, cert.NotAfter)
+	}
+
+	// Ask clients to check back in six hours, so that changes to the suggested
+	// window (e.g. because the certificate was revoked) are noticed promptly.
+	response.Header().Set("Retry-After", strconv.Itoa(int((6 * time.Hour).Seconds())))
+	err = wfe.writeJsonResponse(response, logEvent, http.StatusOK, ri)
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.ServerInternal("Error marshalling renewalInfo"), err)
+		return
+	}
+}
@@ -9,6 +9,9 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
 	"encoding/json"
 	"encoding/pem"
 	"errors"
@@ -25,6 +28,7 @@ import (
 	"time"
 
 	"github.com/jmhodges/clock"
+	"golang.org/x/crypto/ocsp"
 	jose "gopkg.in/square/go-jose.v2"
 
 	"github.com/letsencrypt/boulder/core"
@@ -32,6 +36,7 @@ import (
 	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/features"
 	"github.com/letsencrypt/boulder/goodkey"
+	bgrpc "github.com/letsencrypt/boulder/grpc"
 	"github.com/letsencrypt/boulder/identifier"
 	"github.com/letsencrypt/boulder/issuance"
 	blog "github.com/letsencrypt/boulder/log"
@@ -257,12 +262,15 @@ func (ra *MockRegistrationAuthority) DeactivateRegistration(ctx context.Context,
 
 func (ra *MockRegistrationAuthority) NewOrder(ctx context.Context, req *rapb.NewOrderRequest) (*corepb.Order, error) {
 	return &corepb.Order{
-		Id:               1,
-		RegistrationID:   req.RegistrationID,
-		Expires:          0,
-		Names:            req.Names,
-		Status:           string(core.StatusPending),
-		V2Authorizations: []int64{1},
+		Id:                     1,
+		RegistrationID:         req.RegistrationID,
+		Expires:                0,
+		Names:                  req.Names,
+		Status:                 string(core.StatusPending),
+		V2Authorizations:       []int64{1},
+		CertificateProfileName: req.CertificateProfileName,
+		NotBefore:              req.NotBefore,
+		NotAfter:               req.NotAfter,
 	}, nil
 }
 
@@ -271,6 +279,24 @@ func (ra *MockRegistrationAuthority) FinalizeOrder(ctx context.Context, req *rap
 	return req.Order, nil
 }
 
+func (ra *MockRegistrationAuthority) NewPreAuthorization(ctx context.Context, req *rapb.NewPreAuthorizationRequest) (*corepb.Authorization, error) {
+	return &corepb.Authorization{
+		Id:             "1",
+		Identifier:     req.IdentifierValue,
+		RegistrationID: req.RegistrationID,
+		Status:         string(core.StatusPending),
+		Expires:        time.Date(2070, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano(),
+		Challenges: []*corepb.Challenge{
+			{
+				Id:     1,
+				Type:   string(core.ChallengeTypeDNS01),
+				Status: string(core.StatusPending),
+				Token:  "token",
+			},
+		},
+	}, nil
+}
+
 func makeBody(s string) io.ReadCloser {
 	return ioutil.NopCloser(strings.NewReader(s))
 }
@@ -725,6 +751,7 @@ func TestDirectory(t *testing.T) {
 		name         string
 		caaIdent     string
 		website      string
+		eabRequired  bool
 		expectedJSON string
 		request      *http.Request
 	}{
@@ -783,6 +810,23 @@ func TestDirectory(t *testing.T) {
   "newNonce": "http://localhost/acme/new-nonce",
   "newOrder": "http://localhost/acme/new-order",
   "revokeCert": "http://localhost/acme/revoke-cert"
+}`,
+		},
+		{
+			name:        "standard GET, external account required",
+			eabRequired: true,
+			request:     getReq,
+			expectedJSON: `{
+  "AAAAAAAAAAA": "https://community.letsencrypt.org/t/adding-random-entries-to-the-directory/33417",
+  "keyChange": "http://localhost:4300/acme/key-change",
+  "meta": {
+    "externalAccountRequired": true,
+    "termsOfService": "http://example.invalid/terms"
+  },
+  "newAccount": "http://localhost:4300/acme/new-acct",
+  "newNonce": "http://localhost:4300/acme/new-nonce",
+  "newOrder": "http://localhost:4300/acme/new-order",
+  "revokeCert": "http://localhost:4300/acme/revoke-cert"
 }`,
 		},
 	}
@@ -792,6 +836,7 @@ func TestDirectory(t *testing.T) {
 			// Configure a caaIdentity and website for the /directory meta based on the tc
 			wfe.DirectoryCAAIdentity = tc.caaIdent // "Radiant Lock"
 			wfe.DirectoryWebsite = tc.website      //"zombo.com"
+			wfe.ExternalAccountRequired = tc.eabRequired
 			responseWriter := httptest.NewRecorder()
 			// Serve the /directory response for this request into a recorder
 			mux.ServeHTTP(responseWriter, tc.request)
@@ -1308,6 +1353,7 @@ func TestNewECDSAAccount(t *testing.T) {
 		},
 		"initialIp": "",
 		"createdAt": "0001-01-01T00:00:00Z",
+		"orders": "http://localhost/acme/orders/3",
 		"status": ""
 		}`)
 	test.AssertEquals(t, responseWriter.Header().Get("Location"), "http://localhost/acme/acct/3")
@@ -1490,6 +1536,7 @@ func TestNewAccount(t *testing.T) {
 		],
 		"initialIp": "",
 		"createdAt": "0001-01-01T00:00:00Z",
+		"orders": "http://localhost/acme/orders/1",
 		"status": "valid"
 	}`)
 }
@@ -1539,10 +1586,133 @@ func TestNewAccountNoID(t *testing.T) {
 		],
 		"initialIp": "1.1.1.1",
 		"createdAt": "0001-01-01T00:00:00Z",
+		"orders": "http://localhost/acme/orders/1",
 		"status": ""
 	}`)
 }
 
+// signExternalAccountBinding creates an externalAccountBinding JWS over the
+// public key of the given account key, MACed with the given HMAC key and
+// identified by the given key ID.
+func signExternalAccountBinding(
+	t *testing.T,
+	keyID string,
+	hmacKey []byte,
+	accountKey crypto.Signer,
+	url string) string {
+	jwk, err := (&jose.JSONWebKey{Key: accountKey.Public()}).MarshalJSON()
+	test.AssertNotError(t, err, "Failed to marshal account key")
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: hmacKey}, &jose.SignerOptions{
+		ExtraHeaders: map[jose.HeaderKey]interface{}{
+			"kid": keyID,
+			"url": url,
+		},
+	})
+	test.AssertNotError(t, err, "Failed to make EAB signer")
+
+	jws, err := signer.Sign(jwk)
+	test.AssertNotError(t, err, "Failed to sign EAB")
+	return jws.FullSerialize()
+}
+
+func TestNewAccountExternalAccountBinding(t *testing.T) {
+	wfe, _ := setupWFE(t)
+	wfe.ExternalAccountRequired = true
+	key := loadKey(t, []byte(test2KeyPrivatePEM))
+	otherKey := loadKey(t, []byte(test3KeyPrivatePEM))
+	signedURL := fmt.Sprintf("http://localhost%s", newAcctPath)
+
+	testCases := []struct {
+		name           string
+		binding        string
+		expectedStatus int
+		expectedType   probs.ProblemType
+	}{
+		{
+			name:           "missing binding",
+			expectedStatus: http.StatusForbidden,
+			expectedType:   probs.ExternalAccountRequiredProblem,
+		},
+		{
+			name:           "null binding",
+			binding:        `null`,
+			expectedStatus: http.StatusForbidden,
+			expectedType:   probs.ExternalAccountRequiredProblem,
+		},
+		{
+			name:           "malformed binding",
+			binding:        `"not a JWS"`,
+			expectedStatus: http.StatusBadRequest,
+			expectedType:   probs.MalformedProblem,
+		},
+		{
+			name:           "unknown key ID",
+			binding:        signExternalAccountBinding(t, "unknown", mocks.ExternalAccountHMACKey, key, signedURL),
+			expectedStatus: http.StatusForbidden,
+			expectedType:   probs.UnauthorizedProblem,
+		},
+		{
+			name:           "revoked key",
+			binding:        signExternalAccountBinding(t, "revoked", mocks.ExternalAccountHMACKey, key, signedURL),
+			expectedStatus: http.StatusForbidden,
+			expectedType:   probs.UnauthorizedProblem,
+		},
+		{
+			name:           "already bound key",
+			binding:        signExternalAccountBinding(t, "bound", mocks.ExternalAccountHMACKey, key, signedURL),
+			expectedStatus: http.StatusForbidden,
+			expectedType:   probs.UnauthorizedProblem,
+		},
+		{
+			name:           "wrong HMAC key",
+			binding:        signExternalAccountBinding(t, "valid", []byte("wrong wrong wrong wrong wrong!!!"), key, signedURL),
+			expectedStatus: http.StatusForbidden,
+			expectedType:   probs.UnauthorizedProblem,
+		},
+		{
+			name:           "wrong url",
+			binding:        signExternalAccountBinding(t, "valid", mocks.ExternalAccountHMACKey, key, "http://localhost/acme/new-order"),
+			expectedStatus: http.StatusBadRequest,
+			expectedType:   probs.MalformedProblem,
+		},
+		{
+			name:           "binding for a different account key",
+			binding:        signExternalAccountBinding(t, "valid", mocks.ExternalAccountHMACKey, otherKey, signedURL),
+			expectedStatus: http.StatusBadRequest,
+			expectedType:   probs.MalformedProblem,
+		},
+		{
+			name:           "valid binding",
+			binding:        signExternalAccountBinding(t, "valid", mocks.ExternalAccountHMACKey, key, signedURL),
+			expectedStatus: http.StatusCreated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			payload := `{"contact":["mailto:person@mail.com"],"termsOfServiceAgreed":true}`
+			if tc.binding != "" {
+				payload = fmt.Sprintf(
+					`{"contact":["mailto:person@mail.com"],"termsOfServiceAgreed":true,"externalAccountBinding":%s}`,
+					tc.binding)
+			}
+			_, _, body := signRequestEmbed(t, key, signedURL, payload, wfe.nonceService)
+
+			responseWriter := httptest.NewRecorder()
+			wfe.NewAccount(ctx, newRequestEvent(), responseWriter, makePostRequestWithPath(newAcctPath, body))
+
+			test.AssertEquals(t, responseWriter.Code, tc.expectedStatus)
+			if tc.expectedType != "" {
+				var prob probs.ProblemDetails
+				err := json.Unmarshal(responseWriter.Body.Bytes(), &prob)
+				test.AssertNotError(t, err, "Couldn't unmarshal returned problem")
+				test.AssertEquals(t, prob.Type, probs.V2ErrorNS+tc.expectedType)
+			}
+		})
+	}
+}
+
 func TestGetAuthorization(t *testing.T) {
 	wfe, _ := setupWFE(t)
 
@@ -1793,6 +1963,23 @@ func (sa *mockSAWithCert) GetCertificateStatus(_ context.Context, serial string)
 	}, nil
 }
 
+// GetCertificateAndStatus returns the mock SA's hard-coded certificate and
+// status, if the given serial matches. Otherwise, returns not found.
+func (sa *mockSAWithCert) GetCertificateAndStatus(ctx context.Context, req *sapb.Serial) (*sapb.CertificateAndStatus, error) {
+	cert, err := sa.GetCertificate(ctx, req.Serial)
+	if err != nil {
+		return nil, err
+	}
+	status, err := sa.GetCertificateStatus(ctx, req.Serial)
+	if err != nil {
+		return nil, err
+	}
+	return &sapb.CertificateAndStatus{
+		Certificate: bgrpc.CertToPB(cert),
+		Status:      bgrpc.CertStatusToPB(status),
+	}, nil
+}
+
 func TestGetCertificate(t *testing.T) {
 	wfe, _ := setupWFE(t)
 	wfe.SA = newMockSAWithCert(t, wfe.SA, core.OCSPStatusGood)
@@ -2324,6 +2511,7 @@ func TestDeactivateAccount(t *testing.T) {
 		  ],
 		  "initialIp": "",
 		  "createdAt": "0001-01-01T00:00:00Z",
+		  "orders": "http://localhost/acme/orders/1",
 		  "status": "deactivated"
 		}`)
 
@@ -2345,6 +2533,7 @@ func TestDeactivateAccount(t *testing.T) {
 		  ],
 		  "initialIp": "",
 		  "createdAt": "0001-01-01T00:00:00Z",
+		  "orders": "http://localhost/acme/orders/1",
 		  "status": "deactivated"
 		}`)
 
@@ -2396,6 +2585,15 @@ func TestNewOrder(t *testing.T) {
 		]
 	}`
 
+	validIPOrderBody := `
+	{
+		"Identifiers": [
+		  {"type": "dns", "value": "not-example.com"},
+			{"type": "ip", "value": "93.184.216.34"},
+			{"type": "ip", "value": "2606:2800:220:1:248:1893:25c8:1946"}
+		]
+	}`
+
 	testCases := []struct {
 		Name            string
 		Request         *http.Request
@@ -2438,10 +2636,48 @@ func TestNewOrder(t *testing.T) {
 			Request:      signAndPost(t, targetPath, signedURL, nonDNSIdentifierBody, 1, wfe.nonceService),
 			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewOrder request included invalid non-DNS type identifier: type \"fakeID\", value \"www.i-am-21.com\"","status":400}`,
 		},
+		{
+			Name:         "POST, IP address as DNS identifier in payload",
+			Request:      signAndPost(t, targetPath, signedURL, `{"identifiers":[{"type":"dns","value":"93.184.216.34"}]}`, 1, wfe.nonceService),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewOrder request included IP address \"93.184.216.34\" as a DNS type identifier","status":400}`,
+		},
+		{
+			Name:         "POST, invalid IP identifier in payload",
+			Request:      signAndPost(t, targetPath, signedURL, `{"identifiers":[{"type":"ip","value":"not-example.com"}]}`, 1, wfe.nonceService),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewOrder request included invalid IP type identifier value \"not-example.com\"","status":400}`,
+		},
+		{
+			Name:    "POST, multiple invalid identifiers in payload",
+			Request: signAndPost(t, targetPath, signedURL, `{"identifiers":[{"type":"ip","value":"not-example.com"},{"type":"dns","value":"example.com"},{"type":"fakeID","value":"example.org"}]}`, 1, wfe.nonceService),
+			ExpectedBody: `{
+				"type":"` + probs.V2ErrorNS + `malformed",
+				"detail":"NewOrder request included 2 invalid identifiers. Refer to sub-problems for more information",
+				"status":400,
+				"subproblems":[
+					{
+						"type":"` + probs.V2ErrorNS + `malformed",
+						"detail":"NewOrder request included invalid IP type identifier value \"not-example.com\"",
+						"status":400,
+						"identifier":{"type":"ip","value":"not-example.com"}
+					},
+					{
+						"type":"` + probs.V2ErrorNS + `malformed",
+						"detail":"NewOrder request included invalid non-DNS type identifier: type \"fakeID\", value \"example.org\"",
+						"status":400,
+						"identifier":{"type":"fakeID","value":"example.org"}
+					}
+				]
+			}`,
+		},
 		{
 			Name:         "POST, notAfter and notBefore in payload",
 			Request:      signAndPost(t, targetPath, signedURL, `{"identifiers":[{"type": "dns", "value": "not-example.com"}], "notBefore":"now", "notAfter": "later"}`, 1, wfe.nonceService),
-			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NotBefore and NotAfter are not supported","status":400}`,
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewOrder request notBefore \"now\" is not a valid RFC 3339 timestamp","status":400}`,
+		},
+		{
+			Name:         "POST, malformed notAfter in payload",
+			Request:      signAndPost(t, targetPath, signedURL, `{"identifiers":[{"type": "dns", "value": "not-example.com"}], "notAfter": "2021-13-01T00:00:00Z"}`, 1, wfe.nonceService),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewOrder request notAfter \"2021-13-01T00:00:00Z\" is not a valid RFC 3339 timestamp","status":400}`,
 		},
 		{
 			Name:    "POST, good payload",
@@ -2460,6 +2696,59 @@ func TestNewOrder(t *testing.T) {
 						"finalize": "http://localhost/acme/finalize/1/1"
 					}`,
 		},
+		{
+			Name:    "POST, good payload with IP identifiers",
+			Request: signAndPost(t, targetPath, signedURL, validIPOrderBody, 1, wfe.nonceService),
+			ExpectedBody: `
+					{
+						"status": "pending",
+						"expires": "1970-01-01T00:00:00Z",
+						"identifiers": [
+							{ "type": "dns", "value": "not-example.com"},
+							{ "type": "ip", "value": "93.184.216.34"},
+							{ "type": "ip", "value": "2606:2800:220:1:248:1893:25c8:1946"}
+						],
+						"authorizations": [
+							"http://localhost/acme/authz-v3/1"
+						],
+						"finalize": "http://localhost/acme/finalize/1/1"
+					}`,
+		},
+		{
+			Name:    "POST, good payload with certificate profile",
+			Request: signAndPost(t, targetPath, signedURL, `{"identifiers":[{"type":"dns","value":"not-example.com"}],"profile":"short-lived"}`, 1, wfe.nonceService),
+			ExpectedBody: `
+					{
+						"status": "pending",
+						"expires": "1970-01-01T00:00:00Z",
+						"identifiers": [
+							{ "type": "dns", "value": "not-example.com"}
+						],
+						"authorizations": [
+							"http://localhost/acme/authz-v3/1"
+						],
+						"finalize": "http://localhost/acme/finalize/1/1",
+						"profile": "short-lived"
+					}`,
+		},
+		{
+			Name:    "POST, good payload with notBefore and notAfter",
+			Request: signAndPost(t, targetPath, signedURL, `{"identifiers":[{"type":"dns","value":"not-example.com"}],"notBefore":"2021-04-01T00:00:00Z","notAfter":"2021-04-08T00:00:00+02:00"}`, 1, wfe.nonceService),
+			ExpectedBody: `
+					{
+						"status": "pending",
+						"expires": "1970-01-01T00:00:00Z",
+						"identifiers": [
+							{ "type": "dns", "value": "not-example.com"}
+						],
+						"authorizations": [
+							"http://localhost/acme/authz-v3/1"
+						],
+						"finalize": "http://localhost/acme/finalize/1/1",
+						"notBefore": "2021-04-01T00:00:00Z",
+						"notAfter": "2021-04-07T22:00:00Z"
+					}`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2487,6 +2776,197 @@ func TestNewOrder(t *testing.T) {
 	}
 }
 
+func TestNewAuthorization(t *testing.T) {
+	wfe, _ := setupWFE(t)
+	responseWriter := httptest.NewRecorder()
+
+	targetHost := "localhost"
+	targetPath := "new-authz"
+	signedURL := fmt.Sprintf("http://%s/%s", targetHost, targetPath)
+
+	testCases := []struct {
+		Name            string
+		Request         *http.Request
+		ExpectedBody    string
+		ExpectedHeaders map[string]string
+	}{
+		{
+			Name:         "POST, properly signed JWS, payload isn't valid",
+			Request:      signAndPost(t, targetPath, signedURL, "foo", 1, wfe.nonceService),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"Request payload did not parse as JSON","status":400}`,
+		},
+		{
+			Name:         "POST, no identifier in payload",
+			Request:      signAndPost(t, targetPath, signedURL, "{}", 1, wfe.nonceService),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewAuthorization request included invalid non-DNS type identifier: type \"\", value \"\"","status":400}`,
+		},
+		{
+			Name:         "POST, empty domain name identifier",
+			Request:      signAndPost(t, targetPath, signedURL, `{"identifier":{"type":"dns","value":""}}`, 1, wfe.nonceService),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewAuthorization request included empty domain name","status":400}`,
+		},
+		{
+			Name:         "POST, non-DNS identifier",
+			Request:      signAndPost(t, targetPath, signedURL, `{"identifier":{"type":"fakeID","value":"www.i-am-21.com"}}`, 1, wfe.nonceService),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewAuthorization request included invalid non-DNS type identifier: type \"fakeID\", value \"www.i-am-21.com\"","status":400}`,
+		},
+		{
+			Name:         "POST, wildcard identifier",
+			Request:      signAndPost(t, targetPath, signedURL, `{"identifier":{"type":"dns","value":"*.not-example.com"}}`, 1, wfe.nonceService),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `rejectedIdentifier","detail":"Wildcard domain names cannot be pre-authorized, use newOrder instead","status":400}`,
+		},
+		{
+			Name:    "POST, good payload",
+			Request: signAndPost(t, targetPath, signedURL, `{"identifier":{"type":"dns","value":"not-example.com"}}`, 1, wfe.nonceService),
+			ExpectedBody: `
+				{
+					"identifier": {"type": "dns", "value": "not-example.com"},
+					"status": "pending",
+					"expires": "2070-01-01T00:00:00Z",
+					"challenges": [
+						{
+							"type": "dns-01",
+							"status": "pending",
+							"url": "http://localhost/acme/chall-v3/1/uDBIVw",
+							"token": "token"
+						}
+					]
+				}`,
+			ExpectedHeaders: map[string]string{
+				"Location": "http://localhost/acme/authz-v3/1",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			responseWriter.Body.Reset()
+
+			wfe.NewAuthorization(ctx, newRequestEvent(), responseWriter, tc.Request)
+			test.AssertUnmarshaledEquals(t, responseWriter.Body.String(), tc.ExpectedBody)
+
+			headers := responseWriter.Header()
+			for k, v := range tc.ExpectedHeaders {
+				test.AssertEquals(t, headers.Get(k), v)
+			}
+		})
+	}
+}
+
+func TestNewAuthorizationFeature(t *testing.T) {
+	wfe, _ := setupWFE(t)
+	getDirectory := func() map[string]interface{} {
+		responseWriter := httptest.NewRecorder()
+		wfe.Handler(metrics.NoopRegisterer).ServeHTTP(responseWriter, &http.Request{
+			Method: http.MethodGet,
+			URL:    mustParseURL(directoryPath),
+		})
+		var directory map[string]interface{}
+		err := json.Unmarshal(responseWriter.Body.Bytes(), &directory)
+		test.AssertNotError(t, err, "Couldn't unmarshal directory")
+		return directory
+	}
+
+	// With the feature disabled newAuthz is neither advertised nor served.
+	_, present := getDirectory()["newAuthz"]
+	test.Assert(t, !present, "newAuthz present in directory with PreAuthorization disabled")
+	responseWriter := httptest.NewRecorder()
+	wfe.Handler(metrics.NoopRegisterer).ServeHTTP(responseWriter,
+		signAndPost(t, newAuthzPath, "http://localhost"+newAuthzPath, `{"identifier":{"type":"dns","value":"not-example.com"}}`, 1, wfe.nonceService))
+	test.AssertEquals(t, responseWriter.Code, http.StatusNotFound)
+
+	_ = features.Set(map[string]bool{"PreAuthorization": true})
+	defer features.Reset()
+
+	test.AssertEquals(t, getDirectory()["newAuthz"], "http://localhost/acme/new-authz")
+	responseWriter = httptest.NewRecorder()
+	wfe.Handler(metrics.NoopRegisterer).ServeHTTP(responseWriter,
+		signAndPost(t, newAuthzPath, "http://localhost"+newAuthzPath, `{"identifier":{"type":"dns","value":"not-example.com"}}`, 1, wfe.nonceService))
+	test.AssertEquals(t, responseWriter.Code, http.StatusCreated)
+}
+
+func TestAccountOrders(t *testing.T) {
+	wfe, _ := setupWFE(t)
+	wfe.ordersPageSize = 2
+	mux := wfe.Handler(metrics.NoopRegisterer)
+
+	postAsGet := func(path string) *httptest.ResponseRecorder {
+		responseWriter := httptest.NewRecorder()
+		mux.ServeHTTP(responseWriter, signAndPost(t, path, "http://localhost"+path, "", 1, wfe.nonceService))
+		return responseWriter
+	}
+
+	testCases := []struct {
+		Name         string
+		Path         string
+		ExpectedCode int
+		ExpectedBody string
+		ExpectedLink string
+	}{
+		{
+			Name:         "first page",
+			Path:         ordersPath + "1",
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: `{"orders":["http://localhost/acme/order/1/5","http://localhost/acme/order/1/4"]}`,
+			ExpectedLink: `<http://localhost/acme/orders/1/4>;rel="next"`,
+		},
+		{
+			Name:         "middle page",
+			Path:         ordersPath + "1/4",
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: `{"orders":["http://localhost/acme/order/1/3","http://localhost/acme/order/1/2"]}`,
+			ExpectedLink: `<http://localhost/acme/orders/1/2>;rel="next"`,
+		},
+		{
+			Name:         "last page",
+			Path:         ordersPath + "1/2",
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: `{"orders":["http://localhost/acme/order/1/1"]}`,
+		},
+		{
+			Name:         "invalid cursor",
+			Path:         ordersPath + "1/lol",
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"Invalid orders list cursor","status":400}`,
+		},
+		{
+			Name:         "invalid account ID",
+			Path:         ordersPath + "lol",
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"Invalid account ID","status":400}`,
+		},
+		{
+			Name:         "another account's orders",
+			Path:         ordersPath + "2",
+			ExpectedCode: http.StatusForbidden,
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `unauthorized","detail":"Request signing key did not match account key","status":403}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			responseWriter := postAsGet(tc.Path)
+			test.AssertEquals(t, responseWriter.Code, tc.ExpectedCode)
+			test.AssertUnmarshaledEquals(t, responseWriter.Body.String(), tc.ExpectedBody)
+			var nextLink string
+			for _, l := range responseWriter.Header()["Link"] {
+				if strings.HasSuffix(l, `rel="next"`) {
+					nextLink = l
+				}
+			}
+			test.AssertEquals(t, nextLink, tc.ExpectedLink)
+		})
+	}
+
+	// The orders list is only available via POST-as-GET.
+	responseWriter := httptest.NewRecorder()
+	mux.ServeHTTP(responseWriter, &http.Request{
+		Method: http.MethodGet,
+		URL:    mustParseURL(ordersPath + "1"),
+	})
+	test.AssertEquals(t, responseWriter.Code, http.StatusMethodNotAllowed)
+}
+
 func TestFinalizeOrder(t *testing.T) {
 	wfe, _ := setupWFE(t)
 	responseWriter := httptest.NewRecorder()
@@ -2697,6 +3177,7 @@ func TestKeyRollover(t *testing.T) {
 		     ],
 		     "initialIp": "",
 		     "createdAt": "0001-01-01T00:00:00Z",
+		     "orders": "http://localhost/acme/orders/1",
 		     "status": "valid"
 		   }`,
 			NewKey: newKeyPriv,
@@ -3246,6 +3727,30 @@ func (ra *noSCTMockRA) FinalizeOrder(ctx context.Context, req *rapb.FinalizeOrde
 	return nil, berrors.MissingSCTsError("noSCTMockRA missing scts error")
 }
 
+func TestSendErrorRateLimitRetryAfter(t *testing.T) {
+	wfe, fc := setupWFE(t)
+
+	// A rate limit error with a known reset should produce both a Retry-After
+	// header, rounded up to whole seconds, and a resetTime in the problem.
+	err := berrors.RateLimitError(90*time.Minute+500*time.Millisecond, "too many certificates already issued for: example.com")
+	responseWriter := httptest.NewRecorder()
+	wfe.sendError(responseWriter, newRequestEvent(), web.ProblemDetailsForError(err, "Error creating new order"), err)
+	test.AssertEquals(t, responseWriter.Code, http.StatusTooManyRequests)
+	test.AssertEquals(t, responseWriter.Header().Get("Retry-After"), "5401")
+	var prob probs.ProblemDetails
+	test.AssertNotError(t, json.Unmarshal(responseWriter.Body.Bytes(), &prob), "unmarshalling problem")
+	test.AssertNotNil(t, prob.ResetTime, "expected resetTime in problem")
+	test.AssertEquals(t, prob.ResetTime.Equal(fc.Now().Add(5401*time.Second)), true)
+
+	// Without a known reset there should be neither.
+	err = berrors.RateLimitError(0, "too many currently pending authorizations")
+	responseWriter = httptest.NewRecorder()
+	wfe.sendError(responseWriter, newRequestEvent(), web.ProblemDetailsForError(err, "Error creating new order"), err)
+	test.AssertEquals(t, responseWriter.Code, http.StatusTooManyRequests)
+	test.AssertEquals(t, responseWriter.Header().Get("Retry-After"), "")
+	test.AssertNotContains(t, responseWriter.Body.String(), "resetTime")
+}
+
 func TestFinalizeSCTError(t *testing.T) {
 	wfe, _ := setupWFE(t)
 
@@ -3379,12 +3884,15 @@ func TestPrepAccountForDisplay(t *testing.T) {
 	}
 
 	// Prep the account for display.
-	prepAccountForDisplay(acct)
+	req := &http.Request{URL: &url.URL{Path: acctPath + "1987"}, Host: "localhost"}
+	prepAccountForDisplay(req, acct)
 
 	// The Agreement should always be cleared.
 	test.AssertEquals(t, acct.Agreement, "")
 	// The ID field should be zeroed.
 	test.AssertEquals(t, acct.ID, int64(0))
+	// The orders URL should be keyed by the account's ID.
+	test.AssertEquals(t, acct.Orders, "http://localhost/acme/orders/1987")
 }
 
 func TestGETAPIAuthz(t *testing.T) {
@@ -3484,3 +3992,166 @@ func TestGetAPIAndMandatoryPOSTAsGET(t *testing.T) {
 	wfe.Certificate(context.Background(), event, resp, req)
 	test.AssertEquals(t, resp.Code, 200)
 }
+
+// makeCertIDPath returns the renewalInfo path component for the given
+// certificate and issuer: a base64url encoded DER CertID, as computed by an
+// OCSP client.
+func makeCertIDPath(t *testing.T, cert, issuer *x509.Certificate, hash crypto.Hash) string {
+	t.Helper()
+	reqDER, err := ocsp.CreateRequest(cert, issuer, &ocsp.RequestOptions{Hash: hash})
+	test.AssertNotError(t, err, "failed to create OCSP request")
+	req, err := ocsp.ParseRequest(reqDER)
+	test.AssertNotError(t, err, "failed to parse OCSP request")
+
+	var oid asn1.ObjectIdentifier
+	switch hash {
+	case crypto.SHA256:
+		oid = oidSHA256
+	case crypto.SHA1:
+		oid = oidSHA1
+	default:
+		t.Fatalf("unsupported hash %s", hash)
+	}
+	der, err := asn1.Marshal(certID{
+		HashAlgorithm:  pkix.AlgorithmIdentifier{Algorithm: oid, Parameters: asn1.NullRawValue},
+		IssuerNameHash: req.IssuerNameHash,
+		IssuerKeyHash:  req.IssuerKeyHash,
+		SerialNumber:   req.SerialNumber,
+	})
+	test.AssertNotError(t, err, "failed to marshal CertID")
+	return base64.RawURLEncoding.EncodeToString(der)
+}
+
+func TestRenewalInfo(t *testing.T) {
+	wfe, fc := setupWFE(t)
+	cert, err := core.LoadCert("../test/hierarchy/ee-r3.cert.pem")
+	test.AssertNotError(t, err, "failed to load test certificate")
+	issuer, err := core.LoadCert("../test/hierarchy/int-r3.cert.pem")
+	test.AssertNotError(t, err, "failed to load test issuer")
+	otherIssuer, err := core.LoadCert("../test/hierarchy/int-r4.cert.pem")
+	test.AssertNotError(t, err, "failed to load other test issuer")
+
+	makeGet := func(path string) *http.Request {
+		return &http.Request{URL: &url.URL{Path: renewalInfoPath + path}, Method: "GET"}
+	}
+	get := func(mux http.Handler, path string) *httptest.ResponseRecorder {
+		responseWriter := httptest.NewRecorder()
+		mux.ServeHTTP(responseWriter, makeGet(path))
+		return responseWriter
+	}
+	windowFrom := func(t *testing.T, body []byte) core.SuggestedWindow {
+		t.Helper()
+		var ri core.RenewalInfo
+		err := json.Unmarshal(body, &ri)
+		test.AssertNotError(t, err, "failed to unmarshal renewalInfo")
+		return ri.SuggestedWindow
+	}
+
+	// With the feature disabled the endpoint is neither advertised nor served.
+	wfe.SA = newMockSAWithCert(t, wfe.SA, core.OCSPStatusGood)
+	mux := wfe.Handler(metrics.NoopRegisterer)
+	responseWriter := get(mux, makeCertIDPath(t, cert, issuer, crypto.SHA256))
+	test.AssertEquals(t, responseWriter.Code, http.StatusNotFound)
+
+	_ = features.Set(map[string]bool{"ServeRenewalInfo": true})
+	defer features.Reset()
+	mux = wfe.Handler(metrics.NoopRegisterer)
+
+	responseWriter = httptest.NewRecorder()
+	mux.ServeHTTP(responseWriter, &http.Request{URL: mustParseURL(directoryPath), Method: "GET"})
+	var directory map[string]interface{}
+	err = json.Unmarshal(responseWriter.Body.Bytes(), &directory)
+	test.AssertNotError(t, err, "failed to unmarshal directory")
+	test.AssertEquals(t, directory["renewalInfo"], "http://localhost/acme/renewal-info/")
+
+	// A good certificate gets a window based on its validity period, whichever
+	// hash algorithm was used to construct the CertID.
+	expected := core.RenewalInfoSimple(cert.NotBefore, cert.NotAfter).SuggestedWindow
+	for _, hash := range []crypto.Hash{crypto.SHA256, crypto.SHA1} {
+		responseWriter = get(mux, makeCertIDPath(t, cert, issuer, hash))
+		test.AssertEquals(t, responseWriter.Code, http.StatusOK)
+		test.AssertEquals(t, responseWriter.Header().Get("Retry-After"), "21600")
+		window := windowFrom(t, responseWriter.Body.Bytes())
+		test.Assert(t, window.Start.Equal(expected.Start), "unexpected window start")
+		test.Assert(t, window.End.Equal(expected.End), "unexpected window end")
+	}
+
+	// A revoked certificate should be renewed immediately.
+	wfe.SA = newMockSAWithCert(t, mocks.NewStorageAuthority(fc), core.OCSPStatusRevoked)
+	responseWriter = get(mux, makeCertIDPath(t, cert, issuer, crypto.SHA256))
+	test.AssertEquals(t, responseWriter.Code, http.StatusOK)
+	window := windowFrom(t, responseWriter.Body.Bytes())
+	test.Assert(t, window.End.Before(fc.Now()), "revoked certificate's window should be in the past")
+
+	// An override takes precedence over the computed window.
+	serial := core.SerialToString(cert.SerialNumber)
+	err = wfe.renewalInfoOverrides.load([]byte(fmt.Sprintf(`
+overrides:
+  - serials:
+      - %s
+    start: 2021-01-01T00:00:00Z
+    end: 2021-01-02T00:00:00Z
+`, serial)))
+	test.AssertNotError(t, err, "failed to load renewal info overrides")
+	responseWriter = get(mux, makeCertIDPath(t, cert, issuer, crypto.SHA256))
+	test.AssertEquals(t, responseWriter.Code, http.StatusOK)
+	window = windowFrom(t, responseWriter.Body.Bytes())
+	test.Assert(t, window.Start.Equal(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)), "unexpected override start")
+	test.Assert(t, window.End.Equal(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)), "unexpected override end")
+
+	// Malformed overrides should be rejected.
+	err = wfe.renewalInfoOverrides.load([]byte(`
+overrides:
+  - serials:
+      - nope
+    start: 2021-01-01T00:00:00Z
+    end: 2021-01-02T00:00:00Z
+`))
+	test.AssertError(t, err, "loaded override with invalid serial")
+	err = wfe.renewalInfoOverrides.load([]byte(fmt.Sprintf(`
+overrides:
+  - serials:
+      - %s
+    start: 2021-01-02T00:00:00Z
+    end: 2021-01-01T00:00:00Z
+`, serial)))
+	test.AssertError(t, err, "loaded override which ends before it starts")
+
+	testCases := []struct {
+		name           string
+		path           string
+		expectedStatus int
+	}{
+		{
+			name:           "empty path",
+			path:           "",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "not base64url",
+			path:           "!!!",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "not a CertID",
+			path:           base64.RawURLEncoding.EncodeToString([]byte("hello")),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "unknown issuer",
+			path:           makeCertIDPath(t, cert, otherIssuer, crypto.SHA256),
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "unknown serial",
+			path:           makeCertIDPath(t, issuer, issuer, crypto.SHA256),
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			responseWriter := get(mux, tc.path)
+			test.AssertEquals(t, responseWriter.Code, tc.expectedStatus)
+		})
+	}
+}